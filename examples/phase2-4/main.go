@@ -114,9 +114,6 @@ func (tr *TransformableRectangle) Render(r tinyengine.Renderer) {
 	// 数学ライブラリから変換行列を取得
 	transformMatrix := tr.transform.ToMatrix()
 	
-	// OpenGL用に3x3行列を4x4行列に変換
-	transform4x4 := convert3x3To4x4(transformMatrix)
-	
 	// 原点に基本的な矩形を作成してから変形
 	halfWidth := float32(tr.size.X * 0.5)
 	halfHeight := float32(tr.size.Y * 0.5)
@@ -134,7 +131,7 @@ func (tr *TransformableRectangle) Render(r tinyengine.Renderer) {
 	}
 	
 	// 変換を適用して描画
-	transformedVertices := applyTransformToVertices(vertices, transform4x4)
+	transformedVertices := transformMatrix.TransformVertices(vertices)
 	
 	// 描画用のプリミティブを作成
 	rect := &TransformedRectangle{
@@ -169,33 +166,6 @@ func (tr *TransformedRectangle) GetType() renderer.PrimitiveType {
 	return renderer.PrimitiveTypeRectangle
 }
 
-// convert3x3To4x4 OpenGL用に3x3行列を4x4行列に変換
-func convert3x3To4x4(m3 mathlib.Matrix3x3) [16]float32 {
-	return [16]float32{
-		float32(m3[0][0]), float32(m3[1][0]), 0, float32(m3[2][0]),
-		float32(m3[0][1]), float32(m3[1][1]), 0, float32(m3[2][1]),
-		0,                 0,                 1, 0,
-		float32(m3[0][2]), float32(m3[1][2]), 0, float32(m3[2][2]),
-	}
-}
-
-// applyTransformToVertices 4x4変換行列を頂点に適用
-func applyTransformToVertices(vertices []float32, transform [16]float32) []float32 {
-	transformed := make([]float32, len(vertices))
-	
-	// 頂点を3個ずつ（x, y, z）のグループで処理
-	for i := 0; i < len(vertices); i += 3 {
-		x, y, z := vertices[i], vertices[i+1], vertices[i+2]
-		
-		// 4x4変換行列を適用
-		transformed[i] = transform[0]*x + transform[4]*y + transform[8]*z + transform[12]   // new x
-		transformed[i+1] = transform[1]*x + transform[5]*y + transform[9]*z + transform[13] // new y
-		transformed[i+2] = transform[2]*x + transform[6]*y + transform[10]*z + transform[14] // new z
-	}
-	
-	return transformed
-}
-
 // createRedRectangle 赤い矩形を作成
 func createRedRectangle() *TransformableRectangle {
 	return NewTransformableRectangle(
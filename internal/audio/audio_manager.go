@@ -0,0 +1,236 @@
+package audio
+
+import (
+	"errors"
+	"fmt"
+
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+)
+
+// ErrChannelsNotSupported は Sink がチャンネル指定の再生に対応していない場合のエラー
+var ErrChannelsNotSupported = errors.New("audio sink does not support channels")
+
+// musicTrack は再生中の音楽トラックとそのフェード状態
+type musicTrack struct {
+	handle    MusicHandle
+	gain      float64        // フェードによる音量倍率（0.0〜1.0）
+	fade      *mathlib.Tween // 進行中のフェード（なければnil）
+	stopAtEnd bool           // フェード完了時に停止するか
+}
+
+// Manager はSinkを使用して tinyengine.AudioManager を実装する
+// マスター音量、2D空間音響（リスナー位置による減衰・パン）、音楽のフェードを管理する
+type Manager struct {
+	sink     Sink
+	volume   float64
+	listener mathlib.Vector2
+	falloff  Falloff
+
+	music       *musicTrack   // 現在の音楽
+	fadingMusic []*musicTrack // フェードアウト中の音楽
+	fadeEasing  mathlib.EaseFunc
+}
+
+// NewManager は新しいManagerを作成する
+func NewManager(sink Sink) *Manager {
+	return &Manager{
+		sink:       sink,
+		volume:     1.0,
+		falloff:    DefaultFalloff(),
+		fadeEasing: mathlib.EaseInOutSine, // 中点で0.5になるため、クロスフェード中の合計音量が中点で1.0になる
+	}
+}
+
+// Initialize はオーディオシステムを初期化する
+func (m *Manager) Initialize() error {
+	if m.sink == nil {
+		return fmt.Errorf("audio sink is not set")
+	}
+	return nil
+}
+
+// PlaySound はサウンドを中央定位で再生する
+func (m *Manager) PlaySound(filename string) error {
+	return m.sink.PlaySound(filename, m.volume, 0)
+}
+
+// PlaySoundOnChannel はサウンドを指定したチャンネルで中央定位で再生する
+// Sink が ChannelSink でない場合は ErrChannelsNotSupported を返す
+func (m *Manager) PlaySoundOnChannel(filename string, channel int) error {
+	sink, ok := m.sink.(ChannelSink)
+	if !ok {
+		return ErrChannelsNotSupported
+	}
+	return sink.PlaySoundOnChannel(filename, m.volume, 0, channel)
+}
+
+// StopChannel はチャンネルのサウンドを停止する（Sink が ChannelSink でない場合は何もしない）
+func (m *Manager) StopChannel(channel int) {
+	if sink, ok := m.sink.(ChannelSink); ok {
+		sink.StopChannel(channel)
+	}
+}
+
+// PlayMusic は再生中の音楽を停止し、新しい音楽を再生する
+func (m *Manager) PlayMusic(filename string) error {
+	m.StopMusic()
+	return m.startMusic(filename, 1.0, nil)
+}
+
+// StopMusic はフェード中のものも含めて全ての音楽を即座に停止する
+func (m *Manager) StopMusic() {
+	if m.music != nil {
+		m.sink.StopMusic(m.music.handle)
+		m.music = nil
+	}
+	for _, track := range m.fadingMusic {
+		m.sink.StopMusic(track.handle)
+	}
+	m.fadingMusic = nil
+}
+
+// FadeInMusic は再生中の音楽を停止し、新しい音楽を無音から duration 秒かけてフェードインする
+func (m *Manager) FadeInMusic(filename string, duration float64) error {
+	m.StopMusic()
+	return m.startMusic(filename, 0, mathlib.NewTween(0, 1, duration, m.fadeEasing))
+}
+
+// FadeOutMusic は現在の音楽を duration 秒かけてフェードアウトし、完了時に停止する
+func (m *Manager) FadeOutMusic(duration float64) {
+	if m.music == nil {
+		return
+	}
+
+	track := m.music
+	m.music = nil
+	track.fade = mathlib.NewTween(track.gain, 0, duration, m.fadeEasing)
+	track.stopAtEnd = true
+	if m.updateTrack(track, 0) {
+		m.fadingMusic = append(m.fadingMusic, track)
+	}
+}
+
+// CrossfadeMusic は現在の音楽をフェードアウトしながら、新しい音楽を同じ時間でフェードインする
+func (m *Manager) CrossfadeMusic(filename string, duration float64) error {
+	m.FadeOutMusic(duration)
+	return m.startMusic(filename, 0, mathlib.NewTween(0, 1, duration, m.fadeEasing))
+}
+
+// SetFadeEasing はフェードに使用する曲線を設定する（nilの場合は線形）
+func (m *Manager) SetFadeEasing(easing mathlib.EaseFunc) {
+	if easing == nil {
+		easing = mathlib.EaseLinear
+	}
+	m.fadeEasing = easing
+}
+
+// Update はフェードを進め、各トラックの音量を更新する
+func (m *Manager) Update(deltaTime float64) {
+	if m.music != nil {
+		m.updateTrack(m.music, deltaTime)
+	}
+
+	remaining := m.fadingMusic[:0]
+	for _, track := range m.fadingMusic {
+		if m.updateTrack(track, deltaTime) {
+			remaining = append(remaining, track)
+		}
+	}
+	m.fadingMusic = remaining
+}
+
+// startMusic は音楽を再生して現在のトラックにする
+func (m *Manager) startMusic(filename string, gain float64, fade *mathlib.Tween) error {
+	handle, err := m.sink.PlayMusic(filename, m.volume*gain)
+	if err != nil {
+		return fmt.Errorf("failed to play music %s: %w", filename, err)
+	}
+
+	m.music = &musicTrack{
+		handle: handle,
+		gain:   gain,
+		fade:   fade,
+	}
+	return nil
+}
+
+// updateTrack はトラックのフェードを進めて音量を反映する
+// 停止した場合は false を返す
+func (m *Manager) updateTrack(track *musicTrack, deltaTime float64) bool {
+	if track.fade == nil {
+		return true
+	}
+
+	track.gain = track.fade.Update(deltaTime)
+
+	if track.fade.IsDone() {
+		track.fade = nil
+		if track.stopAtEnd {
+			m.sink.StopMusic(track.handle)
+			return false
+		}
+	}
+
+	m.sink.SetMusicVolume(track.handle, m.volume*track.gain)
+	return true
+}
+
+// SetVolume はマスター音量を設定する（0.0〜1.0にクランプ）
+func (m *Manager) SetVolume(volume float32) {
+	m.volume = clampVolume(float64(volume))
+
+	if m.music != nil {
+		m.sink.SetMusicVolume(m.music.handle, m.volume*m.music.gain)
+	}
+	for _, track := range m.fadingMusic {
+		m.sink.SetMusicVolume(track.handle, m.volume*track.gain)
+	}
+}
+
+// GetVolume はマスター音量を取得する
+func (m *Manager) GetVolume() float64 {
+	return m.volume
+}
+
+// Destroy はオーディオシステムを破棄する
+func (m *Manager) Destroy() {
+	if m.sink != nil {
+		m.sink.Close()
+	}
+}
+
+// SetListenerPosition は空間音響のリスナー位置を設定する
+func (m *Manager) SetListenerPosition(pos mathlib.Vector2) {
+	m.listener = pos
+}
+
+// GetListenerPosition はリスナー位置を取得する
+func (m *Manager) GetListenerPosition() mathlib.Vector2 {
+	return m.listener
+}
+
+// SetFalloff は距離による減衰の設定を変更する
+func (m *Manager) SetFalloff(falloff Falloff) {
+	m.falloff = falloff
+}
+
+// PlaySoundAt はリスナーからの距離で減衰させ、相対位置に応じてパンを振ってサウンドを再生する
+// 完全に減衰する距離の音源は再生しない
+func (m *Manager) PlaySoundAt(filename string, pos mathlib.Vector2) error {
+	attenuation, pan := m.falloff.Spatialize(m.listener, pos)
+	if attenuation <= 0 {
+		return nil
+	}
+	return m.sink.PlaySound(filename, m.volume*attenuation, pan)
+}
+
+// clampVolume は音量を0.0〜1.0の範囲に制限する
+func clampVolume(volume float64) float64 {
+	if volume < 0 {
+		return 0
+	}
+	if volume > 1 {
+		return 1
+	}
+	return volume
+}
@@ -0,0 +1,49 @@
+package audio
+
+import (
+	"testing"
+
+	"github.com/ganyariya/tinyengine/pkg/tinyengine"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManager_ImplementsAudioManager(t *testing.T) {
+	var _ tinyengine.AudioManager = (*Manager)(nil)
+}
+
+func TestManager_SetVolume_Clamps(t *testing.T) {
+	manager := NewManager(NewMockSink())
+
+	manager.SetVolume(1.5)
+	assert.Equal(t, 1.0, manager.GetVolume())
+
+	manager.SetVolume(-0.5)
+	assert.Equal(t, 0.0, manager.GetVolume())
+}
+
+func TestManager_Initialize_WithoutSink(t *testing.T) {
+	manager := NewManager(nil)
+
+	assert.Error(t, manager.Initialize())
+}
+
+func TestManager_PlaySoundOnChannel(t *testing.T) {
+	sink := NewMockSink()
+	sink.On("PlaySoundOnChannel", "hit.wav", 0.5, 0.0, 3).Return(nil)
+	sink.On("StopChannel", 3).Return()
+	manager := NewManager(sink)
+	manager.SetVolume(0.5)
+
+	assert.NoError(t, manager.PlaySoundOnChannel("hit.wav", 3))
+	manager.StopChannel(3)
+
+	sink.AssertExpectations(t)
+}
+
+func TestManager_PlaySoundOnChannel_Unsupported(t *testing.T) {
+	// Sink だけを満たす型に包み、チャンネル指定の再生を隠す
+	manager := NewManager(struct{ Sink }{NewMockSink()})
+
+	assert.ErrorIs(t, manager.PlaySoundOnChannel("hit.wav", 0), ErrChannelsNotSupported)
+	assert.NotPanics(t, func() { manager.StopChannel(0) })
+}
@@ -0,0 +1,75 @@
+package audio
+
+import (
+	"fmt"
+
+	"github.com/ebitengine/oto/v3"
+)
+
+// streamPlayer は StreamSink のストリームを再生するプレイヤー（oto.Player を抽象化する）
+type streamPlayer interface {
+	Play()
+	Close() error
+}
+
+// DeviceSink は StreamSink の出力を oto でオーディオデバイスに送って再生する Sink
+// 効果音のチャンネル指定やマスター音量など StreamSink の機能はそのまま使用できる
+type DeviceSink struct {
+	*StreamSink
+	player   streamPlayer
+	closeErr error
+}
+
+// NewDeviceSink はデフォルトのオーディオデバイスを開き、StreamSink の再生を開始する
+// oto の制約により、オーディオデバイスはプロセスごとに1度しか開けない
+func NewDeviceSink(sampleRate, channels int) (*DeviceSink, error) {
+	stream := NewStreamSink(sampleRate, channels)
+
+	context, ready, err := oto.NewContext(&oto.NewContextOptions{
+		SampleRate:   stream.SampleRate(),
+		ChannelCount: stream.Channels(),
+		Format:       oto.FormatSignedInt16LE,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audio device: %w", err)
+	}
+	<-ready
+
+	return newDeviceSink(stream, context.NewPlayer(stream)), nil
+}
+
+// newDeviceSink はプレイヤーを指定して DeviceSink を作成し、再生を開始する
+func newDeviceSink(stream *StreamSink, player streamPlayer) *DeviceSink {
+	player.Play()
+	return &DeviceSink{
+		StreamSink: stream,
+		player:     player,
+	}
+}
+
+// Close はストリームを終了してからプレイヤーを解放する
+// Sink.Close はエラーを返せないため、プレイヤーの解放に失敗した場合は Err で取得できるようにする
+func (s *DeviceSink) Close() {
+	s.StreamSink.Close()
+	if s.player == nil {
+		return
+	}
+	if err := s.player.Close(); err != nil {
+		s.closeErr = fmt.Errorf("failed to close audio player: %w", err)
+	}
+	s.player = nil
+}
+
+// Err は Close でプレイヤーの解放に失敗した場合のエラーを取得する
+func (s *DeviceSink) Err() error {
+	return s.closeErr
+}
+
+// NewDeviceManager はデフォルトのオーディオデバイスに出力する Manager を作成する
+func NewDeviceManager() (*Manager, error) {
+	sink, err := NewDeviceSink(DefaultSampleRate, DefaultChannels)
+	if err != nil {
+		return nil, err
+	}
+	return NewManager(sink), nil
+}
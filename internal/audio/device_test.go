@@ -0,0 +1,57 @@
+package audio
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakePlayer はテスト用のプレイヤー
+type fakePlayer struct {
+	playing  bool
+	closed   bool
+	closeErr error
+}
+
+func (p *fakePlayer) Play() {
+	p.playing = true
+}
+
+func (p *fakePlayer) Close() error {
+	p.closed = true
+	return p.closeErr
+}
+
+var _ ChannelSink = (*DeviceSink)(nil)
+
+func TestDeviceSink_StartsAndClosesPlayer(t *testing.T) {
+	// Arrange
+	player := &fakePlayer{}
+	sink := newDeviceSink(NewStreamSink(8000, 1), player)
+
+	// Act
+	playing := player.playing
+	sink.Close()
+	sink.Close()
+	_, err := sink.Read(make([]byte, 4))
+
+	// Assert
+	assert.True(t, playing, "作成時に再生を開始する")
+	assert.True(t, player.closed)
+	assert.ErrorIs(t, err, io.EOF, "ストリームも終了する")
+	assert.NoError(t, sink.Err())
+}
+
+func TestDeviceSink_CloseError(t *testing.T) {
+	// Arrange
+	closeErr := errors.New("device busy")
+	sink := newDeviceSink(NewStreamSink(8000, 1), &fakePlayer{closeErr: closeErr})
+
+	// Act
+	NewManager(sink).Destroy()
+
+	// Assert
+	assert.ErrorIs(t, sink.Err(), closeErr)
+}
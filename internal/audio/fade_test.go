@@ -0,0 +1,100 @@
+package audio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newFadeTestSink() *MockSink {
+	sink := NewMockSink()
+	sink.On("PlayMusic", mock.Anything, mock.Anything).Return(nil)
+	sink.On("SetMusicVolume", mock.Anything, mock.Anything).Return()
+	sink.On("StopMusic", mock.Anything).Return()
+	return sink
+}
+
+func TestManager_FadeInMusic(t *testing.T) {
+	// Arrange
+	sink := newFadeTestSink()
+	manager := NewManager(sink)
+
+	// Act
+	require.NoError(t, manager.FadeInMusic("theme.ogg", 1.0))
+	handle := manager.music.handle
+	start, _ := sink.MusicVolume(handle)
+
+	volumes := []float64{start}
+	for i := 0; i < 4; i++ {
+		manager.Update(0.25)
+		volume, _ := sink.MusicVolume(handle)
+		volumes = append(volumes, volume)
+	}
+
+	// Assert
+	assert.Equal(t, 0.0, volumes[0])
+	for i := 1; i < len(volumes); i++ {
+		assert.Greater(t, volumes[i], volumes[i-1], "フェードイン中は音量が単調増加する")
+	}
+	assert.InDelta(t, 1.0, volumes[len(volumes)-1], 1e-9)
+}
+
+func TestManager_FadeOutMusic_StopsAtEnd(t *testing.T) {
+	sink := newFadeTestSink()
+	manager := NewManager(sink)
+	require.NoError(t, manager.PlayMusic("theme.ogg"))
+
+	manager.FadeOutMusic(0.5)
+	manager.Update(0.25)
+	assert.Equal(t, 1, sink.PlayingMusicCount())
+
+	manager.Update(0.25)
+	assert.Equal(t, 0, sink.PlayingMusicCount())
+}
+
+func TestManager_FadeOutMusic_ZeroDurationStopsImmediately(t *testing.T) {
+	sink := newFadeTestSink()
+	manager := NewManager(sink)
+	require.NoError(t, manager.PlayMusic("theme.ogg"))
+
+	manager.FadeOutMusic(0)
+
+	assert.Equal(t, 0, sink.PlayingMusicCount())
+	assert.Empty(t, manager.fadingMusic)
+}
+
+func TestManager_CrossfadeMusic_Midpoint(t *testing.T) {
+	// Arrange
+	sink := newFadeTestSink()
+	manager := NewManager(sink)
+	require.NoError(t, manager.PlayMusic("a.ogg"))
+	oldHandle := manager.music.handle
+
+	// Act
+	require.NoError(t, manager.CrossfadeMusic("b.ogg", 2.0))
+	newHandle := manager.music.handle
+	manager.Update(1.0)
+
+	// Assert
+	oldVolume, _ := sink.MusicVolume(oldHandle)
+	newVolume, _ := sink.MusicVolume(newHandle)
+	assert.InDelta(t, 0.5, oldVolume, 1e-9)
+	assert.InDelta(t, 0.5, newVolume, 1e-9)
+	assert.InDelta(t, 1.0, oldVolume+newVolume, 1e-9)
+
+	manager.Update(1.0)
+	assert.Equal(t, 1, sink.PlayingMusicCount(), "フェードアウトした音楽は停止される")
+}
+
+func TestManager_SetVolume_AppliesToMusic(t *testing.T) {
+	sink := newFadeTestSink()
+	manager := NewManager(sink)
+	require.NoError(t, manager.PlayMusic("theme.ogg"))
+
+	manager.SetVolume(0.5)
+
+	volume, _ := sink.MusicVolume(manager.music.handle)
+	assert.Equal(t, 0.5, volume)
+}
@@ -0,0 +1,180 @@
+package audio
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DefaultMixerChannels は StreamSink が同時に再生できる効果音の数のデフォルト値
+const DefaultMixerChannels = 8
+
+// ErrInvalidChannel は存在しないチャンネルを指定した場合のエラー
+var ErrInvalidChannel = errors.New("invalid mixer channel")
+
+// mixerChannel は効果音を1つ再生するミキサーのチャンネル
+type mixerChannel struct {
+	voice    *voice
+	volume   float64
+	sequence uint64 // 再生を開始した順番（空きがない場合に最も古いチャンネルを選ぶため）
+}
+
+// Mixer は複数のチャンネルの効果音を加算して合成する
+// チャンネルごとの音量とマスター音量を持ち、合成結果は -1.0〜1.0 に制限する
+// 排他制御は行わないため、呼び出し側（StreamSink）でロックする
+type Mixer struct {
+	channels     []mixerChannel
+	masterVolume float64
+	sequence     uint64
+	scratch      []float32
+}
+
+// NewMixer は指定したチャンネル数のミキサーを作成する（1未満の場合は1チャンネル）
+func NewMixer(channelCount int) *Mixer {
+	if channelCount < 1 {
+		channelCount = 1
+	}
+	channels := make([]mixerChannel, channelCount)
+	for i := range channels {
+		channels[i].volume = 1.0
+	}
+	return &Mixer{
+		channels:     channels,
+		masterVolume: 1.0,
+	}
+}
+
+// ChannelCount はチャンネル数を取得する
+func (m *Mixer) ChannelCount() int {
+	return len(m.channels)
+}
+
+// SetChannelVolume はチャンネルの音量を設定する（0.0〜1.0にクランプ）
+func (m *Mixer) SetChannelVolume(channel int, volume float64) error {
+	if err := m.checkChannel(channel); err != nil {
+		return err
+	}
+	m.channels[channel].volume = clampVolume(volume)
+	return nil
+}
+
+// GetChannelVolume はチャンネルの音量を取得する（存在しないチャンネルは0）
+func (m *Mixer) GetChannelVolume(channel int) float64 {
+	if m.checkChannel(channel) != nil {
+		return 0
+	}
+	return m.channels[channel].volume
+}
+
+// SetMasterVolume は全てのチャンネルに掛かるマスター音量を設定する（0.0〜1.0にクランプ）
+func (m *Mixer) SetMasterVolume(volume float64) {
+	m.masterVolume = clampVolume(volume)
+}
+
+// GetMasterVolume はマスター音量を取得する
+func (m *Mixer) GetMasterVolume() float64 {
+	return m.masterVolume
+}
+
+// IsPlaying はチャンネルで効果音を再生中かを判定する
+func (m *Mixer) IsPlaying(channel int) bool {
+	return m.checkChannel(channel) == nil && m.channels[channel].voice != nil
+}
+
+// play は指定したチャンネルで音声を再生する（再生中の音声は置き換える）
+func (m *Mixer) play(channel int, v *voice) error {
+	if err := m.checkChannel(channel); err != nil {
+		return err
+	}
+	m.sequence++
+	m.channels[channel].voice = v
+	m.channels[channel].sequence = m.sequence
+	return nil
+}
+
+// stop はチャンネルの再生を停止する
+func (m *Mixer) stop(channel int) {
+	if m.checkChannel(channel) == nil {
+		m.channels[channel].voice = nil
+	}
+}
+
+// stopAll は全てのチャンネルの再生を停止する
+func (m *Mixer) stopAll() {
+	for i := range m.channels {
+		m.channels[i].voice = nil
+	}
+}
+
+// freeChannel は再生に使うチャンネルを選ぶ
+// 空きチャンネルがなければ最も前に再生を開始したチャンネルを返す
+func (m *Mixer) freeChannel() int {
+	oldest := 0
+	for i, ch := range m.channels {
+		if ch.voice == nil {
+			return i
+		}
+		if ch.sequence < m.channels[oldest].sequence {
+			oldest = i
+		}
+	}
+	return oldest
+}
+
+// mix は再生中のチャンネルのサンプルを out に加算する
+// outChannels は出力のチャンネル数（パンの計算に使用する）
+// マスター音量と範囲の制限は合成の最後に applyMaster でまとめて行う
+func (m *Mixer) mix(out []float32, outChannels int) {
+	if cap(m.scratch) < len(out) {
+		m.scratch = make([]float32, len(out))
+	}
+	scratch := m.scratch[:len(out)]
+
+	for i := range m.channels {
+		ch := &m.channels[i]
+		if ch.voice == nil {
+			continue
+		}
+		if !ch.voice.render(scratch, outChannels) {
+			ch.voice = nil
+		}
+		mixSamples(out, scratch, float32(ch.volume))
+	}
+}
+
+// applyMaster はマスター音量を掛けて合成結果を -1.0〜1.0 に制限する
+func (m *Mixer) applyMaster(out []float32) {
+	scaleSamples(out, float32(m.masterVolume))
+	clampSamples(out)
+}
+
+// checkChannel はチャンネル番号が有効かを確認する
+func (m *Mixer) checkChannel(channel int) error {
+	if channel < 0 || channel >= len(m.channels) {
+		return fmt.Errorf("%w: %d (channels: %d)", ErrInvalidChannel, channel, len(m.channels))
+	}
+	return nil
+}
+
+// mixSamples は src に gain を掛けて dst に加算する
+func mixSamples(dst, src []float32, gain float32) {
+	for i := range dst {
+		if i >= len(src) {
+			return
+		}
+		dst[i] += src[i] * gain
+	}
+}
+
+// scaleSamples はサンプルに gain を掛ける
+func scaleSamples(samples []float32, gain float32) {
+	for i := range samples {
+		samples[i] *= gain
+	}
+}
+
+// clampSamples はサンプルを -1.0〜1.0 の範囲に制限する
+func clampSamples(samples []float32) {
+	for i, sample := range samples {
+		samples[i] = clampSample(sample)
+	}
+}
@@ -0,0 +1,116 @@
+package audio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMixSamples(t *testing.T) {
+	// Arrange
+	dst := []float32{0.1, 0.2, -0.3}
+	src := []float32{0.4, -0.4, 0.2}
+
+	// Act
+	mixSamples(dst, src, 0.5)
+
+	// Assert
+	assert.InDeltaSlice(t, []float32{0.3, 0.0, -0.2}, dst, 1e-6)
+}
+
+func TestMixSamples_ShorterSource(t *testing.T) {
+	dst := []float32{0.1, 0.1}
+
+	mixSamples(dst, []float32{0.2}, 1)
+
+	assert.InDeltaSlice(t, []float32{0.3, 0.1}, dst, 1e-6)
+}
+
+func TestClampSamples(t *testing.T) {
+	// Arrange
+	samples := []float32{1.5, -2, 0.25, 1, -1}
+
+	// Act
+	clampSamples(samples)
+
+	// Assert
+	assert.Equal(t, []float32{1, -1, 0.25, 1, -1}, samples)
+}
+
+func TestNewMixer_Defaults(t *testing.T) {
+	mixer := NewMixer(0)
+
+	assert.Equal(t, 1, mixer.ChannelCount(), "1未満の指定は1チャンネルになる")
+	assert.Equal(t, 1.0, mixer.GetMasterVolume())
+	assert.Equal(t, 1.0, mixer.GetChannelVolume(0))
+}
+
+func TestMixer_Volumes(t *testing.T) {
+	mixer := NewMixer(2)
+
+	require.NoError(t, mixer.SetChannelVolume(1, 1.5))
+	mixer.SetMasterVolume(-0.5)
+
+	assert.Equal(t, 1.0, mixer.GetChannelVolume(1))
+	assert.Equal(t, 0.0, mixer.GetMasterVolume())
+	assert.ErrorIs(t, mixer.SetChannelVolume(2, 0.5), ErrInvalidChannel)
+	assert.Equal(t, 0.0, mixer.GetChannelVolume(-1))
+}
+
+func TestMixer_Mix_SumsChannels(t *testing.T) {
+	// Arrange
+	mixer := NewMixer(2)
+	require.NoError(t, mixer.play(0, &voice{samples: []float32{0.5, 0.5, 0.5}, volume: 1}))
+	require.NoError(t, mixer.play(1, &voice{samples: []float32{0.2, -0.2}, volume: 1}))
+	require.NoError(t, mixer.SetChannelVolume(1, 0.5))
+	out := make([]float32, 3)
+
+	// Act
+	mixer.mix(out, 1)
+
+	// Assert
+	assert.InDeltaSlice(t, []float32{0.6, 0.4, 0.5}, out, 1e-6)
+	assert.True(t, mixer.IsPlaying(0))
+	assert.False(t, mixer.IsPlaying(1), "再生し終えたチャンネルは空く")
+}
+
+func TestMixer_ApplyMaster_ClampsAfterSumming(t *testing.T) {
+	// Arrange
+	mixer := NewMixer(2)
+	require.NoError(t, mixer.play(0, &voice{samples: []float32{0.9, 0.9}, volume: 1}))
+	require.NoError(t, mixer.play(1, &voice{samples: []float32{0.9, -0.3}, volume: 1}))
+	out := make([]float32, 2)
+
+	// Act
+	mixer.mix(out, 1)
+	mixer.applyMaster(out)
+
+	// Assert
+	assert.InDeltaSlice(t, []float32{1.0, 0.6}, out, 1e-6)
+}
+
+func TestMixer_ApplyMaster_ScalesBeforeClamping(t *testing.T) {
+	mixer := NewMixer(1)
+	mixer.SetMasterVolume(0.5)
+	out := []float32{1.6, -0.4}
+
+	mixer.applyMaster(out)
+
+	assert.InDeltaSlice(t, []float32{0.8, -0.2}, out, 1e-6)
+}
+
+func TestMixer_FreeChannel(t *testing.T) {
+	// Arrange
+	mixer := NewMixer(2)
+
+	// Act & Assert
+	assert.Equal(t, 0, mixer.freeChannel())
+	require.NoError(t, mixer.play(1, &voice{samples: []float32{0}}))
+	require.NoError(t, mixer.play(0, &voice{samples: []float32{0}}))
+	assert.Equal(t, 1, mixer.freeChannel(), "空きがなければ最も古いチャンネル")
+
+	mixer.stop(0)
+	assert.Equal(t, 0, mixer.freeChannel())
+	assert.ErrorIs(t, mixer.play(5, &voice{}), ErrInvalidChannel)
+}
@@ -0,0 +1,75 @@
+package audio
+
+import (
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSink はテスト用の音声出力モック
+type MockSink struct {
+	mock.Mock
+
+	// トラックごとの最新の音量
+	musicVolumes map[MusicHandle]float64
+	nextHandle   MusicHandle
+}
+
+// NewMockSink は新しいMockSinkを作成する
+func NewMockSink() *MockSink {
+	return &MockSink{
+		musicVolumes: make(map[MusicHandle]float64),
+		nextHandle:   1,
+	}
+}
+
+func (m *MockSink) PlaySound(filename string, volume, pan float64) error {
+	args := m.Called(filename, volume, pan)
+	return args.Error(0)
+}
+
+func (m *MockSink) PlaySoundOnChannel(filename string, volume, pan float64, channel int) error {
+	args := m.Called(filename, volume, pan, channel)
+	return args.Error(0)
+}
+
+func (m *MockSink) StopChannel(channel int) {
+	m.Called(channel)
+}
+
+func (m *MockSink) PlayMusic(filename string, volume float64) (MusicHandle, error) {
+	args := m.Called(filename, volume)
+	if err := args.Error(0); err != nil {
+		return 0, err
+	}
+
+	handle := m.nextHandle
+	m.nextHandle++
+	m.musicVolumes[handle] = volume
+	return handle, nil
+}
+
+func (m *MockSink) SetMusicVolume(handle MusicHandle, volume float64) {
+	m.Called(handle, volume)
+	if _, exists := m.musicVolumes[handle]; exists {
+		m.musicVolumes[handle] = volume
+	}
+}
+
+func (m *MockSink) StopMusic(handle MusicHandle) {
+	m.Called(handle)
+	delete(m.musicVolumes, handle)
+}
+
+func (m *MockSink) Close() {
+	m.Called()
+}
+
+// MusicVolume は再生中のトラックの最新の音量を取得する（テスト用）
+func (m *MockSink) MusicVolume(handle MusicHandle) (float64, bool) {
+	volume, exists := m.musicVolumes[handle]
+	return volume, exists
+}
+
+// PlayingMusicCount は再生中のトラック数を取得する（テスト用）
+func (m *MockSink) PlayingMusicCount() int {
+	return len(m.musicVolumes)
+}
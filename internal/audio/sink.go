@@ -0,0 +1,36 @@
+package audio
+
+// MusicHandle は再生中の音楽トラックを識別するハンドル
+type MusicHandle int
+
+// Sink は実際の音声出力デバイスを抽象化するインターフェース
+// Manager は音量・パンの計算のみを行い、再生はSinkに委譲する
+type Sink interface {
+	// PlaySound は効果音を指定の音量（0.0〜1.0）とパン（-1.0=左〜1.0=右）で再生する
+	PlaySound(filename string, volume, pan float64) error
+
+	// PlayMusic は音楽をループ再生し、トラックのハンドルを返す
+	// クロスフェードのため複数のトラックを同時に再生できる
+	PlayMusic(filename string, volume float64) (MusicHandle, error)
+
+	// SetMusicVolume は再生中のトラックの音量を変更する
+	SetMusicVolume(handle MusicHandle, volume float64)
+
+	// StopMusic はトラックを停止する
+	StopMusic(handle MusicHandle)
+
+	// Close はデバイスを解放する
+	Close()
+}
+
+// ChannelSink はチャンネルを指定した効果音の再生に対応する Sink
+// 同じチャンネルで再生すると前の効果音を置き換える
+type ChannelSink interface {
+	Sink
+
+	// PlaySoundOnChannel は指定したチャンネルで効果音を再生する
+	PlaySoundOnChannel(filename string, volume, pan float64, channel int) error
+
+	// StopChannel はチャンネルの効果音を停止する
+	StopChannel(channel int)
+}
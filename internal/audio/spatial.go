@@ -0,0 +1,60 @@
+package audio
+
+import (
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+)
+
+// 空間音響のデフォルト値
+const (
+	DefaultMinDistance = 50.0  // この距離までは減衰しない（ピクセル）
+	DefaultMaxDistance = 800.0 // この距離で無音になる（ピクセル）
+	DefaultPanDistance = 400.0 // この水平距離で完全に左右に振られる（ピクセル）
+)
+
+// Falloff は距離による音量減衰の設定
+type Falloff struct {
+	MinDistance float64
+	MaxDistance float64
+	PanDistance float64
+}
+
+// DefaultFalloff はデフォルトの減衰設定を取得する
+func DefaultFalloff() Falloff {
+	return Falloff{
+		MinDistance: DefaultMinDistance,
+		MaxDistance: DefaultMaxDistance,
+		PanDistance: DefaultPanDistance,
+	}
+}
+
+// Attenuation はリスナーからの距離に対する音量倍率（0.0〜1.0）を計算する
+// MinDistance以下では1.0、MaxDistance以上では0.0、その間は線形に減衰する
+func (f Falloff) Attenuation(distance float64) float64 {
+	if distance <= f.MinDistance {
+		return 1.0
+	}
+	if distance >= f.MaxDistance {
+		return 0.0
+	}
+	return 1.0 - (distance-f.MinDistance)/(f.MaxDistance-f.MinDistance)
+}
+
+// Pan は音源のリスナーに対する相対X座標からパン（-1.0=左〜1.0=右）を計算する
+func (f Falloff) Pan(relativeX float64) float64 {
+	if f.PanDistance <= 0 {
+		return 0
+	}
+	pan := relativeX / f.PanDistance
+	if pan < -1 {
+		return -1
+	}
+	if pan > 1 {
+		return 1
+	}
+	return pan
+}
+
+// Spatialize はリスナーと音源の位置から音量倍率とパンを計算する
+func (f Falloff) Spatialize(listener, source mathlib.Vector2) (volume, pan float64) {
+	return f.Attenuation(listener.Distance(source)), f.Pan(source.X - listener.X)
+}
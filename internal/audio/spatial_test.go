@@ -0,0 +1,68 @@
+package audio
+
+import (
+	"testing"
+
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFalloff_Spatialize_AtListener(t *testing.T) {
+	listener := mathlib.Vector2{X: 100, Y: 100}
+
+	volume, pan := DefaultFalloff().Spatialize(listener, listener)
+
+	assert.Equal(t, 1.0, volume)
+	assert.Equal(t, 0.0, pan)
+}
+
+func TestFalloff_Spatialize_FarAway(t *testing.T) {
+	falloff := Falloff{MinDistance: 100, MaxDistance: 500, PanDistance: 400}
+	listener := mathlib.Vector2{X: 0, Y: 0}
+
+	// 減衰区間の中間（距離300）
+	volume, _ := falloff.Spatialize(listener, mathlib.Vector2{X: 0, Y: 300})
+	assert.InDelta(t, 0.5, volume, 1e-9)
+
+	// 最大距離より遠い
+	volume, _ = falloff.Spatialize(listener, mathlib.Vector2{X: 0, Y: 1000})
+	assert.Equal(t, 0.0, volume)
+}
+
+func TestFalloff_Spatialize_ToTheLeft(t *testing.T) {
+	falloff := Falloff{MinDistance: 100, MaxDistance: 500, PanDistance: 400}
+	listener := mathlib.Vector2{X: 0, Y: 0}
+
+	_, pan := falloff.Spatialize(listener, mathlib.Vector2{X: -200, Y: 0})
+	assert.InDelta(t, -0.5, pan, 1e-9)
+
+	_, pan = falloff.Spatialize(listener, mathlib.Vector2{X: -1000, Y: 0})
+	assert.Equal(t, -1.0, pan, "パンは-1にクランプされる")
+}
+
+func TestManager_PlaySoundAt(t *testing.T) {
+	// Arrange
+	sink := NewMockSink()
+	manager := NewManager(sink)
+	manager.SetFalloff(Falloff{MinDistance: 0, MaxDistance: 400, PanDistance: 200})
+	manager.SetVolume(0.5)
+	manager.SetListenerPosition(mathlib.Vector2{X: 100, Y: 0})
+	sink.On("PlaySound", "hit.wav", 0.25, 1.0).Return(nil)
+
+	// Act
+	err := manager.PlaySoundAt("hit.wav", mathlib.Vector2{X: 300, Y: 0})
+
+	// Assert
+	assert.NoError(t, err)
+	sink.AssertExpectations(t)
+}
+
+func TestManager_PlaySoundAt_OutOfRangeIsSilent(t *testing.T) {
+	sink := NewMockSink()
+	manager := NewManager(sink)
+
+	err := manager.PlaySoundAt("hit.wav", mathlib.Vector2{X: 10000, Y: 0})
+
+	assert.NoError(t, err)
+	sink.AssertNotCalled(t, "PlaySound")
+}
@@ -0,0 +1,279 @@
+package audio
+
+import (
+	"encoding/binary"
+	"io"
+	"sync"
+)
+
+// StreamSink の出力形式のデフォルト値
+const (
+	DefaultSampleRate = 44100
+	DefaultChannels   = 2
+)
+
+// voice は再生中の1つの音声（出力形式に変換済みのサンプル）
+type voice struct {
+	samples  []float32
+	position int
+	loop     bool
+	volume   float64
+	pan      float64
+}
+
+// next は次のサンプルを取得する。末尾に達した場合は false を返す（ループ時は先頭に戻る）
+func (v *voice) next() (float32, bool) {
+	if v.position >= len(v.samples) {
+		if !v.loop || len(v.samples) == 0 {
+			return 0, false
+		}
+		v.position = 0
+	}
+	sample := v.samples[v.position]
+	v.position++
+	return sample, true
+}
+
+// render は音量とパンを適用したサンプルで dst を埋める
+// 末尾に達した後は無音で埋め、再生が終わった場合は false を返す
+func (v *voice) render(dst []float32, channels int) bool {
+	playing := true
+	for i := range dst {
+		sample, ok := v.next()
+		if !ok {
+			playing = false
+		}
+		dst[i] = sample * float32(v.volume*panGain(v.pan, i%channels, channels))
+	}
+	return playing
+}
+
+// StreamSink はWAVをデコードして合成し、16bit PCMのストリームとして読み出せる Sink
+// Read で得られるデータ（符号付きリトルエンディアン、チャンネルは交互）を
+// oto などのオーディオデバイスのプレイヤーにそのまま渡して再生する
+// 効果音は Mixer のチャンネルで同時に再生され、空きチャンネルがなければ最も古い効果音を置き換える
+type StreamSink struct {
+	mu         sync.Mutex
+	sampleRate int
+	channels   int
+	closed     bool
+
+	sounds     map[string][]float32 // ファイル名ごとの変換済みサンプル
+	mixer      *Mixer
+	music      map[MusicHandle]*voice
+	nextHandle MusicHandle
+	buffer     []float32
+}
+
+// NewStreamSink は指定した出力形式の StreamSink を作成する
+// 0以下の値を指定した場合はデフォルト値を使用する
+func NewStreamSink(sampleRate, channels int) *StreamSink {
+	if sampleRate <= 0 {
+		sampleRate = DefaultSampleRate
+	}
+	if channels <= 0 {
+		channels = DefaultChannels
+	}
+	return &StreamSink{
+		sampleRate: sampleRate,
+		channels:   channels,
+		sounds:     make(map[string][]float32),
+		mixer:      NewMixer(DefaultMixerChannels),
+		music:      make(map[MusicHandle]*voice),
+		nextHandle: 1,
+	}
+}
+
+// SampleRate は出力のサンプリングレートを取得する
+func (s *StreamSink) SampleRate() int {
+	return s.sampleRate
+}
+
+// Channels は出力のチャンネル数を取得する
+func (s *StreamSink) Channels() int {
+	return s.channels
+}
+
+// PlaySound は効果音を空いているチャンネルで1回再生する
+func (s *StreamSink) PlaySound(filename string, volume, pan float64) error {
+	samples, err := s.load(filename)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.mixer.play(s.mixer.freeChannel(), &voice{samples: samples, volume: volume, pan: pan})
+}
+
+// PlaySoundOnChannel は指定したチャンネルで効果音を1回再生する
+// チャンネルで再生中の効果音は停止する
+func (s *StreamSink) PlaySoundOnChannel(filename string, volume, pan float64, channel int) error {
+	s.mu.Lock()
+	err := s.mixer.checkChannel(channel)
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	samples, err := s.load(filename)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.mixer.play(channel, &voice{samples: samples, volume: volume, pan: pan})
+}
+
+// StopChannel はチャンネルの効果音を停止する
+func (s *StreamSink) StopChannel(channel int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mixer.stop(channel)
+}
+
+// SetChannelVolume はミキサーのチャンネルの音量を設定する
+func (s *StreamSink) SetChannelVolume(channel int, volume float64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.mixer.SetChannelVolume(channel, volume)
+}
+
+// SetMasterVolume は効果音と音楽を合成した出力全体の音量を設定する
+func (s *StreamSink) SetMasterVolume(volume float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mixer.SetMasterVolume(volume)
+}
+
+// PlayMusic は音楽をループ再生し、トラックのハンドルを返す
+func (s *StreamSink) PlayMusic(filename string, volume float64) (MusicHandle, error) {
+	samples, err := s.load(filename)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	handle := s.nextHandle
+	s.nextHandle++
+	s.music[handle] = &voice{samples: samples, loop: true, volume: volume}
+	return handle, nil
+}
+
+// SetMusicVolume は再生中のトラックの音量を変更する
+func (s *StreamSink) SetMusicVolume(handle MusicHandle, volume float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if track, exists := s.music[handle]; exists {
+		track.volume = volume
+	}
+}
+
+// StopMusic はトラックを停止する
+func (s *StreamSink) StopMusic(handle MusicHandle) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.music, handle)
+}
+
+// Close は全ての再生を停止する。以降の Read は io.EOF を返す
+func (s *StreamSink) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	s.mixer.stopAll()
+	s.music = make(map[MusicHandle]*voice)
+	s.sounds = make(map[string][]float32)
+}
+
+// Read は合成したサンプルを16bit符号付きリトルエンディアンPCMとして p に書き込む
+// 再生中の音声がない間は無音を返す
+func (s *StreamSink) Read(p []byte) (int, error) {
+	count := len(p) / 2
+	if cap(s.buffer) < count {
+		s.buffer = make([]float32, count)
+	}
+	samples := s.buffer[:count]
+	if !s.mix(samples) {
+		return 0, io.EOF
+	}
+
+	for i, sample := range samples {
+		binary.LittleEndian.PutUint16(p[i*2:], uint16(toInt16(sample)))
+	}
+	return count * 2, nil
+}
+
+// load はWAVファイルを読み込み、出力形式に変換したサンプルを取得する（読み込み済みの場合はキャッシュを使う）
+func (s *StreamSink) load(filename string) ([]float32, error) {
+	s.mu.Lock()
+	samples, exists := s.sounds[filename]
+	s.mu.Unlock()
+	if exists {
+		return samples, nil
+	}
+
+	sound, err := LoadWAV(filename)
+	if err != nil {
+		return nil, err
+	}
+	samples = convertSound(sound, s.sampleRate, s.channels)
+
+	s.mu.Lock()
+	s.sounds[filename] = samples
+	s.mu.Unlock()
+	return samples, nil
+}
+
+// mix は再生中の音声を合成して out を埋める。閉じられている場合は false を返す
+func (s *StreamSink) mix(out []float32) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false
+	}
+
+	for i := range out {
+		out[i] = 0
+	}
+	for _, track := range s.music {
+		for i := range out {
+			if sample, ok := track.next(); ok {
+				out[i] += sample * float32(track.volume)
+			}
+		}
+	}
+	s.mixer.mix(out, s.channels)
+	s.mixer.applyMaster(out)
+	return true
+}
+
+// panGain はパン（-1.0=左〜1.0=右）に対する各チャンネルの音量倍率を計算する
+// ステレオ以外の出力ではパンを無視する
+func panGain(pan float64, channel, channels int) float64 {
+	if channels != 2 {
+		return 1
+	}
+	if channel == 0 {
+		return clampVolume(1 - pan)
+	}
+	return clampVolume(1 + pan)
+}
+
+// clampSample はサンプルを -1.0〜1.0 の範囲に制限する
+func clampSample(sample float32) float32 {
+	if sample < -1 {
+		return -1
+	}
+	if sample > 1 {
+		return 1
+	}
+	return sample
+}
+
+// toInt16 は -1.0〜1.0 のサンプルを16bit整数に変換する
+func toInt16(sample float32) int16 {
+	return int16(clampSample(sample) * 32767)
+}
@@ -0,0 +1,171 @@
+package audio
+
+import (
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ Sink = (*StreamSink)(nil)
+
+// readSamples はストリームから count 個のサンプルを16bit整数として読み出す
+func readSamples(t *testing.T, sink *StreamSink, count int) []int16 {
+	t.Helper()
+	buf := make([]byte, count*2)
+	n, err := sink.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, len(buf), n)
+
+	samples := make([]int16, count)
+	for i := range samples {
+		samples[i] = int16(binary.LittleEndian.Uint16(buf[i*2:]))
+	}
+	return samples
+}
+
+func TestNewStreamSink_Defaults(t *testing.T) {
+	sink := NewStreamSink(0, 0)
+
+	assert.Equal(t, DefaultSampleRate, sink.SampleRate())
+	assert.Equal(t, DefaultChannels, sink.Channels())
+}
+
+func TestStreamSink_PlaySound_PlaysOnce(t *testing.T) {
+	// Arrange
+	sink := NewStreamSink(8000, 1)
+	path := writeWAVFile(t, "hit.wav", buildWAV(wavFormatPCM, 1, 8000, 16, pcm16(16384, -16384)))
+	require.NoError(t, sink.PlaySound(path, 1.0, 0))
+
+	// Act
+	samples := readSamples(t, sink, 4)
+
+	// Assert
+	assert.Equal(t, []int16{16383, -16383, 0, 0}, samples, "再生し終えたら無音")
+}
+
+func TestStreamSink_PlaySound_Pan(t *testing.T) {
+	// Arrange
+	sink := NewStreamSink(8000, 2)
+	path := writeWAVFile(t, "hit.wav", buildWAV(wavFormatPCM, 1, 8000, 16, pcm16(16384)))
+	require.NoError(t, sink.PlaySound(path, 1.0, 1.0))
+
+	// Act
+	samples := readSamples(t, sink, 2)
+
+	// Assert
+	assert.Equal(t, []int16{0, 16383}, samples, "右に振り切ると左チャンネルは無音")
+}
+
+func TestStreamSink_PlaySound_UnsupportedFormat(t *testing.T) {
+	// Arrange
+	sink := NewStreamSink(8000, 1)
+	path := writeWAVFile(t, "float.wav", buildWAV(3, 1, 8000, 32, make([]byte, 8)))
+
+	// Act
+	err := sink.PlaySound(path, 1.0, 0)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestStreamSink_Music_LoopsWithVolumeUntilStopped(t *testing.T) {
+	// Arrange
+	sink := NewStreamSink(8000, 1)
+	path := writeWAVFile(t, "bgm.wav", buildWAV(wavFormatPCM, 1, 8000, 16, pcm16(16384, 0)))
+	handle, err := sink.PlayMusic(path, 1.0)
+	require.NoError(t, err)
+
+	// Act
+	looped := readSamples(t, sink, 3)
+	sink.SetMusicVolume(handle, 0.5)
+	quieter := readSamples(t, sink, 2)
+	sink.StopMusic(handle)
+	stopped := readSamples(t, sink, 2)
+
+	// Assert
+	assert.Equal(t, []int16{16383, 0, 16383}, looped)
+	assert.Equal(t, []int16{0, 8191}, quieter)
+	assert.Equal(t, []int16{0, 0}, stopped)
+}
+
+func TestStreamSink_Mix_ClampsOutput(t *testing.T) {
+	// Arrange
+	sink := NewStreamSink(8000, 1)
+	path := writeWAVFile(t, "loud.wav", buildWAV(wavFormatPCM, 1, 8000, 16, pcm16(32767)))
+	_, err := sink.PlayMusic(path, 1.0)
+	require.NoError(t, err)
+	require.NoError(t, sink.PlaySound(path, 1.0, 0))
+
+	// Act
+	samples := readSamples(t, sink, 1)
+
+	// Assert
+	assert.Equal(t, int16(32767), samples[0], "合成結果は -1.0〜1.0 に制限される")
+}
+
+func TestStreamSink_Close(t *testing.T) {
+	// Arrange
+	sink := NewStreamSink(8000, 1)
+
+	// Act
+	sink.Close()
+	_, err := sink.Read(make([]byte, 4))
+
+	// Assert
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestManager_WithStreamSink(t *testing.T) {
+	// Arrange
+	sink := NewStreamSink(8000, 1)
+	manager := NewManager(sink)
+	path := writeWAVFile(t, "hit.wav", buildWAV(wavFormatPCM, 1, 8000, 16, pcm16(16384)))
+	require.NoError(t, manager.Initialize())
+	manager.SetVolume(0.5)
+
+	// Act
+	require.NoError(t, manager.PlaySound(path))
+	samples := readSamples(t, sink, 1)
+
+	// Assert
+	assert.Equal(t, []int16{8191}, samples)
+}
+
+var _ ChannelSink = (*StreamSink)(nil)
+
+func TestStreamSink_PlaySound_Overlaps(t *testing.T) {
+	// Arrange
+	sink := NewStreamSink(8000, 1)
+	path := writeWAVFile(t, "hit.wav", buildWAV(wavFormatPCM, 1, 8000, 16, pcm16(8192, 8192)))
+	require.NoError(t, sink.PlaySound(path, 1.0, 0))
+	readSamples(t, sink, 1)
+
+	// Act
+	require.NoError(t, sink.PlaySound(path, 1.0, 0))
+	samples := readSamples(t, sink, 3)
+
+	// Assert
+	assert.Equal(t, []int16{16383, 8191, 0}, samples, "後から再生した効果音が前の効果音を止めない")
+}
+
+func TestStreamSink_Channels(t *testing.T) {
+	// Arrange
+	sink := NewStreamSink(8000, 1)
+	path := writeWAVFile(t, "hit.wav", buildWAV(wavFormatPCM, 1, 8000, 16, pcm16(16384, 16384, 16384)))
+	require.NoError(t, sink.SetChannelVolume(2, 0.5))
+	sink.SetMasterVolume(0.5)
+
+	// Act
+	require.NoError(t, sink.PlaySoundOnChannel(path, 1.0, 0, 2))
+	playing := readSamples(t, sink, 1)
+	sink.StopChannel(2)
+	stopped := readSamples(t, sink, 1)
+
+	// Assert
+	assert.Equal(t, []int16{4095}, playing)
+	assert.Equal(t, []int16{0}, stopped)
+	assert.ErrorIs(t, sink.PlaySoundOnChannel(path, 1.0, 0, DefaultMixerChannels), ErrInvalidChannel)
+}
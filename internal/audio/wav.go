@@ -0,0 +1,206 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// WAV の解析で返すエラー
+var (
+	ErrNotWAV            = errors.New("not a RIFF/WAVE file")
+	ErrMissingFormat     = errors.New("WAV file has no fmt chunk")
+	ErrMissingData       = errors.New("WAV file has no data chunk")
+	ErrUnsupportedFormat = errors.New("unsupported WAV format")
+)
+
+// wavFormatPCM はリニアPCMを表すWAVのフォーマットコード
+const wavFormatPCM = 1
+
+// Format はPCMデータの形式
+type Format struct {
+	SampleRate    int // サンプリングレート（Hz）
+	Channels      int // チャンネル数（1=モノラル, 2=ステレオ）
+	BitsPerSample int // 1サンプルあたりのビット数
+}
+
+// Sound はデコード済みの音声データ
+// Samples はチャンネルごとに交互に並んだ -1.0〜1.0 のサンプル
+type Sound struct {
+	Format  Format
+	Samples []float32
+}
+
+// Frames は全チャンネル分のサンプルをまとめた単位（フレーム）の数を取得する
+func (s *Sound) Frames() int {
+	if s.Format.Channels == 0 {
+		return 0
+	}
+	return len(s.Samples) / s.Format.Channels
+}
+
+// LoadWAV はWAVファイルを読み込んでデコードする
+func LoadWAV(filename string) (*Sound, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read WAV file %s: %w", filename, err)
+	}
+
+	sound, err := DecodeWAV(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode WAV file %s: %w", filename, err)
+	}
+	return sound, nil
+}
+
+// DecodeWAV は8bit/16bitのリニアPCMのWAVデータをデコードする
+func DecodeWAV(r io.Reader) (*Sound, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	format, pcm, err := parseWAV(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Sound{
+		Format:  format,
+		Samples: decodePCM(pcm, format.BitsPerSample),
+	}, nil
+}
+
+// parseWAV はRIFFチャンクを走査し、fmtチャンクの形式とdataチャンクの中身を取得する
+func parseWAV(data []byte) (Format, []byte, error) {
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WAVE" {
+		return Format{}, nil, ErrNotWAV
+	}
+
+	var format Format
+	var pcm []byte
+	hasFormat := false
+
+	offset := 12
+	for offset+8 <= len(data) {
+		chunkID := string(data[offset : offset+4])
+		chunkSize := int(binary.LittleEndian.Uint32(data[offset+4 : offset+8]))
+		body := data[offset+8:]
+		if chunkSize > len(body) {
+			// 途中で切れたファイルは読める範囲だけ使う
+			chunkSize = len(body)
+		}
+		body = body[:chunkSize]
+
+		switch chunkID {
+		case "fmt ":
+			parsed, err := parseFormatChunk(body)
+			if err != nil {
+				return Format{}, nil, err
+			}
+			format = parsed
+			hasFormat = true
+		case "data":
+			pcm = body
+		}
+
+		// チャンクは2バイト境界に揃えられる
+		offset += 8 + chunkSize + chunkSize%2
+	}
+
+	if !hasFormat {
+		return Format{}, nil, ErrMissingFormat
+	}
+	if pcm == nil {
+		return Format{}, nil, ErrMissingData
+	}
+	return format, pcm, nil
+}
+
+// parseFormatChunk はfmtチャンクを解析し、対応していない形式の場合はエラーを返す
+func parseFormatChunk(body []byte) (Format, error) {
+	if len(body) < 16 {
+		return Format{}, fmt.Errorf("%w: fmt chunk too short (%d bytes)", ErrUnsupportedFormat, len(body))
+	}
+
+	audioFormat := binary.LittleEndian.Uint16(body[0:2])
+	format := Format{
+		Channels:      int(binary.LittleEndian.Uint16(body[2:4])),
+		SampleRate:    int(binary.LittleEndian.Uint32(body[4:8])),
+		BitsPerSample: int(binary.LittleEndian.Uint16(body[14:16])),
+	}
+
+	if audioFormat != wavFormatPCM {
+		return Format{}, fmt.Errorf("%w: format code %d (only PCM is supported)", ErrUnsupportedFormat, audioFormat)
+	}
+	if format.BitsPerSample != 8 && format.BitsPerSample != 16 {
+		return Format{}, fmt.Errorf("%w: %d bits per sample (only 8 and 16 are supported)", ErrUnsupportedFormat, format.BitsPerSample)
+	}
+	if format.Channels != 1 && format.Channels != 2 {
+		return Format{}, fmt.Errorf("%w: %d channels (only mono and stereo are supported)", ErrUnsupportedFormat, format.Channels)
+	}
+	if format.SampleRate <= 0 {
+		return Format{}, fmt.Errorf("%w: sample rate %d", ErrUnsupportedFormat, format.SampleRate)
+	}
+	return format, nil
+}
+
+// decodePCM はPCMのバイト列を -1.0〜1.0 のサンプルに変換する
+// 8bitは符号なし、16bitは符号付きリトルエンディアン
+func decodePCM(pcm []byte, bitsPerSample int) []float32 {
+	if bitsPerSample == 8 {
+		samples := make([]float32, len(pcm))
+		for i, b := range pcm {
+			samples[i] = (float32(b) - 128) / 128
+		}
+		return samples
+	}
+
+	samples := make([]float32, len(pcm)/2)
+	for i := range samples {
+		value := int16(binary.LittleEndian.Uint16(pcm[i*2 : i*2+2]))
+		samples[i] = float32(value) / 32768
+	}
+	return samples
+}
+
+// convertSound は音声を出力のサンプリングレート・チャンネル数に変換する
+// サンプリングレートが異なる場合は線形補間でリサンプリングし、モノラルは全チャンネルに複製する
+func convertSound(sound *Sound, sampleRate, channels int) []float32 {
+	srcChannels := sound.Format.Channels
+	srcFrames := sound.Frames()
+	if srcFrames == 0 {
+		return nil
+	}
+
+	dstFrames := srcFrames
+	if sound.Format.SampleRate != sampleRate {
+		dstFrames = int(int64(srcFrames) * int64(sampleRate) / int64(sound.Format.SampleRate))
+	}
+
+	ratio := float64(sound.Format.SampleRate) / float64(sampleRate)
+	out := make([]float32, dstFrames*channels)
+	for frame := 0; frame < dstFrames; frame++ {
+		position := float64(frame) * ratio
+		index := int(position)
+		next := index + 1
+		if next >= srcFrames {
+			next = srcFrames - 1
+		}
+		t := float32(position - float64(index))
+
+		for ch := 0; ch < channels; ch++ {
+			srcCh := ch
+			if srcCh >= srcChannels {
+				srcCh = srcChannels - 1
+			}
+			a := sound.Samples[index*srcChannels+srcCh]
+			b := sound.Samples[next*srcChannels+srcCh]
+			out[frame*channels+ch] = a + (b-a)*t
+		}
+	}
+	return out
+}
@@ -0,0 +1,150 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildWAV はテスト用のWAVデータを作成する
+func buildWAV(audioFormat uint16, channels, sampleRate, bitsPerSample int, pcm []byte) []byte {
+	var buf bytes.Buffer
+	blockAlign := channels * bitsPerSample / 8
+
+	buf.WriteString("RIFF")
+	binary.Write(&buf, binary.LittleEndian, uint32(4+8+16+8+len(pcm)))
+	buf.WriteString("WAVE")
+
+	buf.WriteString("fmt ")
+	binary.Write(&buf, binary.LittleEndian, uint32(16))
+	binary.Write(&buf, binary.LittleEndian, audioFormat)
+	binary.Write(&buf, binary.LittleEndian, uint16(channels))
+	binary.Write(&buf, binary.LittleEndian, uint32(sampleRate))
+	binary.Write(&buf, binary.LittleEndian, uint32(sampleRate*blockAlign))
+	binary.Write(&buf, binary.LittleEndian, uint16(blockAlign))
+	binary.Write(&buf, binary.LittleEndian, uint16(bitsPerSample))
+
+	buf.WriteString("data")
+	binary.Write(&buf, binary.LittleEndian, uint32(len(pcm)))
+	buf.Write(pcm)
+	return buf.Bytes()
+}
+
+// pcm16 は16bitサンプルをリトルエンディアンのバイト列にする
+func pcm16(samples ...int16) []byte {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, samples)
+	return buf.Bytes()
+}
+
+// writeWAVFile はテスト用のWAVファイルを一時ディレクトリに作成する
+func writeWAVFile(t *testing.T, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+	return path
+}
+
+func TestDecodeWAV_16Bit(t *testing.T) {
+	// Arrange
+	data := buildWAV(wavFormatPCM, 2, 22050, 16, pcm16(0, 16384, -32768, 32767))
+
+	// Act
+	sound, err := DecodeWAV(bytes.NewReader(data))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, Format{SampleRate: 22050, Channels: 2, BitsPerSample: 16}, sound.Format)
+	assert.Equal(t, 2, sound.Frames())
+	assert.InDeltaSlice(t, []float32{0, 0.5, -1, 32767.0 / 32768}, sound.Samples, 1e-6)
+}
+
+func TestDecodeWAV_8Bit(t *testing.T) {
+	// Arrange
+	data := buildWAV(wavFormatPCM, 1, 8000, 8, []byte{128, 0, 192})
+
+	// Act
+	sound, err := DecodeWAV(bytes.NewReader(data))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 8, sound.Format.BitsPerSample)
+	assert.InDeltaSlice(t, []float32{0, -1, 0.5}, sound.Samples, 1e-6)
+}
+
+func TestDecodeWAV_SkipsUnknownChunks(t *testing.T) {
+	// Arrange
+	data := buildWAV(wavFormatPCM, 1, 8000, 16, pcm16(100))
+	// fmtチャンクの前に奇数長の LIST チャンク（パディング付き）を挿入する
+	extra := []byte{'L', 'I', 'S', 'T', 3, 0, 0, 0, 'a', 'b', 'c', 0}
+	data = append(append(append([]byte{}, data[:12]...), extra...), data[12:]...)
+
+	// Act
+	sound, err := DecodeWAV(bytes.NewReader(data))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, sound.Samples, 1)
+}
+
+func TestDecodeWAV_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		expected error
+	}{
+		{"not RIFF", []byte("OggS0000WAVE"), ErrNotWAV},
+		{"too short", []byte("RIFF"), ErrNotWAV},
+		{"float format", buildWAV(3, 1, 44100, 32, make([]byte, 8)), ErrUnsupportedFormat},
+		{"24 bit", buildWAV(wavFormatPCM, 1, 44100, 24, make([]byte, 6)), ErrUnsupportedFormat},
+		{"surround", buildWAV(wavFormatPCM, 6, 44100, 16, make([]byte, 12)), ErrUnsupportedFormat},
+		{"no data chunk", buildWAV(wavFormatPCM, 1, 44100, 16, nil)[:36], ErrMissingData},
+		{"no fmt chunk", []byte("RIFF\x0c\x00\x00\x00WAVEdata\x00\x00\x00\x00"), ErrMissingFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := DecodeWAV(bytes.NewReader(tt.data))
+
+			// Assert
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestLoadWAV_MissingFile(t *testing.T) {
+	// Act
+	_, err := LoadWAV(filepath.Join(t.TempDir(), "missing.wav"))
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestConvertSound_MonoToStereo(t *testing.T) {
+	// Arrange
+	sound := &Sound{Format: Format{SampleRate: 100, Channels: 1, BitsPerSample: 16}, Samples: []float32{0.25, -0.5}}
+
+	// Act
+	out := convertSound(sound, 100, 2)
+
+	// Assert
+	assert.Equal(t, []float32{0.25, 0.25, -0.5, -0.5}, out)
+}
+
+func TestConvertSound_Resample(t *testing.T) {
+	// Arrange
+	sound := &Sound{Format: Format{SampleRate: 100, Channels: 1, BitsPerSample: 16}, Samples: []float32{0, 1}}
+
+	// Act
+	// 2倍のサンプリングレートでは間のサンプルを線形補間する
+	out := convertSound(sound, 200, 1)
+
+	// Assert
+	assert.InDeltaSlice(t, []float32{0, 0.5, 1, 1}, out, 1e-6)
+}
@@ -0,0 +1,176 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ganyariya/tinyengine/pkg/tinyengine"
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// keyNames は保存時に使用するキー名とGLFWキーコードの対応表
+var keyNames = map[string]glfw.Key{
+	"Space": glfw.KeySpace, "Enter": glfw.KeyEnter, "Escape": glfw.KeyEscape,
+	"Tab": glfw.KeyTab, "Backspace": glfw.KeyBackspace,
+	"Left": glfw.KeyLeft, "Right": glfw.KeyRight, "Up": glfw.KeyUp, "Down": glfw.KeyDown,
+	"LeftShift": glfw.KeyLeftShift, "RightShift": glfw.KeyRightShift,
+	"LeftControl": glfw.KeyLeftControl, "RightControl": glfw.KeyRightControl,
+	"LeftAlt": glfw.KeyLeftAlt, "RightAlt": glfw.KeyRightAlt,
+	"F1": glfw.KeyF1, "F2": glfw.KeyF2, "F3": glfw.KeyF3, "F4": glfw.KeyF4,
+	"F5": glfw.KeyF5, "F6": glfw.KeyF6, "F7": glfw.KeyF7, "F8": glfw.KeyF8,
+	"F9": glfw.KeyF9, "F10": glfw.KeyF10, "F11": glfw.KeyF11, "F12": glfw.KeyF12,
+}
+
+// keyCodeNames は keyNames の逆引き表
+var keyCodeNames = make(map[glfw.Key]string)
+
+// unnamedKeyPrefix は名前のないキーをキーコードで保存する際の接頭辞（例: "Key320"）
+const unnamedKeyPrefix = "Key"
+
+func init() {
+	// 英字・数字キーはGLFWのキーコードがASCIIと一致する
+	for c := 'A'; c <= 'Z'; c++ {
+		keyNames[string(c)] = glfw.Key(c)
+	}
+	for c := '0'; c <= '9'; c++ {
+		keyNames[string(c)] = glfw.Key(c)
+	}
+	for name, code := range keyNames {
+		keyCodeNames[code] = name
+	}
+}
+
+// keyNameOf はキーコードに対応するキー名を取得する
+func keyNameOf(key int) (string, bool) {
+	name, ok := keyCodeNames[glfw.Key(key)]
+	return name, ok
+}
+
+// encodeKey は保存に使用するキー名を取得する
+// 名前のないキー（記号・テンキーなど）は "Key<キーコード>" として保存する
+func encodeKey(key int) string {
+	if name, ok := keyNameOf(key); ok {
+		return name
+	}
+	return fmt.Sprintf("%s%d", unnamedKeyPrefix, key)
+}
+
+// decodeKey は保存されたキー名からキーコードを取得する（"Key<キーコード>" の形式も受け付ける）
+func decodeKey(name string) (int, bool) {
+	if code, ok := keyNames[name]; ok {
+		return int(code), true
+	}
+	digits, ok := strings.CutPrefix(name, unnamedKeyPrefix)
+	if !ok {
+		return 0, false
+	}
+	code, err := strconv.Atoi(digits)
+	if err != nil {
+		return 0, false
+	}
+	return code, true
+}
+
+// ActionBindings はアクション名とキーの対応を管理する
+// ゲームコードはキーコードではなくアクション名で入力を判定する
+type ActionBindings struct {
+	bindings map[string][]int
+}
+
+// NewActionBindings は新しいActionBindingsを作成する
+func NewActionBindings() *ActionBindings {
+	return &ActionBindings{
+		bindings: make(map[string][]int),
+	}
+}
+
+// Bind はアクションに割り当てるキーを設定する（既存の割り当ては置き換えられる）
+func (b *ActionBindings) Bind(action string, keys ...int) {
+	b.bindings[action] = append([]int(nil), keys...)
+}
+
+// Unbind はアクションの割り当てを削除する
+func (b *ActionBindings) Unbind(action string) {
+	delete(b.bindings, action)
+}
+
+// Keys はアクションに割り当てられたキーを取得する
+func (b *ActionBindings) Keys(action string) []int {
+	return append([]int(nil), b.bindings[action]...)
+}
+
+// Actions は登録されている全アクション名をソート順で取得する
+func (b *ActionBindings) Actions() []string {
+	actions := make([]string, 0, len(b.bindings))
+	for action := range b.bindings {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+	return actions
+}
+
+// IsActionPressed は割り当てられたキーのいずれかが押されているかを確認する
+func (b *ActionBindings) IsActionPressed(input tinyengine.InputManager, action string) bool {
+	for _, key := range b.bindings[action] {
+		if input.IsKeyPressed(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// SaveBindings は割り当てをキー名のJSONとしてファイルに保存する
+// 名前のないキーはキーコードを含む "Key<キーコード>" の形式で保存し、LoadBindings で復元できる
+func (b *ActionBindings) SaveBindings(path string) error {
+	data := make(map[string][]string, len(b.bindings))
+	for action, keys := range b.bindings {
+		names := make([]string, 0, len(keys))
+		for _, key := range keys {
+			names = append(names, encodeKey(key))
+		}
+		data[action] = names
+	}
+
+	encoded, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode bindings: %w", err)
+	}
+	if err := os.WriteFile(path, encoded, 0644); err != nil {
+		return fmt.Errorf("failed to write bindings file %s: %w", path, err)
+	}
+	return nil
+}
+
+// LoadBindings はファイルから割り当てを読み込む
+// ファイルに含まれるアクションのみ置き換え、未知のキー名は警告を出してスキップする
+// ファイルが読めない・JSONが不正な場合はエラーを返し、現在の割り当ては変更しない
+func (b *ActionBindings) LoadBindings(path string) error {
+	encoded, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read bindings file %s: %w", path, err)
+	}
+
+	var data map[string][]string
+	if err := json.Unmarshal(encoded, &data); err != nil {
+		return fmt.Errorf("failed to parse bindings file %s: %w", path, err)
+	}
+
+	for action, names := range data {
+		keys := make([]int, 0, len(names))
+		for _, name := range names {
+			code, ok := decodeKey(name)
+			if !ok {
+				log.Printf("警告: アクション %q の未知のキー %q をスキップします", action, name)
+				continue
+			}
+			keys = append(keys, code)
+		}
+		b.bindings[action] = keys
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeInput はテスト用に入力状態を直接指定できる InputManager
+type fakeInput struct {
+	pressed        map[int]bool
+	justPressed    map[int]bool
+	justReleased   map[int]bool
+	buttons        map[int]bool
+	mouseX, mouseY float64
+	scrollY        float64
+	typed          []rune
+}
+
+func (f *fakeInput) Update()                              {}
+func (f *fakeInput) IsKeyPressed(key int) bool            { return f.pressed[key] }
+func (f *fakeInput) IsKeyJustPressed(key int) bool        { return f.justPressed[key] }
+func (f *fakeInput) IsKeyJustReleased(key int) bool       { return f.justReleased[key] }
+func (f *fakeInput) GetMousePosition() (float64, float64) { return f.mouseX, f.mouseY }
+func (f *fakeInput) IsMouseButtonPressed(button int) bool { return f.buttons[button] }
+func (f *fakeInput) GetScrollDelta() (float64, float64)   { return 0, f.scrollY }
+func (f *fakeInput) GetTypedRunes() []rune                { return f.typed }
+
+func TestActionBindings_IsActionPressed(t *testing.T) {
+	bindings := NewActionBindings()
+	bindings.Bind("jump", int(glfw.KeySpace), int(glfw.KeyW))
+	input := &fakeInput{pressed: map[int]bool{int(glfw.KeyW): true}}
+
+	assert.True(t, bindings.IsActionPressed(input, "jump"))
+	assert.False(t, bindings.IsActionPressed(input, "fire"))
+}
+
+func TestActionBindings_SaveAndLoad_RoundTrip(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "bindings.json")
+	original := NewActionBindings()
+	original.Bind("jump", int(glfw.KeySpace))
+	original.Bind("left", int(glfw.KeyA), int(glfw.KeyLeft))
+	original.Bind("pause", int(glfw.KeyEscape), int(glfw.KeyF1))
+
+	// Act
+	require.NoError(t, original.SaveBindings(path))
+	loaded := NewActionBindings()
+	err := loaded.LoadBindings(path)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, original.Actions(), loaded.Actions())
+	for _, action := range original.Actions() {
+		assert.Equal(t, original.Keys(action), loaded.Keys(action), action)
+	}
+}
+
+func TestActionBindings_SaveAndLoad_UnnamedKeys(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "bindings.json")
+	original := NewActionBindings()
+	original.Bind("zoom", int(glfw.KeyKP0), int(glfw.KeyComma), int(glfw.KeyPageUp))
+
+	// Act
+	require.NoError(t, original.SaveBindings(path))
+	saved, err := os.ReadFile(path)
+	require.NoError(t, err)
+	loaded := NewActionBindings()
+	err = loaded.LoadBindings(path)
+
+	// Assert
+	// 名前のないキーもキーコードとして保存され、読み込み後も失われない
+	require.NoError(t, err)
+	assert.Contains(t, string(saved), "Key320")
+	assert.Equal(t, original.Keys("zoom"), loaded.Keys("zoom"))
+}
+
+func TestDecodeKey(t *testing.T) {
+	code, ok := decodeKey("Space")
+	assert.True(t, ok)
+	assert.Equal(t, int(glfw.KeySpace), code)
+
+	code, ok = decodeKey("Key44")
+	assert.True(t, ok)
+	assert.Equal(t, int(glfw.KeyComma), code)
+
+	_, ok = decodeKey("KeyComma")
+	assert.False(t, ok)
+}
+
+func TestActionBindings_LoadBindings_SkipsUnknownKeys(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "bindings.json")
+	content := `{"jump": ["Space", "NoSuchKey"]}`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	bindings := NewActionBindings()
+	bindings.Bind("fire", int(glfw.KeyZ))
+
+	// Act
+	err := bindings.LoadBindings(path)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []int{int(glfw.KeySpace)}, bindings.Keys("jump"))
+	// ファイルに含まれないアクションはそのまま残る
+	assert.Equal(t, []int{int(glfw.KeyZ)}, bindings.Keys("fire"))
+}
+
+func TestActionBindings_LoadBindings_MalformedFile(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "bindings.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"jump": ["Space"`), 0644))
+	bindings := NewActionBindings()
+	bindings.Bind("jump", int(glfw.KeyW))
+
+	// Act
+	err := bindings.LoadBindings(path)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, []int{int(glfw.KeyW)}, bindings.Keys("jump"), "不正なファイルでは既存の割り当てを変更しない")
+}
+
+func TestActionBindings_LoadBindings_MissingFile(t *testing.T) {
+	bindings := NewActionBindings()
+
+	err := bindings.LoadBindings(filepath.Join(t.TempDir(), "missing.json"))
+
+	assert.Error(t, err)
+}
@@ -7,6 +7,8 @@ import (
 
 // Application は基本的なアプリケーション実装を提供する
 type Application struct {
+	world *World
+
 	// 将来的に追加する予定のフィールド
 	// - シーンマネージャー
 	// - 入力マネージャー
@@ -15,7 +17,14 @@ type Application struct {
 
 // NewApplication は新しいアプリケーションインスタンスを作成する
 func NewApplication() *Application {
-	return &Application{}
+	return &Application{
+		world: NewWorld(),
+	}
+}
+
+// World はアプリケーションが更新・描画するゲームオブジェクトの集合を取得する
+func (app *Application) World() *World {
+	return app.world
 }
 
 // Initialize はアプリケーションを初期化する
@@ -36,6 +45,7 @@ func (app *Application) Update(deltaTime float64) {
 	// - シーンの更新
 	// - 物理演算
 	// - 衝突判定
+	app.world.Update(deltaTime)
 }
 
 // Render は描画処理を行う
@@ -45,6 +55,7 @@ func (app *Application) Render(renderer tinyengine.Renderer) {
 	// - シーンの描画
 	// - UIの描画
 	// - 画面表示
+	app.world.Render(renderer)
 }
 
 // Destroy はアプリケーションの終了処理を行う
@@ -54,4 +65,5 @@ func (app *Application) Destroy() {
 	// - オーディオシステムの終了
 	// - レンダラーの終了
 	// - リソースの解放
+	app.world.Destroy()
 }
\ No newline at end of file
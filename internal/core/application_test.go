@@ -26,4 +26,21 @@ func TestApplication_Lifecycle(t *testing.T) {
 	// GameObjectインターフェースを実装していることを確認
 	var gameObj tinyengine.GameObject = app
 	assert.NotNil(t, gameObj)
-}
\ No newline at end of file
+}
+
+func TestApplication_UpdatesWorldObjects(t *testing.T) {
+	// Arrange
+	app := NewApplication()
+	object := &testGameObject{}
+	assert.NoError(t, app.World().AddObject(object))
+
+	// Act
+	app.Update(0.016)
+	app.Render(nil)
+	app.Destroy()
+
+	// Assert
+	assert.Equal(t, 1, object.updateCount)
+	assert.Equal(t, 1, object.renderCount)
+	assert.Equal(t, 1, object.destroyCount)
+}
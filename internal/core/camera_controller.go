@@ -0,0 +1,125 @@
+package core
+
+import (
+	stdmath "math"
+
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+	"github.com/ganyariya/tinyengine/pkg/tinyengine"
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// カメラコントローラーのデフォルト値
+const (
+	DefaultCameraPanSpeed  = 1.0 // キー操作時の移動速度（ズーム1.0でのワールド単位/秒）
+	DefaultCameraZoomSpeed = 0.1 // スクロール1段あたりのズーム倍率の増分
+)
+
+// CameraController は入力に応じて Camera2D をパン・ズームする
+// 中ボタンドラッグでパン、スクロールでカーソル位置に向かってズーム、WASD/矢印キーでパンする
+type CameraController struct {
+	input  tinyengine.InputManager
+	camera *mathlib.Camera2D
+
+	screenWidth  float64
+	screenHeight float64
+	panSpeed     float64
+	zoomSpeed    float64
+
+	dragging  bool
+	lastMouse mathlib.Vector2
+}
+
+// NewCameraController は新しいCameraControllerを作成する
+func NewCameraController(input tinyengine.InputManager, camera *mathlib.Camera2D, screenWidth, screenHeight float64) *CameraController {
+	return &CameraController{
+		input:        input,
+		camera:       camera,
+		screenWidth:  screenWidth,
+		screenHeight: screenHeight,
+		panSpeed:     DefaultCameraPanSpeed,
+		zoomSpeed:    DefaultCameraZoomSpeed,
+	}
+}
+
+// SetScreenSize は画面サイズを設定する（ウィンドウのリサイズ時に呼び出す）
+func (c *CameraController) SetScreenSize(width, height float64) {
+	c.screenWidth = width
+	c.screenHeight = height
+}
+
+// SetPanSpeed はキー操作時の移動速度を設定する
+func (c *CameraController) SetPanSpeed(speed float64) {
+	c.panSpeed = speed
+}
+
+// SetZoomSpeed はスクロール1段あたりのズーム倍率の増分を設定する
+func (c *CameraController) SetZoomSpeed(speed float64) {
+	c.zoomSpeed = speed
+}
+
+// Update は入力状態に応じてカメラを更新する
+func (c *CameraController) Update(deltaTime float64) {
+	mouseX, mouseY := c.input.GetMousePosition()
+	mouse := mathlib.Vector2{X: mouseX, Y: mouseY}
+
+	// 中ボタンドラッグでパン
+	if c.input.IsMouseButtonPressed(int(glfw.MouseButtonMiddle)) {
+		if c.dragging {
+			delta := dragPanDelta(*c.camera, c.lastMouse, mouse, c.screenWidth, c.screenHeight)
+			c.camera.Move(delta)
+		}
+		c.dragging = true
+	} else {
+		c.dragging = false
+	}
+	c.lastMouse = mouse
+
+	// スクロールでカーソル位置に向かってズーム
+	if _, scrollY := c.input.GetScrollDelta(); scrollY != 0 {
+		c.camera.ZoomToPoint(mouse, scrollZoomFactor(scrollY, c.zoomSpeed), c.screenWidth, c.screenHeight)
+	}
+
+	// キー操作でパン
+	direction := keyboardPanDirection(c.input)
+	c.camera.Move(keyboardPanDelta(direction, c.panSpeed, c.camera.Zoom, deltaTime))
+}
+
+// dragPanDelta はドラッグ前後のカーソル位置から、掴んだワールド座標がカーソルに追従するカメラ移動量を計算する
+func dragPanDelta(camera mathlib.Camera2D, from, to mathlib.Vector2, screenWidth, screenHeight float64) mathlib.Vector2 {
+	fromWorld := camera.ScreenToWorld(from, screenWidth, screenHeight)
+	toWorld := camera.ScreenToWorld(to, screenWidth, screenHeight)
+	return fromWorld.Sub(toWorld)
+}
+
+// scrollZoomFactor はスクロール量からズーム倍率を計算する
+// 上方向（正）で拡大、下方向（負）で縮小し、同じ量の往復で元の倍率に戻る
+func scrollZoomFactor(scrollY, zoomSpeed float64) float64 {
+	return stdmath.Pow(1.0+zoomSpeed, scrollY)
+}
+
+// keyboardPanDirection はWASD/矢印キーの入力から移動方向（ワールド座標系、Y上向き）を取得する
+func keyboardPanDirection(input tinyengine.InputManager) mathlib.Vector2 {
+	direction := mathlib.Vector2{}
+	if input.IsKeyPressed(int(glfw.KeyW)) || input.IsKeyPressed(int(glfw.KeyUp)) {
+		direction.Y += 1
+	}
+	if input.IsKeyPressed(int(glfw.KeyS)) || input.IsKeyPressed(int(glfw.KeyDown)) {
+		direction.Y -= 1
+	}
+	if input.IsKeyPressed(int(glfw.KeyD)) || input.IsKeyPressed(int(glfw.KeyRight)) {
+		direction.X += 1
+	}
+	if input.IsKeyPressed(int(glfw.KeyA)) || input.IsKeyPressed(int(glfw.KeyLeft)) {
+		direction.X -= 1
+	}
+	return direction.Normalize()
+}
+
+// keyboardPanDelta はキー操作によるカメラ移動量を計算する
+// ズームしているほど画面上の速度が一定になるようワールド座標での移動量を小さくする
+func keyboardPanDelta(direction mathlib.Vector2, panSpeed, zoom, deltaTime float64) mathlib.Vector2 {
+	if zoom <= 0 {
+		return mathlib.Vector2{}
+	}
+	return direction.Scale(panSpeed * deltaTime / zoom)
+}
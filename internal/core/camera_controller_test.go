@@ -0,0 +1,83 @@
+package core
+
+import (
+	"testing"
+
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+	"github.com/go-gl/glfw/v3.3/glfw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrollZoomFactor(t *testing.T) {
+	assert.InDelta(t, 1.1, scrollZoomFactor(1, 0.1), 1e-9)
+	assert.InDelta(t, 1.0, scrollZoomFactor(0, 0.1), 1e-9)
+	// 同じ量の往復で元に戻る
+	assert.InDelta(t, 1.0, scrollZoomFactor(2, 0.1)*scrollZoomFactor(-2, 0.1), 1e-9)
+}
+
+func TestKeyboardPanDelta(t *testing.T) {
+	input := &fakeInput{pressed: map[int]bool{int(glfw.KeyW): true, int(glfw.KeyRight): true}}
+
+	direction := keyboardPanDirection(input)
+	delta := keyboardPanDelta(direction, 2.0, 2.0, 0.5)
+
+	// 斜め移動は正規化され、ズーム2倍で移動量は半分になる
+	assert.InDelta(t, 0.5*0.7071067811865476, delta.X, 1e-9)
+	assert.InDelta(t, 0.5*0.7071067811865476, delta.Y, 1e-9)
+}
+
+func TestDragPanDelta_KeepsGrabbedPointUnderCursor(t *testing.T) {
+	camera := mathlib.NewCamera2DWithValues(mathlib.Vector2{X: 0.2, Y: 0.1}, 2.0, 0)
+	from := mathlib.Vector2{X: 400, Y: 300}
+	to := mathlib.Vector2{X: 500, Y: 250}
+	grabbed := camera.ScreenToWorld(from, 800, 600)
+
+	camera.Move(dragPanDelta(camera, from, to, 800, 600))
+
+	after := camera.ScreenToWorld(to, 800, 600)
+	assert.InDelta(t, grabbed.X, after.X, 1e-9)
+	assert.InDelta(t, grabbed.Y, after.Y, 1e-9)
+}
+
+func TestCameraController_MiddleMouseDrag(t *testing.T) {
+	// Arrange
+	camera := mathlib.NewCamera2D()
+	input := &fakeInput{buttons: map[int]bool{int(glfw.MouseButtonMiddle): true}, mouseX: 400, mouseY: 300}
+	controller := NewCameraController(input, &camera, 800, 600)
+
+	// Act: 右へ200px（画面の半分の幅）ドラッグ
+	controller.Update(0.016)
+	input.mouseX = 600
+	controller.Update(0.016)
+
+	// Assert: ズーム1.0では画面の半分の幅はワールド座標で0.5
+	assert.InDelta(t, -0.5, camera.Position.X, 1e-9)
+	assert.InDelta(t, 0.0, camera.Position.Y, 1e-9)
+}
+
+func TestCameraController_ScrollZoomsTowardCursor(t *testing.T) {
+	// Arrange
+	camera := mathlib.NewCamera2D()
+	input := &fakeInput{mouseX: 600, mouseY: 300, scrollY: 1}
+	controller := NewCameraController(input, &camera, 800, 600)
+	controller.SetZoomSpeed(1.0)
+
+	// Act
+	controller.Update(0.016)
+
+	// Assert: カーソル下のワールド座標(0.5, 0)が固定されたまま2倍にズーム
+	assert.InDelta(t, 2.0, camera.Zoom, 1e-9)
+	assert.InDelta(t, 0.25, camera.Position.X, 1e-9)
+}
+
+func TestCameraController_KeyboardPan(t *testing.T) {
+	camera := mathlib.NewCamera2D()
+	input := &fakeInput{pressed: map[int]bool{int(glfw.KeyA): true}}
+	controller := NewCameraController(input, &camera, 800, 600)
+	controller.SetPanSpeed(3.0)
+
+	controller.Update(0.5)
+
+	assert.InDelta(t, -1.5, camera.Position.X, 1e-9)
+	assert.InDelta(t, 0.0, camera.Position.Y, 1e-9)
+}
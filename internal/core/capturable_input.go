@@ -0,0 +1,93 @@
+package core
+
+import (
+	"github.com/ganyariya/tinyengine/pkg/tinyengine"
+)
+
+// CapturableInput は UI が入力を占有している間、ゲームプレイ側への入力を遮断する InputManager
+// テキスト入力やメニューの操作中に同じキー入力がゲームプレイにも届くのを防ぐ
+// UI 側は Underlying() で元の InputManager を参照して入力を受け取る
+type CapturableInput struct {
+	input    tinyengine.InputManager
+	captured bool
+}
+
+// NewCapturableInput は指定した InputManager をラップする CapturableInput を作成する
+func NewCapturableInput(input tinyengine.InputManager) *CapturableInput {
+	return &CapturableInput{
+		input: input,
+	}
+}
+
+// SetInputCaptured は UI が入力を占有しているかを設定する
+func (c *CapturableInput) SetInputCaptured(captured bool) {
+	c.captured = captured
+}
+
+// IsInputCaptured は UI が入力を占有しているかを返す
+func (c *CapturableInput) IsInputCaptured() bool {
+	return c.captured
+}
+
+// Underlying はラップしている元の InputManager を取得する（UI 層向け）
+func (c *CapturableInput) Underlying() tinyengine.InputManager {
+	return c.input
+}
+
+// Update は占有中でも元の InputManager の状態を更新する
+func (c *CapturableInput) Update() {
+	c.input.Update()
+}
+
+// IsKeyPressed は占有中は常に false を返す
+func (c *CapturableInput) IsKeyPressed(key int) bool {
+	if c.captured {
+		return false
+	}
+	return c.input.IsKeyPressed(key)
+}
+
+// IsKeyJustPressed は占有中は常に false を返す
+func (c *CapturableInput) IsKeyJustPressed(key int) bool {
+	if c.captured {
+		return false
+	}
+	return c.input.IsKeyJustPressed(key)
+}
+
+// IsKeyJustReleased は占有中は常に false を返す
+func (c *CapturableInput) IsKeyJustReleased(key int) bool {
+	if c.captured {
+		return false
+	}
+	return c.input.IsKeyJustReleased(key)
+}
+
+// GetMousePosition はマウス座標を取得する（座標は押下状態ではないため占有中もそのまま返す）
+func (c *CapturableInput) GetMousePosition() (float64, float64) {
+	return c.input.GetMousePosition()
+}
+
+// IsMouseButtonPressed は占有中は常に false を返す
+func (c *CapturableInput) IsMouseButtonPressed(button int) bool {
+	if c.captured {
+		return false
+	}
+	return c.input.IsMouseButtonPressed(button)
+}
+
+// GetScrollDelta は占有中は 0 を返す
+func (c *CapturableInput) GetScrollDelta() (float64, float64) {
+	if c.captured {
+		return 0, 0
+	}
+	return c.input.GetScrollDelta()
+}
+
+// GetTypedRunes は占有中は nil を返す（文字入力は UI 層が Underlying() から受け取る）
+func (c *CapturableInput) GetTypedRunes() []rune {
+	if c.captured {
+		return nil
+	}
+	return c.input.GetTypedRunes()
+}
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/ganyariya/tinyengine/pkg/tinyengine"
+	"github.com/go-gl/glfw/v3.3/glfw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapturableInput_Implementation(t *testing.T) {
+	var _ tinyengine.InputManager = (*CapturableInput)(nil)
+}
+
+func TestCapturableInput_PassesThroughWhenNotCaptured(t *testing.T) {
+	// Arrange
+	input := NewCapturableInput(&fakeInput{
+		pressed: map[int]bool{int(glfw.KeySpace): true},
+		buttons: map[int]bool{0: true},
+		mouseX:  10, mouseY: 20,
+		scrollY: 1.5,
+	})
+
+	// Act
+	_, scrollY := input.GetScrollDelta()
+	mouseX, mouseY := input.GetMousePosition()
+
+	// Assert
+	assert.False(t, input.IsInputCaptured())
+	assert.True(t, input.IsKeyPressed(int(glfw.KeySpace)))
+	assert.True(t, input.IsMouseButtonPressed(0))
+	assert.Equal(t, 1.5, scrollY)
+	assert.Equal(t, 10.0, mouseX)
+	assert.Equal(t, 20.0, mouseY)
+}
+
+func TestCapturableInput_SuppressesWhenCaptured(t *testing.T) {
+	// Arrange
+	underlying := &fakeInput{
+		pressed:      map[int]bool{int(glfw.KeySpace): true},
+		justPressed:  map[int]bool{int(glfw.KeySpace): true},
+		justReleased: map[int]bool{int(glfw.KeyEnter): true},
+		buttons:      map[int]bool{0: true},
+		scrollY:      1.5,
+		typed:        []rune("a"),
+	}
+	input := NewCapturableInput(underlying)
+
+	// Act
+	input.SetInputCaptured(true)
+	scrollX, scrollY := input.GetScrollDelta()
+
+	// Assert
+	assert.False(t, input.IsKeyPressed(int(glfw.KeySpace)))
+	assert.False(t, input.IsKeyJustPressed(int(glfw.KeySpace)))
+	assert.False(t, input.IsKeyJustReleased(int(glfw.KeyEnter)))
+	assert.False(t, input.IsMouseButtonPressed(0))
+	assert.Zero(t, scrollX)
+	assert.Zero(t, scrollY)
+	assert.Empty(t, input.GetTypedRunes())
+
+	// UI 層は元の InputManager から入力を受け取れる
+	assert.True(t, input.Underlying().IsKeyPressed(int(glfw.KeySpace)))
+	assert.Equal(t, []rune("a"), input.Underlying().GetTypedRunes())
+
+	// 占有を解除すると再び通過する
+	input.SetInputCaptured(false)
+	assert.True(t, input.IsKeyPressed(int(glfw.KeySpace)))
+	assert.True(t, input.IsKeyJustPressed(int(glfw.KeySpace)))
+	assert.True(t, input.IsKeyJustReleased(int(glfw.KeyEnter)))
+}
+
+func TestCapturableInput_ActionBindingsRespectCapture(t *testing.T) {
+	bindings := NewActionBindings()
+	bindings.Bind("jump", int(glfw.KeySpace))
+	input := NewCapturableInput(&fakeInput{pressed: map[int]bool{int(glfw.KeySpace): true}})
+
+	input.SetInputCaptured(true)
+
+	assert.False(t, bindings.IsActionPressed(input, "jump"))
+}
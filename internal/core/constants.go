@@ -7,4 +7,19 @@ const (
 	DefaultTargetFPS        = 60
 	DefaultFrameTimeSeconds = 1.0 / DefaultTargetFPS
 	DefaultFrameTimeMs      = time.Millisecond * 16 // ~60FPS
+)
+
+// Delta time constants
+const (
+	DefaultMaxDeltaTimeSeconds = 0.1 // 1フレームのデルタタイムの上限（ウィンドウのドラッグや一時停止からの復帰時の飛び防止）
+)
+
+// Frame statistics constants
+const (
+	DefaultFrameStatsWindow = 60 // フレーム統計を計算する直近のフレーム数
+)
+
+// Fixed timestep constants
+const (
+	DefaultFixedMaxFrameTimeSeconds = 0.25 // 1フレームで固定ステップに回す経過時間の上限
 )
\ No newline at end of file
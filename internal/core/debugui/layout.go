@@ -0,0 +1,63 @@
+package debugui
+
+// widgetRect はウィジェットの画面上の矩形（左上原点、ピクセル単位）
+type widgetRect struct {
+	x, y          float64
+	width, height float64
+}
+
+// contains は点が矩形内にあるかを判定する（右端・下端は含まない）
+func (r widgetRect) contains(px, py float64) bool {
+	return px >= r.x && px < r.x+r.width && py >= r.y && py < r.y+r.height
+}
+
+// inset は各辺を指定量だけ内側に縮めた矩形を返す
+func (r widgetRect) inset(amount float64) widgetRect {
+	result := widgetRect{
+		x:      r.x + amount,
+		y:      r.y + amount,
+		width:  r.width - amount*2,
+		height: r.height - amount*2,
+	}
+	if result.width < 0 {
+		result.width = 0
+	}
+	if result.height < 0 {
+		result.height = 0
+	}
+	return result
+}
+
+// SliderValueAt はトラック上のX座標をスライダーの値に変換する
+// トラック外の座標は両端の値にクランプされる
+func SliderValueAt(px, trackX, trackWidth, min, max float64) float64 {
+	if trackWidth <= 0 {
+		return min
+	}
+
+	t := (px - trackX) / trackWidth
+	if t < 0 {
+		t = 0
+	} else if t > 1 {
+		t = 1
+	}
+
+	return min + (max-min)*t
+}
+
+// SliderPositionOf はスライダーの値をトラック上のX座標に変換する
+// 範囲外の値は両端の座標にクランプされる
+func SliderPositionOf(value, trackX, trackWidth, min, max float64) float64 {
+	if max == min {
+		return trackX
+	}
+
+	t := (value - min) / (max - min)
+	if t < 0 {
+		t = 0
+	} else if t > 1 {
+		t = 1
+	}
+
+	return trackX + trackWidth*t
+}
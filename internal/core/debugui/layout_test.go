@@ -0,0 +1,61 @@
+package debugui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWidgetRect_Contains(t *testing.T) {
+	rect := widgetRect{x: 10, y: 20, width: 100, height: 30}
+
+	assert.True(t, rect.contains(10, 20), "左上の角は含む")
+	assert.True(t, rect.contains(60, 35))
+	assert.False(t, rect.contains(110, 35), "右端は含まない")
+	assert.False(t, rect.contains(60, 50), "下端は含まない")
+	assert.False(t, rect.contains(9, 35))
+}
+
+func TestWidgetRect_Inset(t *testing.T) {
+	rect := widgetRect{x: 0, y: 0, width: 10, height: 4}
+
+	inset := rect.inset(3)
+
+	assert.Equal(t, 3.0, inset.x)
+	assert.Equal(t, 4.0, inset.width)
+	assert.Equal(t, 0.0, inset.height, "縮めすぎた場合は0にクランプ")
+}
+
+func TestSliderValueAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		px       float64
+		expected float64
+	}{
+		{"左端", 100, 0},
+		{"中央", 150, 5},
+		{"右端", 200, 10},
+		{"左側の範囲外", 50, 0},
+		{"右側の範囲外", 250, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, SliderValueAt(tt.px, 100, 100, 0, 10), 1e-9)
+		})
+	}
+}
+
+func TestSliderPositionOf(t *testing.T) {
+	assert.InDelta(t, 100.0, SliderPositionOf(-1, 100, 100, -1, 1), 1e-9)
+	assert.InDelta(t, 150.0, SliderPositionOf(0, 100, 100, -1, 1), 1e-9)
+	assert.InDelta(t, 200.0, SliderPositionOf(5, 100, 100, -1, 1), 1e-9, "範囲外はクランプ")
+	assert.Equal(t, 100.0, SliderPositionOf(3, 100, 100, 3, 3), "範囲が0の場合は左端")
+}
+
+func TestSlider_RoundTrip(t *testing.T) {
+	for _, value := range []float64{0.25, 0.5, 0.75} {
+		px := SliderPositionOf(value, 20, 200, 0, 1)
+		assert.InDelta(t, value, SliderValueAt(px, 20, 200, 0, 1), 1e-9)
+	}
+}
@@ -0,0 +1,206 @@
+package debugui
+
+import (
+	"github.com/ganyariya/tinyengine/internal/renderer"
+	"github.com/ganyariya/tinyengine/pkg/tinyengine"
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// レイアウト定数
+const (
+	DefaultRowHeight  = 20.0 // 1ウィジェットあたりの高さ（ピクセル）
+	DefaultPadding    = 4.0  // ウィジェット間・パネル端の余白（ピクセル）
+	DefaultLabelWidth = 80.0 // フォント未指定時のラベル列の幅（ピクセル）
+
+	sliderKnobWidth = 8.0
+)
+
+// labelColor はラベルのテキストの色
+var labelColor = renderer.NewColorRGB(1.0, 1.0, 1.0)
+
+// Panel はデバッグ用の即時モードUIパネル
+// 毎フレーム Begin → Slider/Button/Toggle → End の順に呼び出し、戻り値で値を更新する
+// ホバー・アクティブ状態はウィジェットIDで管理される
+type Panel struct {
+	input    tinyengine.InputManager
+	renderer tinyengine.Renderer
+	font     *renderer.Font
+
+	x, y       float64
+	width      float64
+	labelWidth float64
+	rowHeight  float64
+	cursorY    float64
+
+	mouseX, mouseY float64
+	mouseDown      bool
+	mousePressed   bool // このフレームで押された
+	mouseReleased  bool // このフレームで離された
+
+	hot    string // マウスが乗っているウィジェット
+	active string // 操作中（押下中）のウィジェット
+}
+
+// NewPanel は新しいPanelを作成する
+// font が指定された場合、ラベル列の幅はフォントの計測結果から決定される
+func NewPanel(input tinyengine.InputManager, r tinyengine.Renderer, font *renderer.Font, x, y, width float64) *Panel {
+	rowHeight := DefaultRowHeight
+	if font != nil && font.LineHeight() > rowHeight {
+		rowHeight = font.LineHeight()
+	}
+
+	return &Panel{
+		input:      input,
+		renderer:   r,
+		font:       font,
+		x:          x,
+		y:          y,
+		width:      width,
+		labelWidth: DefaultLabelWidth,
+		rowHeight:  rowHeight,
+	}
+}
+
+// Begin はフレームの開始時に入力状態を取り込む
+func (p *Panel) Begin() {
+	p.mouseX, p.mouseY = p.input.GetMousePosition()
+	down := p.input.IsMouseButtonPressed(int(glfw.MouseButtonLeft))
+	p.mousePressed = down && !p.mouseDown
+	p.mouseReleased = !down && p.mouseDown
+	p.mouseDown = down
+
+	p.cursorY = p.y + DefaultPadding
+	p.hot = ""
+}
+
+// End はフレームの終了処理を行う
+func (p *Panel) End() {
+	if !p.mouseDown {
+		p.active = ""
+	}
+}
+
+// HotID はマウスが乗っているウィジェットのIDを取得する
+func (p *Panel) HotID() string {
+	return p.hot
+}
+
+// ActiveID は操作中のウィジェットのIDを取得する
+func (p *Panel) ActiveID() string {
+	return p.active
+}
+
+// Slider はラベル付きスライダーを描画し、更新後の値を返す
+func (p *Panel) Slider(id, label string, value, min, max float64) float64 {
+	track := p.nextRow(label)
+	p.updateInteraction(id, track)
+
+	if p.active == id {
+		value = SliderValueAt(p.mouseX, track.x, track.width, min, max)
+	}
+
+	p.drawRect(track, 0.2, 0.2, 0.2, 0.8)
+	knobX := SliderPositionOf(value, track.x, track.width, min, max)
+	knob := widgetRect{x: knobX - sliderKnobWidth/2, y: track.y, width: sliderKnobWidth, height: track.height}
+	r, g, b := p.widgetColor(id)
+	p.drawRect(knob, r, g, b, 1.0)
+
+	return value
+}
+
+// Button はラベル付きボタンを描画し、クリックされたフレームで true を返す
+// クリックはボタン上で押してボタン上で離したときに成立する
+func (p *Panel) Button(id, label string) bool {
+	rect := p.nextRow(label)
+	clicked := p.updateInteraction(id, rect)
+
+	r, g, b := p.widgetColor(id)
+	p.drawRect(rect, r, g, b, 0.9)
+
+	return clicked
+}
+
+// Toggle はラベル付きトグルを描画し、更新後の値を返す
+func (p *Panel) Toggle(id, label string, value bool) bool {
+	rect := p.nextRow(label)
+	box := widgetRect{x: rect.x, y: rect.y, width: rect.height, height: rect.height}
+	if p.updateInteraction(id, box) {
+		value = !value
+	}
+
+	r, g, b := p.widgetColor(id)
+	p.drawRect(box, r, g, b, 0.9)
+	if value {
+		p.drawRect(box.inset(box.height/4), 1.0, 1.0, 1.0, 1.0)
+	}
+
+	return value
+}
+
+// nextRow はラベルを描画してラベル列を確保し、ウィジェット本体の矩形を返す
+func (p *Panel) nextRow(label string) widgetRect {
+	labelWidth := p.labelWidth
+	if p.font != nil {
+		w, _ := p.font.Measure(label)
+		if w+DefaultPadding > labelWidth {
+			labelWidth = w + DefaultPadding
+		}
+	}
+
+	rect := widgetRect{
+		x:      p.x + DefaultPadding + labelWidth,
+		y:      p.cursorY,
+		width:  p.width - labelWidth - DefaultPadding*2,
+		height: p.rowHeight,
+	}
+	if rect.width < 0 {
+		rect.width = 0
+	}
+	p.drawLabel(label, p.x+DefaultPadding, p.cursorY)
+	p.cursorY += p.rowHeight + DefaultPadding
+
+	return rect
+}
+
+// updateInteraction はホバー・アクティブ状態を更新し、クリックが成立したかを返す
+func (p *Panel) updateInteraction(id string, rect widgetRect) bool {
+	inside := rect.contains(p.mouseX, p.mouseY)
+	if inside && (p.active == "" || p.active == id) {
+		p.hot = id
+	}
+	if inside && p.mousePressed && p.active == "" {
+		p.active = id
+	}
+
+	return p.mouseReleased && p.active == id && inside
+}
+
+// widgetColor はウィジェットの状態に応じた色を返す
+func (p *Panel) widgetColor(id string) (float32, float32, float32) {
+	switch {
+	case p.active == id:
+		return 0.9, 0.6, 0.2
+	case p.hot == id:
+		return 0.6, 0.6, 0.8
+	default:
+		return 0.4, 0.4, 0.5
+	}
+}
+
+// drawLabel はラベルのテキストをフォントのグリフで行の中央に描画する（フォント未指定の場合は描画しない）
+func (p *Panel) drawLabel(label string, x, rowY float64) {
+	if p.renderer == nil || p.font == nil {
+		return
+	}
+	y := rowY + (p.rowHeight-p.font.LineHeight())/2
+	for _, sprite := range p.font.TextSprites(label, x, y, labelColor) {
+		p.renderer.DrawPrimitive(sprite)
+	}
+}
+
+func (p *Panel) drawRect(rect widgetRect, r, g, b, a float32) {
+	if p.renderer == nil {
+		return
+	}
+	p.renderer.DrawRectangleColor(float32(rect.x), float32(rect.y), float32(rect.width), float32(rect.height), r, g, b, a)
+}
@@ -0,0 +1,153 @@
+package debugui
+
+import (
+	"testing"
+
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+	"github.com/ganyariya/tinyengine/internal/renderer"
+	"github.com/go-gl/glfw/v3.3/glfw"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeInput はマウスの状態だけを持つテスト用の入力
+type fakeInput struct {
+	mouseX, mouseY float64
+	buttons        map[int]bool
+}
+
+func newFakeInput() *fakeInput {
+	return &fakeInput{buttons: make(map[int]bool)}
+}
+
+func (f *fakeInput) Update()                              {}
+func (f *fakeInput) IsKeyPressed(key int) bool            { return false }
+func (f *fakeInput) IsKeyJustPressed(key int) bool        { return false }
+func (f *fakeInput) IsKeyJustReleased(key int) bool       { return false }
+func (f *fakeInput) GetMousePosition() (float64, float64) { return f.mouseX, f.mouseY }
+func (f *fakeInput) IsMouseButtonPressed(button int) bool { return f.buttons[button] }
+func (f *fakeInput) GetScrollDelta() (float64, float64)   { return 0, 0 }
+func (f *fakeInput) GetTypedRunes() []rune                { return nil }
+func (f *fakeInput) moveTo(x, y float64)                  { f.mouseX, f.mouseY = x, y }
+func (f *fakeInput) setLeftButton(down bool)              { f.buttons[int(glfw.MouseButtonLeft)] = down }
+
+// fakeRenderer は描画されたプリミティブを記録するテスト用のレンダラー
+type fakeRenderer struct {
+	primitives []interface{}
+}
+
+func (f *fakeRenderer) Clear()                                        {}
+func (f *fakeRenderer) Present()                                      {}
+func (f *fakeRenderer) SetClearColor(red, green, blue, alpha float32) {}
+func (f *fakeRenderer) DrawRectangle(x, y, width, height float32)     {}
+func (f *fakeRenderer) DrawPrimitive(primitive interface{}) {
+	f.primitives = append(f.primitives, primitive)
+}
+func (f *fakeRenderer) DrawRectangleColor(x, y, width, height float32, red, green, blue, alpha float32) {
+}
+func (f *fakeRenderer) DrawCircle(x, y, radius float32, red, green, blue, alpha float32) {}
+func (f *fakeRenderer) DrawLine(x1, y1, x2, y2 float32, red, green, blue, alpha float32) {}
+func (f *fakeRenderer) DrawTriangle(x1, y1, x2, y2, x3, y3 float32, red, green, blue, alpha float32) {
+}
+func (f *fakeRenderer) DrawPoint(x, y float32, red, green, blue, alpha float32) {}
+
+// frame は1フレーム分のボタンを配置し、クリックされたかを返す
+func frame(panel *Panel) bool {
+	panel.Begin()
+	clicked := panel.Button("ok", "OK")
+	panel.Button("cancel", "Cancel")
+	return clicked
+}
+
+func TestPanel_HotAndActiveTransitions(t *testing.T) {
+	// Arrange
+	// パネル (0, 0) 幅200: ボタン本体は x=84〜196、1行目 y=4〜24、2行目 y=28〜48
+	input := newFakeInput()
+	panel := NewPanel(input, nil, nil, 0, 0, 200)
+
+	// Act & Assert
+	input.moveTo(100, 10)
+	frame(panel)
+	assert.Equal(t, "ok", panel.HotID(), "マウスが乗るとホットになる")
+	assert.Equal(t, "", panel.ActiveID())
+	panel.End()
+
+	input.setLeftButton(true)
+	frame(panel)
+	panel.End()
+	assert.Equal(t, "ok", panel.ActiveID(), "押下するとアクティブになる")
+
+	// 押したまま別のボタンに移動してもアクティブは変わらず、他のボタンはホットにならない
+	input.moveTo(100, 30)
+	frame(panel)
+	panel.End()
+	assert.Equal(t, "", panel.HotID())
+	assert.Equal(t, "ok", panel.ActiveID())
+
+	// ボタンの外で離すとクリックは成立せず、アクティブが解除される
+	input.setLeftButton(false)
+	assert.False(t, frame(panel))
+	panel.End()
+	assert.Equal(t, "", panel.ActiveID())
+
+	// アクティブが解除された次のフレームからマウス下のボタンがホットになる
+	frame(panel)
+	panel.End()
+	assert.Equal(t, "cancel", panel.HotID())
+}
+
+func TestPanel_ButtonClick(t *testing.T) {
+	// Arrange
+	input := newFakeInput()
+	panel := NewPanel(input, nil, nil, 0, 0, 200)
+	input.moveTo(100, 10)
+
+	// Act
+	input.setLeftButton(true)
+	pressed := frame(panel)
+	panel.End()
+	input.setLeftButton(false)
+	released := frame(panel)
+	panel.End()
+
+	// Assert
+	assert.False(t, pressed, "押しただけではクリックにならない")
+	assert.True(t, released, "ボタン上で押して離すとクリックになる")
+	assert.Equal(t, "", panel.ActiveID())
+}
+
+func TestPanel_IgnoresOtherMouseButtons(t *testing.T) {
+	input := newFakeInput()
+	panel := NewPanel(input, nil, nil, 0, 0, 200)
+	input.moveTo(100, 10)
+	input.buttons[int(glfw.MouseButtonRight)] = true
+
+	frame(panel)
+	panel.End()
+
+	assert.Equal(t, "", panel.ActiveID(), "左ボタン以外では操作しない")
+}
+
+func TestPanel_DrawsLabelsWithFont(t *testing.T) {
+	// Arrange
+	font := renderer.NewFont(16, 8)
+	atlas := &renderer.Texture{Width: 64, Height: 64}
+	glyph := renderer.NewTextureRegion(atlas, mathlib.Rect{Max: mathlib.Vector2{X: 8, Y: 16}})
+	for _, r := range "OK" {
+		font.SetGlyph(r, renderer.Glyph{Advance: 8, Region: glyph})
+	}
+	draw := &fakeRenderer{}
+	panel := NewPanel(newFakeInput(), draw, font, 10, 20, 200)
+
+	// Act
+	panel.Begin()
+	panel.Button("ok", "OK")
+	panel.End()
+
+	// Assert
+	// 行の高さ20、フォントの行の高さ16のため、上下に2pxずつ余白を取って描画する
+	assert.Len(t, draw.primitives, 2)
+	first, ok := draw.primitives[0].(*renderer.Sprite)
+	assert.True(t, ok)
+	assert.Equal(t, [2]float32{14, 26}, [2]float32{first.X, first.Y})
+	assert.Equal(t, atlas, first.Texture)
+}
@@ -13,14 +13,32 @@ type Engine struct {
 	running     bool
 	application tinyengine.GameObject
 	lastTime    time.Time
+
+	// デルタタイムの上限（0以下の場合は制限しない）
+	maxDeltaTime float64
+
+	// 直近のフレーム時間の統計
+	frameStats *frameStatsWindow
+
+	// 固定ステップ更新（nilの場合は無効）
+	fixedLoop *FixedLoop
+
+	// 毎フレームの可変ステップ更新（nilの場合は無効）
+	variableUpdate func(dt float64)
+
+	// フレーム処理時間の監視
+	gameLoop *GameLoop
 }
 
 // NewEngine は新しいエンジンインスタンスを作成する
 func NewEngine(title string, width, height int) *Engine {
 	return &Engine{
-		title:  title,
-		width:  width,
-		height: height,
+		title:        title,
+		width:        width,
+		height:       height,
+		maxDeltaTime: DefaultMaxDeltaTimeSeconds,
+		frameStats:   newFrameStatsWindow(DefaultFrameStatsWindow),
+		gameLoop:     NewGameLoop(),
 	}
 }
 
@@ -29,6 +47,86 @@ func (e *Engine) SetApplication(app tinyengine.GameObject) {
 	e.application = app
 }
 
+// SetMaxDeltaTime は1フレームのデルタタイムの上限（秒）を設定する
+// ウィンドウのドラッグ中や一時停止からの復帰で経過時間が大きくなっても、Update には上限の値が渡される
+// 0以下を指定すると制限しない
+func (e *Engine) SetMaxDeltaTime(seconds float64) {
+	e.maxDeltaTime = seconds
+}
+
+// GetMaxDeltaTime は1フレームのデルタタイムの上限（秒）を返す
+func (e *Engine) GetMaxDeltaTime() float64 {
+	return e.maxDeltaTime
+}
+
+// clampDeltaTime はデルタタイムを上限までに制限する
+func (e *Engine) clampDeltaTime(deltaTime float64) float64 {
+	if e.maxDeltaTime > 0 && deltaTime > e.maxDeltaTime {
+		return e.maxDeltaTime
+	}
+	return deltaTime
+}
+
+// SetStatsWindow はフレーム統計を計算する直近のフレーム数を設定する
+// 設定するとそれまでに記録したフレーム時間は破棄される
+func (e *Engine) SetStatsWindow(frames int) {
+	e.frameStats = newFrameStatsWindow(frames)
+}
+
+// GetFrameStats は直近のフレームのFPS・平均/最短/最長フレーム時間を返す
+// フレーム時間はデルタタイムの上限で制限する前の実測値
+func (e *Engine) GetFrameStats() FrameStats {
+	return e.frameStats.Stats()
+}
+
+// SetFixedUpdate は固定レート（stepHz回/秒）で呼び出される更新関数を設定する
+// 可変ステップのUpdateとは別に呼び出されるため、物理演算などに利用できる
+// fnがnil、またはstepHzが0以下の場合は固定ステップ更新を無効にする
+func (e *Engine) SetFixedUpdate(fn func(dt float64), stepHz int) {
+	if fn == nil || stepHz <= 0 {
+		e.fixedLoop = nil
+		return
+	}
+	e.fixedLoop = NewFixedLoop(stepHz, fn)
+}
+
+// SetVariableUpdate は毎フレーム、固定ステップ更新の後・アプリケーションのUpdateの前に呼び出される更新関数を設定する
+// nilを指定すると無効にする
+func (e *Engine) SetVariableUpdate(fn func(dt float64)) {
+	e.variableUpdate = fn
+}
+
+// GetFixedUpdateAlpha は描画時の補間係数（持ち越した時間 / 固定ステップ間隔）を返す
+// 固定ステップ更新が無効の場合は 0 を返す
+func (e *Engine) GetFixedUpdateAlpha() float64 {
+	if e.fixedLoop == nil {
+		return 0
+	}
+	return e.fixedLoop.Alpha()
+}
+
+// SetFrameBudget はフレーム処理時間の上限（ミリ秒）を設定する
+// 1フレームの更新・描画が上限を超えるとWARNレベルのログを出力する
+// 0以下を指定すると監視を無効にする
+func (e *Engine) SetFrameBudget(maxMillis float64) {
+	e.gameLoop.SetFrameBudget(maxMillis)
+}
+
+// GetFrameBudget はフレーム処理時間の上限（ミリ秒）を返す
+func (e *Engine) GetFrameBudget() float64 {
+	return e.gameLoop.GetFrameBudget()
+}
+
+// SetClock はフレーム処理時間の計測に使用するClockを設定する（nilの場合はtime.Now）
+func (e *Engine) SetClock(clock Clock) {
+	e.gameLoop.SetClock(clock)
+}
+
+// SetLogger はフレーム処理時間の警告の出力に使用するLoggerを設定する
+func (e *Engine) SetLogger(logger *Logger) {
+	e.gameLoop.SetLogger(logger)
+}
+
 // Run はゲームループを開始する
 func (e *Engine) Run() error {
 	if e.application == nil {
@@ -50,11 +148,7 @@ func (e *Engine) Run() error {
 		deltaTime := now.Sub(e.lastTime).Seconds()
 		e.lastTime = now
 
-		// 更新処理
-		e.application.Update(deltaTime)
-
-		// 描画処理（レンダラーは後で実装）
-		e.application.Render(nil)
+		e.tick(deltaTime)
 
 		// フレームレート制限（60FPS）
 		time.Sleep(DefaultFrameTimeMs)
@@ -65,6 +159,37 @@ func (e *Engine) Run() error {
 	return nil
 }
 
+// tick は1フレーム分の更新・描画を行う
+func (e *Engine) tick(deltaTime float64) {
+	e.gameLoop.BeginFrame()
+	defer e.gameLoop.EndFrame()
+
+	e.frameStats.Add(deltaTime * 1000)
+	deltaTime = e.clampDeltaTime(deltaTime)
+
+	// 固定ステップ更新
+	e.runFixedUpdates(deltaTime)
+
+	// 可変ステップ更新
+	if e.variableUpdate != nil {
+		e.variableUpdate(deltaTime)
+	}
+
+	// 更新処理
+	e.application.Update(deltaTime)
+
+	// 描画処理（レンダラーは後で実装）
+	e.application.Render(nil)
+}
+
+// runFixedUpdates は蓄積時間に応じて固定ステップ更新を呼び出し、その回数を返す
+func (e *Engine) runFixedUpdates(deltaTime float64) int {
+	if e.fixedLoop == nil {
+		return 0
+	}
+	return e.fixedLoop.Advance(deltaTime)
+}
+
 // Stop はゲームループを停止する
 func (e *Engine) Stop() {
 	e.running = false
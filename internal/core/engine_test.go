@@ -1,6 +1,8 @@
 package core
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 	"time"
 	"github.com/stretchr/testify/assert"
@@ -14,6 +16,7 @@ type testApplication struct {
 	rendered    bool
 	destroyed   bool
 	updateCount int
+	lastDelta   float64
 }
 
 func (app *testApplication) Initialize() error {
@@ -24,6 +27,7 @@ func (app *testApplication) Initialize() error {
 func (app *testApplication) Update(deltaTime float64) {
 	app.updated = true
 	app.updateCount++
+	app.lastDelta = deltaTime
 }
 
 func (app *testApplication) Render(renderer tinyengine.Renderer) {
@@ -71,4 +75,193 @@ func TestEngine_GameLoop(t *testing.T) {
 	assert.True(t, app.rendered)
 	assert.True(t, app.destroyed)
 	assert.Greater(t, app.updateCount, 0)
-}
\ No newline at end of file
+}
+
+func TestEngine_SetFixedUpdate(t *testing.T) {
+	engine := NewEngine("テスト", 800, 600)
+	app := &testApplication{}
+	engine.SetApplication(app)
+
+	fixedCount := 0
+	var fixedDelta float64
+	engine.SetFixedUpdate(func(dt float64) {
+		fixedCount++
+		fixedDelta = dt
+	}, 64)
+
+	// 1/128秒のフレームを128回 = 1秒分を注入
+	for i := 0; i < 128; i++ {
+		engine.tick(1.0 / 128.0)
+	}
+
+	assert.Equal(t, 64, fixedCount)
+	assert.Equal(t, 1.0/64.0, fixedDelta)
+	assert.Equal(t, 128, app.updateCount)
+}
+
+func TestEngine_SetFixedUpdate_LargeFrame(t *testing.T) {
+	engine := NewEngine("テスト", 800, 600)
+
+	fixedCount := 0
+	engine.SetFixedUpdate(func(dt float64) { fixedCount++ }, 32)
+
+	// 1フレームで0.25秒経過した場合は8回呼び出される
+	steps := engine.runFixedUpdates(0.25)
+
+	assert.Equal(t, 8, steps)
+	assert.Equal(t, 8, fixedCount)
+}
+
+func TestEngine_SetFixedUpdate_AccumulatesRemainder(t *testing.T) {
+	engine := NewEngine("テスト", 800, 600)
+
+	fixedCount := 0
+	engine.SetFixedUpdate(func(dt float64) { fixedCount++ }, 4)
+
+	// 1ステップ(0.25秒)に満たない場合は呼び出されない
+	assert.Equal(t, 0, engine.runFixedUpdates(0.125))
+	// 蓄積時間が1ステップに達すると呼び出される
+	assert.Equal(t, 1, engine.runFixedUpdates(0.125))
+	assert.Equal(t, 1, fixedCount)
+	assert.Zero(t, engine.GetFixedUpdateAlpha())
+
+	engine.runFixedUpdates(0.125)
+	assert.InDelta(t, 0.5, engine.GetFixedUpdateAlpha(), 1e-9)
+}
+
+func TestEngine_SetFixedUpdate_Disable(t *testing.T) {
+	engine := NewEngine("テスト", 800, 600)
+
+	fixedCount := 0
+	engine.SetFixedUpdate(func(dt float64) { fixedCount++ }, 60)
+	engine.SetFixedUpdate(nil, 60)
+
+	assert.Equal(t, 0, engine.runFixedUpdates(1.0))
+	assert.Equal(t, 0, fixedCount)
+
+	// stepHzが0以下の場合も無効
+	engine.SetFixedUpdate(func(dt float64) { fixedCount++ }, 0)
+	assert.Equal(t, 0, engine.runFixedUpdates(1.0))
+	assert.Equal(t, 0, fixedCount)
+}
+
+func TestEngine_SetVariableUpdate_RunsBetweenFixedAndApplication(t *testing.T) {
+	// Arrange
+	engine := NewEngine("テスト", 800, 600)
+	app := &testApplication{}
+	engine.SetApplication(app)
+	engine.SetMaxDeltaTime(0)
+	order := make([]string, 0)
+	var variableDelta float64
+	engine.SetFixedUpdate(func(dt float64) { order = append(order, "fixed") }, 4)
+	engine.SetVariableUpdate(func(dt float64) {
+		order = append(order, "variable")
+		variableDelta = dt
+		assert.Equal(t, 0, app.updateCount, "アプリケーションのUpdateより先に呼び出される")
+	})
+
+	// Act
+	engine.tick(0.25)
+	engine.SetVariableUpdate(nil)
+	engine.tick(0.25)
+
+	// Assert
+	assert.Equal(t, []string{"fixed", "variable", "fixed"}, order)
+	assert.Equal(t, 0.25, variableDelta)
+}
+
+func TestEngine_ClampsDeltaTime(t *testing.T) {
+	// Arrange
+	engine := NewEngine("テスト", 800, 600)
+	app := &testApplication{}
+	engine.SetApplication(app)
+	engine.SetMaxDeltaTime(0.05)
+
+	// Act
+	// 2秒間フレームが止まった場合
+	engine.tick(2.0)
+
+	// Assert
+	assert.Equal(t, 0.05, app.lastDelta)
+
+	// 上限以下のデルタタイムはそのまま渡す
+	engine.tick(0.02)
+	assert.Equal(t, 0.02, app.lastDelta)
+}
+
+func TestEngine_MaxDeltaTime_DefaultAndDisable(t *testing.T) {
+	// Arrange
+	engine := NewEngine("テスト", 800, 600)
+	app := &testApplication{}
+	engine.SetApplication(app)
+
+	// Act & Assert
+	assert.Equal(t, DefaultMaxDeltaTimeSeconds, engine.GetMaxDeltaTime())
+	engine.tick(2.0)
+	assert.Equal(t, DefaultMaxDeltaTimeSeconds, app.lastDelta)
+
+	engine.SetMaxDeltaTime(0)
+	engine.tick(2.0)
+	assert.Equal(t, 2.0, app.lastDelta, "0以下では制限しない")
+}
+
+func TestEngine_GetFrameStats(t *testing.T) {
+	// Arrange
+	engine := NewEngine("テスト", 800, 600)
+	engine.SetApplication(&testApplication{})
+	engine.SetStatsWindow(2)
+
+	// Act
+	engine.tick(0.5)
+	engine.tick(0.010)
+	engine.tick(0.030)
+	stats := engine.GetFrameStats()
+
+	// Assert
+	// ウィンドウは直近2フレーム、デルタタイムの制限前の値で計算する
+	assert.InDelta(t, 20.0, stats.AvgFrameTimeMs, 1e-9)
+	assert.InDelta(t, 10.0, stats.MinFrameTimeMs, 1e-9)
+	assert.InDelta(t, 30.0, stats.MaxFrameTimeMs, 1e-9)
+	assert.InDelta(t, 50.0, stats.FPS, 1e-9)
+}
+
+// slowApplication は Update の中で時計を進めて処理時間を模擬する
+type slowApplication struct {
+	testApplication
+	clock     *fakeClock
+	durations []time.Duration
+}
+
+func (app *slowApplication) Update(deltaTime float64) {
+	app.testApplication.Update(deltaTime)
+	app.clock.Advance(app.durations[app.updateCount-1])
+}
+
+func TestEngine_FrameBudget_WarnsOnlyForSlowFrame(t *testing.T) {
+	// Arrange
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var output bytes.Buffer
+	engine := NewEngine("テスト", 800, 600)
+	engine.SetApplication(&slowApplication{
+		clock:     clock,
+		durations: []time.Duration{10 * time.Millisecond, 25 * time.Millisecond},
+	})
+	engine.SetClock(clock.Now)
+	engine.SetLogger(NewLogger(&output, LogLevelInfo))
+	engine.SetFrameBudget(16)
+
+	// Act: 予算内のフレーム
+	engine.tick(0.016)
+
+	// Assert
+	assert.Empty(t, output.String())
+
+	// Act: 予算超過のフレーム
+	engine.tick(0.016)
+
+	// Assert
+	assert.Equal(t, 16.0, engine.GetFrameBudget())
+	assert.Contains(t, output.String(), "[WARN]")
+	assert.Contains(t, output.String(), "25.00ms")
+	assert.Equal(t, 1, strings.Count(output.String(), "\n"))
+}
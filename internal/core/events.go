@@ -0,0 +1,94 @@
+package core
+
+import (
+	"sync"
+)
+
+// EventHandler はイベントを受け取る関数
+type EventHandler func(data interface{})
+
+// SubscriptionToken は購読を識別するトークン（Unsubscribe に使用する）
+type SubscriptionToken uint64
+
+// subscription は1件の購読を表す
+type subscription struct {
+	token   SubscriptionToken
+	handler EventHandler
+}
+
+// EventBus はイベントの種類ごとに購読者へイベントを配信する
+// 衝突判定からオーディオやスコアへの通知など、システム同士を直接参照させずに連携させる
+// 配信は Publish の呼び出し中に同期的に、購読した順に行われる
+type EventBus struct {
+	mu            sync.Mutex
+	subscriptions map[string][]subscription
+	eventTypes    map[SubscriptionToken]string
+	nextToken     SubscriptionToken
+}
+
+// NewEventBus は新しいEventBusを作成する
+func NewEventBus() *EventBus {
+	return &EventBus{
+		subscriptions: make(map[string][]subscription),
+		eventTypes:    make(map[SubscriptionToken]string),
+	}
+}
+
+// Subscribe は指定した種類のイベントの購読を登録し、解除用のトークンを返す
+func (b *EventBus) Subscribe(eventType string, handler EventHandler) SubscriptionToken {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.nextToken++
+	token := b.nextToken
+	b.subscriptions[eventType] = append(b.subscriptions[eventType], subscription{token: token, handler: handler})
+	b.eventTypes[token] = eventType
+	return token
+}
+
+// Unsubscribe はトークンに対応する購読を解除する
+// 既に解除済み、または未知のトークンの場合は false を返す
+func (b *EventBus) Unsubscribe(token SubscriptionToken) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	eventType, exists := b.eventTypes[token]
+	if !exists {
+		return false
+	}
+	delete(b.eventTypes, token)
+
+	subscriptions := b.subscriptions[eventType]
+	for i, sub := range subscriptions {
+		if sub.token == token {
+			b.subscriptions[eventType] = append(subscriptions[:i:i], subscriptions[i+1:]...)
+			break
+		}
+	}
+	if len(b.subscriptions[eventType]) == 0 {
+		delete(b.subscriptions, eventType)
+	}
+	return true
+}
+
+// Publish は指定した種類のイベントを購読者全員に配信する
+// 購読者がいない場合は何もしない
+// ハンドラー内での購読・解除は次の Publish から反映される
+func (b *EventBus) Publish(eventType string, data interface{}) {
+	b.mu.Lock()
+	subscriptions := b.subscriptions[eventType]
+	b.mu.Unlock()
+
+	for _, sub := range subscriptions {
+		if sub.handler != nil {
+			sub.handler(data)
+		}
+	}
+}
+
+// SubscriberCount は指定した種類のイベントの購読者数を返す
+func (b *EventBus) SubscriberCount(eventType string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subscriptions[eventType])
+}
@@ -0,0 +1,70 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventBus_Publish_AllSubscribersReceive(t *testing.T) {
+	// Arrange
+	bus := NewEventBus()
+	var received []string
+	bus.Subscribe("collision", func(data interface{}) { received = append(received, "audio:"+data.(string)) })
+	bus.Subscribe("collision", func(data interface{}) { received = append(received, "score:"+data.(string)) })
+	bus.Subscribe("other", func(data interface{}) { received = append(received, "other") })
+
+	// Act
+	bus.Publish("collision", "enemy")
+
+	// Assert
+	assert.Equal(t, []string{"audio:enemy", "score:enemy"}, received, "購読した順に配信する")
+}
+
+func TestEventBus_Unsubscribe_StopsDelivery(t *testing.T) {
+	// Arrange
+	bus := NewEventBus()
+	first, second := 0, 0
+	token := bus.Subscribe("hit", func(interface{}) { first++ })
+	bus.Subscribe("hit", func(interface{}) { second++ })
+
+	// Act
+	bus.Publish("hit", nil)
+	removed := bus.Unsubscribe(token)
+	removedAgain := bus.Unsubscribe(token)
+	bus.Publish("hit", nil)
+
+	// Assert
+	assert.True(t, removed)
+	assert.False(t, removedAgain)
+	assert.Equal(t, 1, first)
+	assert.Equal(t, 2, second)
+	assert.Equal(t, 1, bus.SubscriberCount("hit"))
+}
+
+func TestEventBus_Publish_NoSubscribers(t *testing.T) {
+	// Arrange
+	bus := NewEventBus()
+
+	// Act & Assert
+	assert.NotPanics(t, func() { bus.Publish("nothing", 42) })
+	assert.Equal(t, 0, bus.SubscriberCount("nothing"))
+}
+
+func TestEventBus_UnsubscribeDuringPublish(t *testing.T) {
+	// Arrange
+	bus := NewEventBus()
+	calls := 0
+	var token SubscriptionToken
+	token = bus.Subscribe("once", func(interface{}) {
+		calls++
+		bus.Unsubscribe(token)
+	})
+
+	// Act
+	bus.Publish("once", nil)
+	bus.Publish("once", nil)
+
+	// Assert
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,74 @@
+package core
+
+// FixedLoop は可変の経過時間を蓄積し、一定間隔の固定ステップ更新に変換する
+// 物理演算などをフレームレートに依存せず決定的に進めるために使用する
+// 描画側は Alpha() で直前と最新の固定ステップの間を補間できる（InterpolatedTransform と組み合わせる）
+type FixedLoop struct {
+	step         float64
+	accumulator  float64
+	maxFrameTime float64
+	update       func(dt float64)
+}
+
+// NewFixedLoop は stepHz 回/秒で update を呼び出す FixedLoop を作成する
+// stepHz が0以下の場合は DefaultTargetFPS を使用する
+func NewFixedLoop(stepHz int, update func(dt float64)) *FixedLoop {
+	if stepHz <= 0 {
+		stepHz = DefaultTargetFPS
+	}
+	return &FixedLoop{
+		step:         1.0 / float64(stepHz),
+		maxFrameTime: DefaultFixedMaxFrameTimeSeconds,
+		update:       update,
+	}
+}
+
+// SetMaxFrameTime は1フレームで蓄積する経過時間の上限（秒）を設定する
+// 処理落ちで固定ステップが追いつかなくなり、さらに処理が重くなる悪循環を防ぐ
+// 0以下を指定すると上限を設けない
+func (l *FixedLoop) SetMaxFrameTime(seconds float64) {
+	l.maxFrameTime = seconds
+}
+
+// GetMaxFrameTime は1フレームで蓄積する経過時間の上限（秒）を返す
+func (l *FixedLoop) GetMaxFrameTime() float64 {
+	return l.maxFrameTime
+}
+
+// Step は固定ステップの間隔（秒）を返す
+func (l *FixedLoop) Step() float64 {
+	return l.step
+}
+
+// Advance は実際の経過時間（秒）を蓄積し、蓄積分に応じた回数だけ固定ステップ更新を呼び出す
+// 呼び出した回数を返す。余りは次のフレームに持ち越す
+func (l *FixedLoop) Advance(elapsed float64) int {
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	if l.maxFrameTime > 0 && elapsed > l.maxFrameTime {
+		elapsed = l.maxFrameTime
+	}
+
+	l.accumulator += elapsed
+	steps := 0
+	for l.accumulator >= l.step {
+		if l.update != nil {
+			l.update(l.step)
+		}
+		l.accumulator -= l.step
+		steps++
+	}
+	return steps
+}
+
+// Alpha は持ち越した時間が次の固定ステップの何割に当たるか（0.0〜1.0未満）を返す
+// 描画時に直前と最新の状態を補間する係数として使用する
+func (l *FixedLoop) Alpha() float64 {
+	return l.accumulator / l.step
+}
+
+// Reset は蓄積した時間を破棄する
+func (l *FixedLoop) Reset() {
+	l.accumulator = 0
+}
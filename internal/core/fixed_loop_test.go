@@ -0,0 +1,87 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixedLoop_Advance_StepCounts(t *testing.T) {
+	// Arrange
+	steps := 0
+	loop := NewFixedLoop(10, func(dt float64) { steps++ })
+	frames := []float64{0.05, 0.05, 0.25, 0.0, 0.125}
+	expected := []int{0, 1, 2, 0, 1}
+
+	// Act & Assert
+	for i, frame := range frames {
+		assert.Equal(t, expected[i], loop.Advance(frame), "frame %d", i)
+	}
+	assert.Equal(t, 4, steps)
+}
+
+func TestFixedLoop_Advance_PassesFixedDelta(t *testing.T) {
+	// Arrange
+	var deltas []float64
+	loop := NewFixedLoop(8, func(dt float64) { deltas = append(deltas, dt) })
+
+	// Act
+	loop.Advance(0.25)
+
+	// Assert
+	assert.Equal(t, []float64{0.125, 0.125}, deltas)
+}
+
+func TestFixedLoop_Alpha(t *testing.T) {
+	// Arrange
+	loop := NewFixedLoop(8, nil)
+
+	// Act
+	loop.Advance(0.1875)
+
+	// Assert
+	// 0.125秒で1ステップ、残り0.0625秒は次のステップの半分
+	assert.InDelta(t, 0.5, loop.Alpha(), 1e-9)
+}
+
+func TestFixedLoop_ClampsLargeFrames(t *testing.T) {
+	// Arrange
+	steps := 0
+	loop := NewFixedLoop(60, func(dt float64) { steps++ })
+	loop.SetMaxFrameTime(0.1)
+
+	// Act
+	// 2秒の処理落ちでも上限の0.1秒分しか進めない
+	count := loop.Advance(2.0)
+
+	// Assert
+	assert.Equal(t, 6, count)
+	assert.Equal(t, 6, steps)
+	assert.Less(t, loop.Alpha(), 1.0)
+}
+
+func TestFixedLoop_NoClampWhenDisabled(t *testing.T) {
+	// Arrange
+	loop := NewFixedLoop(4, nil)
+	loop.SetMaxFrameTime(0)
+
+	// Act & Assert
+	assert.Equal(t, 8, loop.Advance(2.0))
+}
+
+func TestFixedLoop_DefaultsAndReset(t *testing.T) {
+	// Arrange
+	loop := NewFixedLoop(0, nil)
+
+	// Act
+	loop.Advance(-1)
+	loop.Advance(DefaultFrameTimeSeconds / 2)
+	alpha := loop.Alpha()
+	loop.Reset()
+
+	// Assert
+	assert.Equal(t, DefaultFrameTimeSeconds, loop.Step())
+	assert.Equal(t, DefaultFixedMaxFrameTimeSeconds, loop.GetMaxFrameTime())
+	assert.InDelta(t, 0.5, alpha, 1e-9)
+	assert.Zero(t, loop.Alpha())
+}
@@ -0,0 +1,70 @@
+package core
+
+// FrameStats は直近のフレームの処理時間の統計
+type FrameStats struct {
+	FPS            float64 // 平均フレーム時間から求めたフレームレート
+	AvgFrameTimeMs float64 // 平均フレーム時間（ミリ秒）
+	MinFrameTimeMs float64 // 最短フレーム時間（ミリ秒）
+	MaxFrameTimeMs float64 // 最長フレーム時間（ミリ秒）
+}
+
+// frameStatsWindow は直近 size フレーム分のフレーム時間を保持するリングバッファ
+type frameStatsWindow struct {
+	samples []float64
+	next    int
+	count   int
+}
+
+// newFrameStatsWindow は指定したフレーム数の統計ウィンドウを作成する（1未満は1として扱う）
+func newFrameStatsWindow(size int) *frameStatsWindow {
+	if size < 1 {
+		size = 1
+	}
+	return &frameStatsWindow{
+		samples: make([]float64, size),
+	}
+}
+
+// Add はフレーム時間（ミリ秒）を追加する。ウィンドウが一杯の場合は最も古い値を上書きする
+func (w *frameStatsWindow) Add(frameTimeMs float64) {
+	w.samples[w.next] = frameTimeMs
+	w.next = (w.next + 1) % len(w.samples)
+	if w.count < len(w.samples) {
+		w.count++
+	}
+}
+
+// Size はウィンドウのフレーム数を返す
+func (w *frameStatsWindow) Size() int {
+	return len(w.samples)
+}
+
+// Stats はウィンドウ内のフレーム時間から統計を計算する
+// フレームが記録されていない場合はゼロ値を返す
+func (w *frameStatsWindow) Stats() FrameStats {
+	if w.count == 0 {
+		return FrameStats{}
+	}
+
+	sum := 0.0
+	min, max := w.samples[0], w.samples[0]
+	for _, sample := range w.samples[:w.count] {
+		sum += sample
+		if sample < min {
+			min = sample
+		}
+		if sample > max {
+			max = sample
+		}
+	}
+
+	stats := FrameStats{
+		AvgFrameTimeMs: sum / float64(w.count),
+		MinFrameTimeMs: min,
+		MaxFrameTimeMs: max,
+	}
+	if stats.AvgFrameTimeMs > 0 {
+		stats.FPS = 1000.0 / stats.AvgFrameTimeMs
+	}
+	return stats
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFrameStatsWindow_Stats(t *testing.T) {
+	// Arrange
+	window := newFrameStatsWindow(4)
+
+	// Act
+	for _, frameTime := range []float64{10, 20, 30, 40} {
+		window.Add(frameTime)
+	}
+	stats := window.Stats()
+
+	// Assert
+	assert.Equal(t, 25.0, stats.AvgFrameTimeMs)
+	assert.Equal(t, 10.0, stats.MinFrameTimeMs)
+	assert.Equal(t, 40.0, stats.MaxFrameTimeMs)
+	assert.Equal(t, 40.0, stats.FPS)
+}
+
+func TestFrameStatsWindow_RollsOverOldFrames(t *testing.T) {
+	// Arrange
+	window := newFrameStatsWindow(3)
+	for _, frameTime := range []float64{100, 10, 20} {
+		window.Add(frameTime)
+	}
+
+	// Act
+	// 最も古い100msのフレームが押し出される
+	window.Add(30)
+	stats := window.Stats()
+
+	// Assert
+	assert.Equal(t, 20.0, stats.AvgFrameTimeMs)
+	assert.Equal(t, 10.0, stats.MinFrameTimeMs)
+	assert.Equal(t, 30.0, stats.MaxFrameTimeMs)
+}
+
+func TestFrameStatsWindow_PartiallyFilled(t *testing.T) {
+	// Arrange
+	window := newFrameStatsWindow(60)
+
+	// Act
+	window.Add(16)
+	window.Add(18)
+	stats := window.Stats()
+
+	// Assert
+	assert.Equal(t, 17.0, stats.AvgFrameTimeMs, "記録済みのフレームのみで計算する")
+	assert.Equal(t, 16.0, stats.MinFrameTimeMs)
+	assert.Equal(t, 18.0, stats.MaxFrameTimeMs)
+}
+
+func TestFrameStatsWindow_Empty(t *testing.T) {
+	// Arrange
+	window := newFrameStatsWindow(0)
+
+	// Act & Assert
+	assert.Equal(t, FrameStats{}, window.Stats())
+	assert.Equal(t, 1, window.Size())
+}
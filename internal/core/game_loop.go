@@ -4,11 +4,20 @@ import (
 	"time"
 )
 
+// Clock は現在時刻を返す関数（テストで差し替え可能）
+type Clock func() time.Time
+
 // GameLoop はゲームループの管理を行う
 type GameLoop struct {
 	lastTime    time.Time
 	targetFPS   int
 	frameTime   float64
+	clock       Clock
+	logger      *Logger
+
+	// フレーム処理時間の監視
+	frameBudgetMs float64 // 0以下の場合は監視しない
+	frameStart    time.Time
 }
 
 // NewGameLoop は新しいゲームループインスタンスを作成する
@@ -17,12 +26,28 @@ func NewGameLoop() *GameLoop {
 		lastTime:  time.Now(),
 		targetFPS: DefaultTargetFPS,
 		frameTime: DefaultFrameTimeSeconds,
+		clock:     time.Now,
+		logger:    defaultLogger(),
+	}
+}
+
+// SetClock は時刻の取得に使用するClockを設定する（nilの場合はtime.Now）
+func (gl *GameLoop) SetClock(clock Clock) {
+	if clock == nil {
+		clock = time.Now
 	}
+	gl.clock = clock
+	gl.lastTime = clock()
+}
+
+// SetLogger は警告の出力に使用するLoggerを設定する
+func (gl *GameLoop) SetLogger(logger *Logger) {
+	gl.logger = logger
 }
 
 // GetDeltaTime は前フレームからの経過時間を返す（秒）
 func (gl *GameLoop) GetDeltaTime() float64 {
-	now := time.Now()
+	now := gl.clock()
 	deltaTime := now.Sub(gl.lastTime).Seconds()
 	gl.lastTime = now
 	return deltaTime
@@ -44,8 +69,35 @@ func (gl *GameLoop) GetTargetFrameTime() float64 {
 	return gl.frameTime
 }
 
+// SetFrameBudget はフレーム処理時間の上限（ミリ秒）を設定する
+// BeginFrame から EndFrame までの時間が上限を超えるとWARNレベルのログを出力する
+// 0以下を指定すると監視を無効にする
+func (gl *GameLoop) SetFrameBudget(maxMillis float64) {
+	gl.frameBudgetMs = maxMillis
+}
+
+// GetFrameBudget はフレーム処理時間の上限（ミリ秒）を返す
+func (gl *GameLoop) GetFrameBudget() float64 {
+	return gl.frameBudgetMs
+}
+
+// BeginFrame はフレーム処理時間の計測を開始する
+func (gl *GameLoop) BeginFrame() {
+	gl.frameStart = gl.clock()
+}
+
+// EndFrame はフレーム処理時間の計測を終了し、処理時間（ミリ秒）を返す
+// 上限を超えた場合は計測時間を含む警告を出力する
+func (gl *GameLoop) EndFrame() float64 {
+	elapsedMs := float64(gl.clock().Sub(gl.frameStart)) / float64(time.Millisecond)
+	if gl.frameBudgetMs > 0 && elapsedMs > gl.frameBudgetMs && gl.logger != nil {
+		gl.logger.Warnf("フレーム処理時間が上限を超えました: %.2fms (上限 %.2fms)", elapsedMs, gl.frameBudgetMs)
+	}
+	return elapsedMs
+}
+
 // SleepForFrameRate はフレームレート制限のためのスリープを行う
 func (gl *GameLoop) SleepForFrameRate() {
 	sleepDuration := time.Duration(gl.frameTime * float64(time.Second))
 	time.Sleep(sleepDuration)
-}
\ No newline at end of file
+}
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 	"time"
 	"github.com/stretchr/testify/assert"
@@ -30,4 +32,72 @@ func TestGameLoop_FrameRate(t *testing.T) {
 	expectedFrameTime := 1.0 / 60.0
 	frameTime := loop.GetTargetFrameTime()
 	assert.InDelta(t, expectedFrameTime, frameTime, 0.001)
-}
\ No newline at end of file
+}
+
+// fakeClock は呼び出し側が進める時刻を返すテスト用の時計
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestGameLoop_DeltaTimeWithClock(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	loop := NewGameLoop()
+	loop.SetClock(clock.Now)
+
+	clock.Advance(250 * time.Millisecond)
+
+	assert.InDelta(t, 0.25, loop.GetDeltaTime(), 1e-9)
+}
+
+func TestGameLoop_FrameBudget_WarnsOnlyForSlowFrame(t *testing.T) {
+	// Arrange
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var output bytes.Buffer
+	loop := NewGameLoop()
+	loop.SetClock(clock.Now)
+	loop.SetLogger(NewLogger(&output, LogLevelInfo))
+	loop.SetFrameBudget(16)
+
+	// Act: 予算内のフレーム
+	loop.BeginFrame()
+	clock.Advance(10 * time.Millisecond)
+	fastMs := loop.EndFrame()
+
+	// Assert
+	assert.InDelta(t, 10.0, fastMs, 1e-9)
+	assert.Empty(t, output.String())
+
+	// Act: 予算超過のフレーム
+	loop.BeginFrame()
+	clock.Advance(25 * time.Millisecond)
+	slowMs := loop.EndFrame()
+
+	// Assert
+	assert.InDelta(t, 25.0, slowMs, 1e-9)
+	assert.Contains(t, output.String(), "[WARN]")
+	assert.Contains(t, output.String(), "25.00ms")
+	assert.Equal(t, 1, strings.Count(output.String(), "\n"))
+}
+
+func TestGameLoop_FrameBudget_DisabledByDefault(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var output bytes.Buffer
+	loop := NewGameLoop()
+	loop.SetClock(clock.Now)
+	loop.SetLogger(NewLogger(&output, LogLevelDebug))
+
+	loop.BeginFrame()
+	clock.Advance(time.Second)
+	loop.EndFrame()
+
+	assert.Equal(t, 0.0, loop.GetFrameBudget())
+	assert.Empty(t, output.String())
+}
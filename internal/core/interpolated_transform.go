@@ -0,0 +1,58 @@
+package core
+
+import (
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+)
+
+// InterpolatedTransform は固定タイムステップ更新と可変レート描画の間でTransformを補間する
+// 固定更新では Transform() で得た作業用Transformを変更し、更新後に Commit() を呼び出す
+// 描画時は Interpolated(alpha) で直前の状態と最新の状態の間を補間した値を使用する
+type InterpolatedTransform struct {
+	previous mathlib.Transform
+	current  mathlib.Transform
+	working  mathlib.Transform
+}
+
+// NewInterpolatedTransform は初期状態のTransformで新しいInterpolatedTransformを作成する
+func NewInterpolatedTransform(initial mathlib.Transform) *InterpolatedTransform {
+	return &InterpolatedTransform{
+		previous: initial,
+		current:  initial,
+		working:  initial,
+	}
+}
+
+// Transform は固定更新中に変更する作業用Transformを取得する
+func (it *InterpolatedTransform) Transform() *mathlib.Transform {
+	return &it.working
+}
+
+// Commit は固定更新の結果を確定する
+// 最新の状態を直前の状態へずらし、作業用Transformを新しい最新の状態にする
+func (it *InterpolatedTransform) Commit() {
+	it.previous = it.current
+	it.current = it.working
+}
+
+// Teleport は補間せずに即座に指定のTransformへ移動する
+func (it *InterpolatedTransform) Teleport(transform mathlib.Transform) {
+	it.previous = transform
+	it.current = transform
+	it.working = transform
+}
+
+// Previous は直前の固定更新で確定した状態を取得する
+func (it *InterpolatedTransform) Previous() mathlib.Transform {
+	return it.previous
+}
+
+// Current は最新の固定更新で確定した状態を取得する
+func (it *InterpolatedTransform) Current() mathlib.Transform {
+	return it.current
+}
+
+// Interpolated は直前の状態と最新の状態を alpha（0〜1）で補間したTransformを取得する
+// alpha には Engine.GetFixedUpdateAlpha の補間係数（持ち越した時間 / 固定ステップ間隔）を渡す
+func (it *InterpolatedTransform) Interpolated(alpha float64) mathlib.Transform {
+	return it.previous.Lerp(it.current, alpha)
+}
@@ -0,0 +1,69 @@
+package core
+
+import (
+	stdmath "math"
+	"testing"
+
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+	"github.com/stretchr/testify/assert"
+)
+
+// stepTo は固定更新1回分として作業用Transformを変更して確定する
+func stepTo(it *InterpolatedTransform, position mathlib.Vector2, rotation float64) {
+	it.Transform().SetPosition(position)
+	it.Transform().SetRotation(rotation)
+	it.Commit()
+}
+
+func TestInterpolatedTransform_Alpha(t *testing.T) {
+	// Arrange
+	it := NewInterpolatedTransform(mathlib.NewTransform())
+	stepTo(it, mathlib.Vector2{X: 10, Y: 0}, 0)
+	stepTo(it, mathlib.Vector2{X: 20, Y: 10}, stdmath.Pi/2)
+
+	// Act
+	atPrevious := it.Interpolated(0)
+	atCurrent := it.Interpolated(1)
+	midpoint := it.Interpolated(0.5)
+
+	// Assert
+	assert.True(t, atPrevious.Equals(it.Previous()))
+	assert.InDelta(t, 10.0, atPrevious.Position.X, 1e-9)
+	assert.True(t, atCurrent.Equals(it.Current()))
+	assert.InDelta(t, 20.0, atCurrent.Position.X, 1e-9)
+	assert.InDelta(t, 15.0, midpoint.Position.X, 1e-9)
+	assert.InDelta(t, 5.0, midpoint.Position.Y, 1e-9)
+	assert.InDelta(t, stdmath.Pi/4, midpoint.Rotation, 1e-9)
+}
+
+func TestInterpolatedTransform_WrapAroundRotation(t *testing.T) {
+	it := NewInterpolatedTransform(mathlib.NewTransform())
+	stepTo(it, mathlib.Vector2{}, mathlib.DegreesToRad(350))
+	stepTo(it, mathlib.Vector2{}, mathlib.DegreesToRad(10))
+
+	midpoint := it.Interpolated(0.5)
+
+	// 350° → 10° の中間は 180° ではなく 0°
+	assert.InDelta(t, 0.0, stdmath.Remainder(midpoint.Rotation, mathlib.TwoPi), 1e-9)
+}
+
+func TestInterpolatedTransform_CommitBeforeChangeKeepsState(t *testing.T) {
+	it := NewInterpolatedTransform(mathlib.NewTransform())
+	stepTo(it, mathlib.Vector2{X: 5, Y: 5}, 0)
+
+	// 変更なしで確定すると直前と最新が一致し、補間しても動かない
+	it.Commit()
+
+	assert.True(t, it.Interpolated(0.3).Equals(it.Current()))
+}
+
+func TestInterpolatedTransform_Teleport(t *testing.T) {
+	it := NewInterpolatedTransform(mathlib.NewTransform())
+	stepTo(it, mathlib.Vector2{X: 5, Y: 0}, 0)
+
+	target := mathlib.NewTransformWithValues(mathlib.Vector2{X: 100, Y: 100}, 0, mathlib.Vector2{X: 1, Y: 1})
+	it.Teleport(target)
+
+	assert.True(t, it.Interpolated(0).Equals(target))
+	assert.True(t, it.Transform().Equals(target))
+}
@@ -0,0 +1,32 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// KeyName はキーボードレイアウトに応じたキーの表示名を取得する
+// GLFWのキーコードは物理位置に基づくため、表示には glfw.GetKeyName のローカライズ名を優先する
+// ローカライズ名がないキー（矢印・ファンクションキーなど）は固定のキー名にフォールバックする
+// GLFWの初期化後に呼び出す必要がある
+func KeyName(key, scancode int) string {
+	return keyNameWith(func(key, scancode int) string {
+		return glfw.GetKeyName(glfw.Key(key), scancode)
+	}, key, scancode)
+}
+
+// keyNameWith は指定した名前取得関数を使ってキーの表示名を決定する
+func keyNameWith(lookup func(key, scancode int) string, key, scancode int) string {
+	if lookup != nil {
+		if name := lookup(key, scancode); name != "" {
+			return strings.ToUpper(name)
+		}
+	}
+
+	if key == int(glfw.KeyUnknown) {
+		return fmt.Sprintf("Scancode%d", scancode)
+	}
+	return encodeKey(key)
+}
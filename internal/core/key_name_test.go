@@ -0,0 +1,33 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyNameWith_UsesLocalizedName(t *testing.T) {
+	// AZERTY配列では物理位置Qのキーが "a" になる
+	lookup := func(key, scancode int) string { return "a" }
+
+	assert.Equal(t, "A", keyNameWith(lookup, int(glfw.KeyQ), 24))
+}
+
+func TestKeyNameWith_EmptyNameFallsBackToKeyName(t *testing.T) {
+	empty := func(key, scancode int) string { return "" }
+
+	assert.Equal(t, "Left", keyNameWith(empty, int(glfw.KeyLeft), 113))
+	assert.Equal(t, "F5", keyNameWith(empty, int(glfw.KeyF5), 71))
+}
+
+func TestKeyNameWith_NilLookupFallsBack(t *testing.T) {
+	assert.Equal(t, "Space", keyNameWith(nil, int(glfw.KeySpace), 65))
+}
+
+func TestKeyNameWith_UnknownKeys(t *testing.T) {
+	empty := func(key, scancode int) string { return "" }
+
+	assert.Equal(t, "Scancode200", keyNameWith(empty, int(glfw.KeyUnknown), 200))
+	assert.Equal(t, "Key348", keyNameWith(empty, int(glfw.KeyMenu), 135))
+}
@@ -0,0 +1,92 @@
+package core
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+)
+
+// LogLevel はログの重要度を表す
+type LogLevel int
+
+const (
+	LogLevelDebug LogLevel = iota
+	LogLevelInfo
+	LogLevelWarn
+	LogLevelError
+)
+
+// String はログレベルの表示名を返す
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelDebug:
+		return "DEBUG"
+	case LogLevelInfo:
+		return "INFO"
+	case LogLevelWarn:
+		return "WARN"
+	case LogLevelError:
+		return "ERROR"
+	default:
+		return fmt.Sprintf("LEVEL(%d)", int(l))
+	}
+}
+
+// Logger はレベル付きのログ出力を行う
+// 設定したレベル未満のメッセージは出力されない
+type Logger struct {
+	logger *log.Logger
+	level  LogLevel
+}
+
+// NewLogger は指定の出力先とレベルで新しいLoggerを作成する
+func NewLogger(out io.Writer, level LogLevel) *Logger {
+	return &Logger{
+		logger: log.New(out, "", log.LstdFlags),
+		level:  level,
+	}
+}
+
+// defaultLogger は標準エラー出力にINFO以上を出力するLoggerを作成する
+func defaultLogger() *Logger {
+	return NewLogger(os.Stderr, LogLevelInfo)
+}
+
+// SetLevel は出力する最低レベルを設定する
+func (l *Logger) SetLevel(level LogLevel) {
+	l.level = level
+}
+
+// GetLevel は出力する最低レベルを返す
+func (l *Logger) GetLevel() LogLevel {
+	return l.level
+}
+
+// Debugf はDEBUGレベルのログを出力する
+func (l *Logger) Debugf(format string, args ...interface{}) {
+	l.logf(LogLevelDebug, format, args...)
+}
+
+// Infof はINFOレベルのログを出力する
+func (l *Logger) Infof(format string, args ...interface{}) {
+	l.logf(LogLevelInfo, format, args...)
+}
+
+// Warnf はWARNレベルのログを出力する
+func (l *Logger) Warnf(format string, args ...interface{}) {
+	l.logf(LogLevelWarn, format, args...)
+}
+
+// Errorf はERRORレベルのログを出力する
+func (l *Logger) Errorf(format string, args ...interface{}) {
+	l.logf(LogLevelError, format, args...)
+}
+
+// logf はレベルが有効な場合のみメッセージを出力する
+func (l *Logger) logf(level LogLevel, format string, args ...interface{}) {
+	if level < l.level {
+		return
+	}
+	l.logger.Printf("[%s] %s", level, fmt.Sprintf(format, args...))
+}
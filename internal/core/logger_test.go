@@ -0,0 +1,37 @@
+package core
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogger_FiltersByLevel(t *testing.T) {
+	// Arrange
+	var output bytes.Buffer
+	logger := NewLogger(&output, LogLevelWarn)
+
+	// Act
+	logger.Debugf("debug %d", 1)
+	logger.Infof("info %d", 2)
+	logger.Warnf("warn %d", 3)
+	logger.Errorf("error %d", 4)
+
+	// Assert
+	assert.NotContains(t, output.String(), "debug 1")
+	assert.NotContains(t, output.String(), "info 2")
+	assert.Contains(t, output.String(), "[WARN] warn 3")
+	assert.Contains(t, output.String(), "[ERROR] error 4")
+}
+
+func TestLogger_SetLevel(t *testing.T) {
+	var output bytes.Buffer
+	logger := NewLogger(&output, LogLevelError)
+
+	logger.SetLevel(LogLevelDebug)
+	logger.Debugf("visible")
+
+	assert.Equal(t, LogLevelDebug, logger.GetLevel())
+	assert.Contains(t, output.String(), "[DEBUG] visible")
+}
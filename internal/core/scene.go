@@ -0,0 +1,94 @@
+package core
+
+import (
+	"github.com/ganyariya/tinyengine/pkg/tinyengine"
+)
+
+// Scene はメニュー・ゲームプレイ・ゲームオーバーなどの1画面分の処理を表す
+type Scene interface {
+	// Enter はシーンがスタックに積まれたときに呼び出される
+	Enter()
+
+	// Exit はシーンがスタックから取り除かれたときに呼び出される
+	Exit()
+
+	// Update はシーンが最前面にある間、フレーム毎に呼び出される
+	Update(deltaTime float64)
+
+	// Render はシーンが最前面にある間、フレーム毎に呼び出される
+	Render(renderer tinyengine.Renderer)
+}
+
+// SceneManager はシーンをスタックで管理する
+// 最前面（スタックの一番上）のシーンのみ更新・描画する
+// Push で積まれたシーンの下にあるシーンは Exit されず、上のシーンが Pop されると再び最前面になる
+type SceneManager struct {
+	scenes []Scene
+}
+
+// NewSceneManager は新しいSceneManagerを作成する
+func NewSceneManager() *SceneManager {
+	return &SceneManager{
+		scenes: make([]Scene, 0),
+	}
+}
+
+// Push はシーンを最前面に積み、Enter を呼び出す（一時停止メニューなど）
+func (m *SceneManager) Push(scene Scene) {
+	if scene == nil {
+		return
+	}
+	m.scenes = append(m.scenes, scene)
+	scene.Enter()
+}
+
+// Pop は最前面のシーンを取り除いて Exit を呼び出し、取り除いたシーンを返す
+// シーンがない場合は nil を返す
+func (m *SceneManager) Pop() Scene {
+	if len(m.scenes) == 0 {
+		return nil
+	}
+
+	top := m.scenes[len(m.scenes)-1]
+	m.scenes[len(m.scenes)-1] = nil
+	m.scenes = m.scenes[:len(m.scenes)-1]
+	top.Exit()
+	return top
+}
+
+// Switch は最前面のシーンを入れ替える（メニューからゲームプレイへの遷移など）
+// 現在のシーンの Exit の後に新しいシーンの Enter を呼び出す
+func (m *SceneManager) Switch(scene Scene) {
+	if scene == nil {
+		return
+	}
+	m.Pop()
+	m.Push(scene)
+}
+
+// Current は最前面のシーンを返す（シーンがない場合は nil）
+func (m *SceneManager) Current() Scene {
+	if len(m.scenes) == 0 {
+		return nil
+	}
+	return m.scenes[len(m.scenes)-1]
+}
+
+// Depth はスタックに積まれているシーン数を返す
+func (m *SceneManager) Depth() int {
+	return len(m.scenes)
+}
+
+// Update は最前面のシーンを更新する
+func (m *SceneManager) Update(deltaTime float64) {
+	if scene := m.Current(); scene != nil {
+		scene.Update(deltaTime)
+	}
+}
+
+// Render は最前面のシーンを描画する
+func (m *SceneManager) Render(renderer tinyengine.Renderer) {
+	if scene := m.Current(); scene != nil {
+		scene.Render(renderer)
+	}
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/ganyariya/tinyengine/pkg/tinyengine"
+	"github.com/stretchr/testify/assert"
+)
+
+// testScene は呼び出しを記録するテスト用のシーン
+type testScene struct {
+	name        string
+	events      *[]string
+	updateCount int
+	renderCount int
+}
+
+func (s *testScene) Enter()                              { *s.events = append(*s.events, s.name+".Enter") }
+func (s *testScene) Exit()                               { *s.events = append(*s.events, s.name+".Exit") }
+func (s *testScene) Update(deltaTime float64)            { s.updateCount++ }
+func (s *testScene) Render(renderer tinyengine.Renderer) { s.renderCount++ }
+
+func TestSceneManager_PushPop_EnterExitOrder(t *testing.T) {
+	// Arrange
+	var events []string
+	manager := NewSceneManager()
+	gameplay := &testScene{name: "gameplay", events: &events}
+	pause := &testScene{name: "pause", events: &events}
+
+	// Act
+	manager.Push(gameplay)
+	manager.Push(pause)
+	popped := manager.Pop()
+
+	// Assert
+	assert.Equal(t, []string{"gameplay.Enter", "pause.Enter", "pause.Exit"}, events)
+	assert.Equal(t, pause, popped)
+	assert.Equal(t, gameplay, manager.Current(), "下のシーンが再び最前面になる")
+	assert.Equal(t, 1, manager.Depth())
+}
+
+func TestSceneManager_Switch(t *testing.T) {
+	// Arrange
+	var events []string
+	manager := NewSceneManager()
+	menu := &testScene{name: "menu", events: &events}
+	gameplay := &testScene{name: "gameplay", events: &events}
+	manager.Push(menu)
+
+	// Act
+	manager.Switch(gameplay)
+
+	// Assert
+	assert.Equal(t, []string{"menu.Enter", "menu.Exit", "gameplay.Enter"}, events)
+	assert.Equal(t, gameplay, manager.Current())
+	assert.Equal(t, 1, manager.Depth())
+}
+
+func TestSceneManager_OnlyTopSceneUpdates(t *testing.T) {
+	// Arrange
+	var events []string
+	manager := NewSceneManager()
+	gameplay := &testScene{name: "gameplay", events: &events}
+	pause := &testScene{name: "pause", events: &events}
+	manager.Push(gameplay)
+	manager.Push(pause)
+
+	// Act
+	manager.Update(0.016)
+	manager.Render(nil)
+	manager.Update(0.016)
+
+	// Assert
+	assert.Equal(t, 2, pause.updateCount)
+	assert.Equal(t, 1, pause.renderCount)
+	assert.Equal(t, 0, gameplay.updateCount)
+	assert.Equal(t, 0, gameplay.renderCount)
+}
+
+func TestSceneManager_Empty(t *testing.T) {
+	// Arrange
+	manager := NewSceneManager()
+
+	// Act & Assert
+	assert.Nil(t, manager.Current())
+	assert.Nil(t, manager.Pop())
+	assert.NotPanics(t, func() {
+		manager.Update(0.016)
+		manager.Render(nil)
+	})
+}
@@ -0,0 +1,121 @@
+package core
+
+import (
+	"github.com/ganyariya/tinyengine/pkg/tinyengine"
+)
+
+// fadeDirection はフェードの方向を表す
+type fadeDirection int
+
+const (
+	fadeNone fadeDirection = iota
+	fadeOut                // 透明 → 指定色
+	fadeIn                 // 指定色 → 透明
+)
+
+// ScreenFade は画面全体を指定色でフェードさせるトランジション効果
+// シーン切り替え時に FadeOut → (IsCompleteでシーン交換) → FadeIn のように使用する
+type ScreenFade struct {
+	width     int
+	height    int
+	red       float32
+	green     float32
+	blue      float32
+	alpha     float64
+	direction fadeDirection
+	duration  float64
+	elapsed   float64
+}
+
+// NewScreenFade は新しいScreenFadeを作成する（デフォルトは黒）
+func NewScreenFade(width, height int) *ScreenFade {
+	return &ScreenFade{
+		width:  width,
+		height: height,
+	}
+}
+
+// SetColor はフェード色を設定する
+func (f *ScreenFade) SetColor(red, green, blue float32) {
+	f.red = red
+	f.green = green
+	f.blue = blue
+}
+
+// SetSize は覆う画面サイズを設定する
+func (f *ScreenFade) SetSize(width, height int) {
+	f.width = width
+	f.height = height
+}
+
+// FadeOut は指定秒数かけて画面をフェード色で覆う
+func (f *ScreenFade) FadeOut(duration float64) {
+	f.start(fadeOut, duration)
+}
+
+// FadeIn は指定秒数かけてフェード色を取り除く
+func (f *ScreenFade) FadeIn(duration float64) {
+	f.start(fadeIn, duration)
+}
+
+// start はフェードを開始する
+func (f *ScreenFade) start(direction fadeDirection, duration float64) {
+	f.direction = direction
+	f.duration = duration
+	f.elapsed = 0
+	f.alpha = f.alphaAt(0)
+	if duration <= 0 {
+		f.finish()
+	}
+}
+
+// finish はフェードを即座に完了させる
+func (f *ScreenFade) finish() {
+	f.elapsed = f.duration
+	f.alpha = f.alphaAt(1)
+	f.direction = fadeNone
+}
+
+// alphaAt は進行度（0〜1）に対応するアルファ値を返す
+func (f *ScreenFade) alphaAt(progress float64) float64 {
+	switch f.direction {
+	case fadeOut:
+		return progress
+	case fadeIn:
+		return 1.0 - progress
+	default:
+		return f.alpha
+	}
+}
+
+// Update はフェードを進める
+func (f *ScreenFade) Update(deltaTime float64) {
+	if f.direction == fadeNone {
+		return
+	}
+
+	f.elapsed += deltaTime
+	if f.elapsed >= f.duration {
+		f.finish()
+		return
+	}
+	f.alpha = f.alphaAt(f.elapsed / f.duration)
+}
+
+// Render は画面全体を覆う矩形を現在のアルファ値で描画する
+func (f *ScreenFade) Render(renderer tinyengine.Renderer) {
+	if renderer == nil || f.alpha <= 0 {
+		return
+	}
+	renderer.DrawRectangleColor(0, 0, float32(f.width), float32(f.height), f.red, f.green, f.blue, float32(f.alpha))
+}
+
+// Alpha は現在のアルファ値（0〜1）を返す
+func (f *ScreenFade) Alpha() float64 {
+	return f.alpha
+}
+
+// IsComplete はフェードが完了しているか（進行中のフェードがないか）を返す
+func (f *ScreenFade) IsComplete() bool {
+	return f.direction == fadeNone
+}
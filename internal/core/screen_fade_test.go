@@ -0,0 +1,91 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/ganyariya/tinyengine/pkg/tinyengine"
+	"github.com/stretchr/testify/assert"
+)
+
+// テスト用の描画呼び出しを記録するレンダラー
+type recordingRenderer struct {
+	tinyengine.Renderer
+	rectangles [][8]float32
+}
+
+func (r *recordingRenderer) DrawRectangleColor(x, y, width, height float32, red, green, blue, alpha float32) {
+	r.rectangles = append(r.rectangles, [8]float32{x, y, width, height, red, green, blue, alpha})
+}
+
+func TestScreenFade_InitialState(t *testing.T) {
+	fade := NewScreenFade(800, 600)
+
+	assert.Equal(t, 0.0, fade.Alpha())
+	assert.True(t, fade.IsComplete())
+}
+
+func TestScreenFade_FadeOutAlphaCurve(t *testing.T) {
+	fade := NewScreenFade(800, 600)
+
+	fade.FadeOut(1.0)
+	assert.False(t, fade.IsComplete())
+	assert.Equal(t, 0.0, fade.Alpha())
+
+	fade.Update(0.25)
+	assert.InDelta(t, 0.25, fade.Alpha(), 1e-9)
+
+	fade.Update(0.5)
+	assert.InDelta(t, 0.75, fade.Alpha(), 1e-9)
+	assert.False(t, fade.IsComplete())
+
+	// 終了時刻を超えると完全に覆われて完了する
+	fade.Update(0.5)
+	assert.Equal(t, 1.0, fade.Alpha())
+	assert.True(t, fade.IsComplete())
+}
+
+func TestScreenFade_FadeInAlphaCurve(t *testing.T) {
+	fade := NewScreenFade(800, 600)
+
+	fade.FadeIn(2.0)
+	assert.Equal(t, 1.0, fade.Alpha())
+
+	fade.Update(0.5)
+	assert.InDelta(t, 0.75, fade.Alpha(), 1e-9)
+
+	fade.Update(1.5)
+	assert.Equal(t, 0.0, fade.Alpha())
+	assert.True(t, fade.IsComplete())
+}
+
+func TestScreenFade_ZeroDuration(t *testing.T) {
+	fade := NewScreenFade(800, 600)
+
+	// 時間0の場合は即座に完了する
+	fade.FadeOut(0)
+
+	assert.Equal(t, 1.0, fade.Alpha())
+	assert.True(t, fade.IsComplete())
+}
+
+func TestScreenFade_Render(t *testing.T) {
+	fade := NewScreenFade(800, 600)
+	fade.SetColor(1.0, 0.5, 0.0)
+	renderer := &recordingRenderer{}
+
+	// 透明な場合は描画しない
+	fade.Render(renderer)
+	assert.Empty(t, renderer.rectangles)
+
+	fade.FadeOut(1.0)
+	fade.Update(0.5)
+	fade.Render(renderer)
+
+	// 画面全体を覆う矩形が現在のアルファ値で描画される
+	assert.Equal(t, [][8]float32{{0, 0, 800, 600, 1.0, 0.5, 0.0, 0.5}}, renderer.rectangles)
+
+	// nilレンダラーでもパニックしない
+	assert.NotPanics(t, func() {
+		fade.Render(nil)
+	})
+}
@@ -0,0 +1,100 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/ganyariya/tinyengine/pkg/tinyengine"
+)
+
+// Spawner は一定間隔ごとにスポーン用コールバックを呼び出すGameObject
+// ウェーブ制の敵出現など、周期的な生成処理に使用する
+type Spawner struct {
+	interval   float64
+	spawn      func()
+	elapsed    float64
+	maxSpawns  int // 0以下の場合は無制限
+	spawnCount int
+	paused     bool
+}
+
+// NewSpawner は指定間隔（秒）ごとにspawnを呼び出すSpawnerを作成する
+func NewSpawner(interval float64, spawn func()) *Spawner {
+	return &Spawner{
+		interval: interval,
+		spawn:    spawn,
+	}
+}
+
+// SetMaxSpawns はスポーン回数の上限を設定する（0以下で無制限）
+func (s *Spawner) SetMaxSpawns(max int) {
+	s.maxSpawns = max
+}
+
+// Initialize はSpawnerの設定を検証する
+func (s *Spawner) Initialize() error {
+	if s.interval <= 0 {
+		return fmt.Errorf("spawner interval must be positive: %v", s.interval)
+	}
+	if s.spawn == nil {
+		return fmt.Errorf("spawner callback is nil")
+	}
+	return nil
+}
+
+// Update はタイマーを進め、間隔が経過するたびにスポーンを行う
+// 1フレームで複数の間隔が経過した場合はその回数分スポーンする
+// スポーン回数が上限に達した後は経過時間を蓄積しない
+func (s *Spawner) Update(deltaTime float64) {
+	if s.paused || s.interval <= 0 || s.spawn == nil || s.IsFinished() {
+		return
+	}
+
+	s.elapsed += deltaTime
+	for s.elapsed >= s.interval && !s.IsFinished() {
+		s.elapsed -= s.interval
+		s.spawnCount++
+		s.spawn()
+	}
+	if s.IsFinished() {
+		s.elapsed = 0
+	}
+}
+
+// Render は何も描画しない
+func (s *Spawner) Render(renderer tinyengine.Renderer) {}
+
+// Destroy はスポーンを停止する
+func (s *Spawner) Destroy() {
+	s.paused = true
+}
+
+// Pause はタイマーを一時停止する
+func (s *Spawner) Pause() {
+	s.paused = true
+}
+
+// Resume は一時停止したタイマーを再開する
+func (s *Spawner) Resume() {
+	s.paused = false
+}
+
+// IsPaused は一時停止中かを返す
+func (s *Spawner) IsPaused() bool {
+	return s.paused
+}
+
+// SpawnCount はこれまでのスポーン回数を返す
+func (s *Spawner) SpawnCount() int {
+	return s.spawnCount
+}
+
+// IsFinished はスポーン回数が上限に達したかを返す
+func (s *Spawner) IsFinished() bool {
+	return s.maxSpawns > 0 && s.spawnCount >= s.maxSpawns
+}
+
+// Reset はタイマーとスポーン回数を初期状態に戻す
+func (s *Spawner) Reset() {
+	s.elapsed = 0
+	s.spawnCount = 0
+}
@@ -0,0 +1,104 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/ganyariya/tinyengine/pkg/tinyengine"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpawner_Implementation(t *testing.T) {
+	var _ tinyengine.GameObject = (*Spawner)(nil)
+}
+
+func TestSpawner_Initialize(t *testing.T) {
+	assert.NoError(t, NewSpawner(1.0, func() {}).Initialize())
+	assert.Error(t, NewSpawner(0, func() {}).Initialize())
+	assert.Error(t, NewSpawner(1.0, nil).Initialize())
+}
+
+func TestSpawner_SpawnsPerInterval(t *testing.T) {
+	// Arrange
+	count := 0
+	spawner := NewSpawner(0.5, func() { count++ })
+
+	// Act: 0.1秒刻みで2.3秒進める
+	for i := 0; i < 23; i++ {
+		spawner.Update(0.1)
+	}
+
+	// Assert: 0.5, 1.0, 1.5, 2.0 秒の4回
+	assert.Equal(t, 4, count)
+	assert.Equal(t, 4, spawner.SpawnCount())
+}
+
+func TestSpawner_LargeStepSpawnsMultipleTimes(t *testing.T) {
+	count := 0
+	spawner := NewSpawner(1.0, func() { count++ })
+
+	spawner.Update(3.5)
+	assert.Equal(t, 3, count)
+
+	// 端数の0.5秒は持ち越される
+	spawner.Update(0.5)
+	assert.Equal(t, 4, count)
+}
+
+func TestSpawner_PauseHaltsSpawning(t *testing.T) {
+	// Arrange
+	count := 0
+	spawner := NewSpawner(1.0, func() { count++ })
+	spawner.Update(0.8)
+
+	// Act
+	spawner.Pause()
+	spawner.Update(5.0)
+
+	// Assert
+	assert.True(t, spawner.IsPaused())
+	assert.Equal(t, 0, count)
+
+	// 再開後は停止前の経過時間から続く
+	spawner.Resume()
+	spawner.Update(0.2)
+	assert.Equal(t, 1, count)
+}
+
+func TestSpawner_MaxSpawns(t *testing.T) {
+	count := 0
+	spawner := NewSpawner(1.0, func() { count++ })
+	spawner.SetMaxSpawns(2)
+
+	spawner.Update(10.0)
+
+	assert.Equal(t, 2, count)
+	assert.True(t, spawner.IsFinished())
+
+	// リセットすると再びスポーンできる
+	spawner.Reset()
+	spawner.Update(1.0)
+	assert.Equal(t, 3, count)
+	assert.False(t, spawner.IsFinished())
+}
+
+func TestSpawner_StopsAccumulatingWhenFinished(t *testing.T) {
+	// Arrange
+	count := 0
+	spawner := NewSpawner(1.0, func() { count++ })
+	spawner.SetMaxSpawns(2)
+
+	// Act
+	// 上限に達した後も時間を進めてから上限を引き上げる
+	spawner.Update(2.5)
+	for i := 0; i < 10; i++ {
+		spawner.Update(1.0)
+	}
+	spawner.SetMaxSpawns(4)
+	spawner.Update(0.5)
+
+	// Assert
+	// 上限に達した後の経過時間はまとめてスポーンされない
+	assert.Equal(t, 2, count)
+	spawner.Update(0.5)
+	assert.Equal(t, 3, count)
+}
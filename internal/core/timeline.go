@@ -0,0 +1,127 @@
+package core
+
+import (
+	"sort"
+)
+
+// timelineEvent は指定時刻に一度だけ発火するイベント
+type timelineEvent struct {
+	time  float64
+	fn    func()
+	fired bool
+}
+
+// timelineInterval は一定間隔で繰り返し発火するイベント
+type timelineInterval struct {
+	interval float64
+	fn       func()
+	count    int // 発火済みの回数
+}
+
+// timelineFiring は1回のUpdateで発火するイベントの候補
+type timelineFiring struct {
+	time  float64
+	order int
+	fn    func()
+}
+
+// Timeline は再生位置の進行に応じてスケジュールされたコールバックを発火させる
+// 大きな deltaTime で複数のイベントを跨いだ場合も、時刻順に全て発火する
+type Timeline struct {
+	playhead  float64
+	events    []*timelineEvent
+	intervals []*timelineInterval
+}
+
+// NewTimeline は新しいTimelineを作成する
+func NewTimeline() *Timeline {
+	return &Timeline{
+		events:    make([]*timelineEvent, 0),
+		intervals: make([]*timelineInterval, 0),
+	}
+}
+
+// At は指定時刻（秒）に一度だけ発火するコールバックを登録する
+// 時刻0のイベントは最初の Update で発火する
+func (t *Timeline) At(time float64, fn func()) {
+	if fn == nil {
+		return
+	}
+	t.events = append(t.events, &timelineEvent{time: time, fn: fn})
+}
+
+// Every は指定間隔（秒）ごとに発火するコールバックを登録する
+// 最初の発火は interval 秒後。間隔が0以下の場合は登録しない
+func (t *Timeline) Every(interval float64, fn func()) {
+	if fn == nil || interval <= 0 {
+		return
+	}
+	t.intervals = append(t.intervals, &timelineInterval{interval: interval, fn: fn})
+}
+
+// Update は再生位置を進め、到達したイベントを時刻順に発火する
+// 同時刻のイベントは登録順に発火する
+func (t *Timeline) Update(deltaTime float64) {
+	if deltaTime < 0 {
+		return
+	}
+	t.playhead += deltaTime
+
+	firings := make([]timelineFiring, 0)
+	order := 0
+
+	for _, event := range t.events {
+		if !event.fired && event.time <= t.playhead {
+			event.fired = true
+			firings = append(firings, timelineFiring{time: event.time, order: order, fn: event.fn})
+		}
+		order++
+	}
+
+	for _, interval := range t.intervals {
+		for {
+			next := interval.interval * float64(interval.count+1)
+			if next > t.playhead {
+				break
+			}
+			interval.count++
+			firings = append(firings, timelineFiring{time: next, order: order, fn: interval.fn})
+		}
+		order++
+	}
+
+	sort.SliceStable(firings, func(i, j int) bool {
+		if firings[i].time != firings[j].time {
+			return firings[i].time < firings[j].time
+		}
+		return firings[i].order < firings[j].order
+	})
+
+	for _, firing := range firings {
+		firing.fn()
+	}
+}
+
+// Reset は再生位置を0に戻し、全てのイベントを再び発火可能にする
+// 登録されたイベントは保持される
+func (t *Timeline) Reset() {
+	t.playhead = 0
+	for _, event := range t.events {
+		event.fired = false
+	}
+	for _, interval := range t.intervals {
+		interval.count = 0
+	}
+}
+
+// Clear は登録された全てのイベントを削除し、再生位置を0に戻す
+func (t *Timeline) Clear() {
+	t.playhead = 0
+	t.events = t.events[:0]
+	t.intervals = t.intervals[:0]
+}
+
+// GetPlayhead は現在の再生位置（秒）を取得する
+func (t *Timeline) GetPlayhead() float64 {
+	return t.playhead
+}
@@ -0,0 +1,78 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeline_At_FiresInOrder(t *testing.T) {
+	// Arrange
+	timeline := NewTimeline()
+	fired := make([]string, 0)
+	timeline.At(2.0, func() { fired = append(fired, "c") })
+	timeline.At(0.5, func() { fired = append(fired, "a") })
+	timeline.At(1.0, func() { fired = append(fired, "b") })
+
+	// Act
+	timeline.Update(0.75)
+	afterFirst := append([]string(nil), fired...)
+	timeline.Update(5.0)
+
+	// Assert
+	assert.Equal(t, []string{"a"}, afterFirst)
+	assert.Equal(t, []string{"a", "b", "c"}, fired)
+}
+
+func TestTimeline_At_FiresOnlyOnce(t *testing.T) {
+	timeline := NewTimeline()
+	count := 0
+	timeline.At(0.5, func() { count++ })
+
+	timeline.Update(1.0)
+	timeline.Update(1.0)
+
+	assert.Equal(t, 1, count)
+}
+
+func TestTimeline_Every_LargeStep(t *testing.T) {
+	timeline := NewTimeline()
+	count := 0
+	timeline.Every(0.25, func() { count++ })
+
+	// 1回の大きなステップで 0.25, 0.5, ..., 2.5 の10回発火する
+	timeline.Update(2.6)
+	assert.Equal(t, 10, count)
+
+	timeline.Update(0.2)
+	assert.Equal(t, 11, count)
+}
+
+func TestTimeline_MixedEventsInterleave(t *testing.T) {
+	timeline := NewTimeline()
+	fired := make([]string, 0)
+	timeline.Every(1.0, func() { fired = append(fired, "tick") })
+	timeline.At(1.5, func() { fired = append(fired, "event") })
+
+	timeline.Update(2.0)
+
+	assert.Equal(t, []string{"tick", "event", "tick"}, fired)
+}
+
+func TestTimeline_Reset(t *testing.T) {
+	// Arrange
+	timeline := NewTimeline()
+	atCount, everyCount := 0, 0
+	timeline.At(0.5, func() { atCount++ })
+	timeline.Every(1.0, func() { everyCount++ })
+	timeline.Update(3.0)
+
+	// Act
+	timeline.Reset()
+
+	// Assert
+	assert.Equal(t, 0.0, timeline.GetPlayhead())
+	timeline.Update(1.0)
+	assert.Equal(t, 2, atCount, "リセット後は再び発火する")
+	assert.Equal(t, 4, everyCount)
+}
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"github.com/ganyariya/tinyengine/pkg/tinyengine"
+)
+
+// World は登録されたゲームオブジェクトをまとめて更新・描画する
+// オブジェクトは登録順に処理される
+type World struct {
+	objects []tinyengine.GameObject
+}
+
+// NewWorld は空のWorldを作成する
+func NewWorld() *World {
+	return &World{
+		objects: make([]tinyengine.GameObject, 0),
+	}
+}
+
+// AddObject はオブジェクトを初期化してから登録する
+// 初期化に失敗した場合は登録せずにエラーを返す
+func (w *World) AddObject(object tinyengine.GameObject) error {
+	if object == nil {
+		return nil
+	}
+	if err := object.Initialize(); err != nil {
+		return NewEngineError("world", "object initialization", err)
+	}
+	w.objects = append(w.objects, object)
+	return nil
+}
+
+// RemoveObject はオブジェクトの登録を解除して破棄する
+// 登録されていないオブジェクトの場合は false を返す
+func (w *World) RemoveObject(object tinyengine.GameObject) bool {
+	for i, registered := range w.objects {
+		if registered != object {
+			continue
+		}
+		// 残りのオブジェクトの順序を保つ
+		w.objects = append(w.objects[:i], w.objects[i+1:]...)
+		object.Destroy()
+		return true
+	}
+	return false
+}
+
+// Objects は登録されているオブジェクトを登録順に返す
+func (w *World) Objects() []tinyengine.GameObject {
+	objects := make([]tinyengine.GameObject, len(w.objects))
+	copy(objects, w.objects)
+	return objects
+}
+
+// Len は登録されているオブジェクト数を返す
+func (w *World) Len() int {
+	return len(w.objects)
+}
+
+// Update は全てのオブジェクトを登録順に更新する
+// 更新中に追加・削除されたオブジェクトは次のフレームから反映される
+func (w *World) Update(deltaTime float64) {
+	for _, object := range w.Objects() {
+		object.Update(deltaTime)
+	}
+}
+
+// Render は全てのオブジェクトを登録順に描画する
+func (w *World) Render(renderer tinyengine.Renderer) {
+	for _, object := range w.Objects() {
+		object.Render(renderer)
+	}
+}
+
+// Destroy は全てのオブジェクトを破棄して登録を解除する
+func (w *World) Destroy() {
+	for _, object := range w.objects {
+		object.Destroy()
+	}
+	w.objects = w.objects[:0]
+}
@@ -0,0 +1,129 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ganyariya/tinyengine/pkg/tinyengine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testGameObject は呼び出し回数を記録するテスト用のゲームオブジェクト
+type testGameObject struct {
+	name         string
+	initErr      error
+	initialized  int
+	updateCount  int
+	renderCount  int
+	destroyCount int
+	order        *[]string
+}
+
+func (o *testGameObject) Initialize() error {
+	o.initialized++
+	return o.initErr
+}
+
+func (o *testGameObject) Update(deltaTime float64) {
+	o.updateCount++
+	if o.order != nil {
+		*o.order = append(*o.order, o.name)
+	}
+}
+
+func (o *testGameObject) Render(renderer tinyengine.Renderer) { o.renderCount++ }
+func (o *testGameObject) Destroy()                            { o.destroyCount++ }
+
+func TestWorld_AddObject_Initializes(t *testing.T) {
+	// Arrange
+	world := NewWorld()
+	object := &testGameObject{}
+
+	// Act
+	err := world.AddObject(object)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, object.initialized)
+	assert.Equal(t, 1, world.Len())
+}
+
+func TestWorld_AddObject_InitializeError(t *testing.T) {
+	// Arrange
+	world := NewWorld()
+	cause := errors.New("failed")
+
+	// Act
+	err := world.AddObject(&testGameObject{initErr: cause})
+
+	// Assert
+	assert.ErrorIs(t, err, cause)
+	assert.Equal(t, 0, world.Len(), "初期化に失敗したオブジェクトは登録しない")
+}
+
+func TestWorld_UpdateRender_CallsEachOnce(t *testing.T) {
+	// Arrange
+	world := NewWorld()
+	var order []string
+	objects := []*testGameObject{
+		{name: "a", order: &order},
+		{name: "b", order: &order},
+		{name: "c", order: &order},
+	}
+	for _, object := range objects {
+		require.NoError(t, world.AddObject(object))
+	}
+
+	// Act
+	world.Update(0.016)
+	world.Render(nil)
+
+	// Assert
+	for _, object := range objects {
+		assert.Equal(t, 1, object.updateCount, object.name)
+		assert.Equal(t, 1, object.renderCount, object.name)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, order, "登録順に処理する")
+}
+
+func TestWorld_RemoveObject_DestroysAndKeepsOrder(t *testing.T) {
+	// Arrange
+	world := NewWorld()
+	var order []string
+	a := &testGameObject{name: "a", order: &order}
+	b := &testGameObject{name: "b", order: &order}
+	c := &testGameObject{name: "c", order: &order}
+	for _, object := range []*testGameObject{a, b, c} {
+		require.NoError(t, world.AddObject(object))
+	}
+
+	// Act
+	removed := world.RemoveObject(b)
+	removedAgain := world.RemoveObject(b)
+	world.Update(0.016)
+
+	// Assert
+	assert.True(t, removed)
+	assert.False(t, removedAgain)
+	assert.Equal(t, 1, b.destroyCount)
+	assert.Equal(t, 0, b.updateCount)
+	assert.Equal(t, []string{"a", "c"}, order)
+}
+
+func TestWorld_Destroy(t *testing.T) {
+	// Arrange
+	world := NewWorld()
+	a := &testGameObject{}
+	b := &testGameObject{}
+	require.NoError(t, world.AddObject(a))
+	require.NoError(t, world.AddObject(b))
+
+	// Act
+	world.Destroy()
+
+	// Assert
+	assert.Equal(t, 1, a.destroyCount)
+	assert.Equal(t, 1, b.destroyCount)
+	assert.Equal(t, 0, world.Len())
+}
@@ -0,0 +1,147 @@
+package ecs
+
+import "github.com/ganyariya/tinyengine/internal/core"
+
+// System はWorldに対して毎フレーム実行される処理
+type System func(world *World, dt float64)
+
+// Phase はシステムを実行する更新フェーズ
+type Phase int
+
+const (
+	PhaseVariable Phase = iota // 毎フレーム可変の経過時間で実行する
+	PhaseFixed                 // 固定ステップで実行する（物理演算など）
+)
+
+// DefaultFixedStepHz はデフォルトの固定ステップ更新レート
+const DefaultFixedStepHz = 60
+
+// UpdateHooks は SystemScheduler を組み込むエンジンの更新フック（core.Engine が実装する）
+type UpdateHooks interface {
+	SetFixedUpdate(fn func(dt float64), stepHz int)
+	SetVariableUpdate(fn func(dt float64))
+}
+
+// scheduledSystem は登録されたシステムとその状態
+type scheduledSystem struct {
+	name    string
+	phase   Phase
+	system  System
+	enabled bool
+}
+
+// SystemScheduler は登録されたシステムを登録順に実行する
+// Attach でエンジンに組み込むと、固定フェーズのシステムはエンジンの固定ステップ更新で、
+// 可変フェーズのシステムは毎フレーム1回実行される
+// エンジンを使わない場合は Update を毎フレーム呼び出す（固定フェーズは core.FixedLoop で進める）
+type SystemScheduler struct {
+	world       *World
+	systems     []*scheduledSystem
+	fixedStepHz int
+	fixedLoop   *core.FixedLoop
+}
+
+// NewSystemScheduler は新しいSystemSchedulerを作成する
+// fixedStepHz が0以下の場合は DefaultFixedStepHz を使用する
+func NewSystemScheduler(world *World, fixedStepHz int) *SystemScheduler {
+	if fixedStepHz <= 0 {
+		fixedStepHz = DefaultFixedStepHz
+	}
+	s := &SystemScheduler{
+		world:       world,
+		systems:     make([]*scheduledSystem, 0),
+		fixedStepHz: fixedStepHz,
+	}
+	s.fixedLoop = core.NewFixedLoop(fixedStepHz, s.FixedUpdate)
+	return s
+}
+
+// Attach は固定フェーズをエンジンの固定ステップ更新に、可変フェーズを毎フレームの更新に登録する
+// 登録後は Update を呼び出さない（固定フェーズが二重に進むため）
+func (s *SystemScheduler) Attach(hooks UpdateHooks) {
+	hooks.SetFixedUpdate(s.FixedUpdate, s.fixedStepHz)
+	hooks.SetVariableUpdate(s.VariableUpdate)
+}
+
+// AddSystem はシステムを指定フェーズに登録する（有効な状態で追加される）
+// 同じ名前のシステムが既にある場合は何もせず false を返す
+func (s *SystemScheduler) AddSystem(name string, phase Phase, system System) bool {
+	if system == nil || s.find(name) != nil {
+		return false
+	}
+	s.systems = append(s.systems, &scheduledSystem{
+		name:    name,
+		phase:   phase,
+		system:  system,
+		enabled: true,
+	})
+	return true
+}
+
+// RemoveSystem はシステムを削除する
+func (s *SystemScheduler) RemoveSystem(name string) bool {
+	for i, scheduled := range s.systems {
+		if scheduled.name == name {
+			s.systems = append(s.systems[:i], s.systems[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// SetEnabled はシステムの有効・無効を切り替える
+func (s *SystemScheduler) SetEnabled(name string, enabled bool) bool {
+	scheduled := s.find(name)
+	if scheduled == nil {
+		return false
+	}
+	scheduled.enabled = enabled
+	return true
+}
+
+// IsEnabled はシステムが有効かを確認する
+func (s *SystemScheduler) IsEnabled(name string) bool {
+	scheduled := s.find(name)
+	return scheduled != nil && scheduled.enabled
+}
+
+// Update は固定フェーズのシステムを蓄積時間に応じて実行した後、可変フェーズのシステムを実行する
+// 実行した固定ステップの回数を返す
+func (s *SystemScheduler) Update(deltaTime float64) int {
+	steps := s.fixedLoop.Advance(deltaTime)
+	s.VariableUpdate(deltaTime)
+	return steps
+}
+
+// FixedUpdate は固定フェーズの有効なシステムを1ステップ分実行する
+func (s *SystemScheduler) FixedUpdate(dt float64) {
+	s.runPhase(PhaseFixed, dt)
+}
+
+// VariableUpdate は可変フェーズの有効なシステムを実行する
+func (s *SystemScheduler) VariableUpdate(dt float64) {
+	s.runPhase(PhaseVariable, dt)
+}
+
+// Alpha は Update で持ち越した時間が次の固定ステップの何割に当たるかを返す
+func (s *SystemScheduler) Alpha() float64 {
+	return s.fixedLoop.Alpha()
+}
+
+// runPhase は指定フェーズの有効なシステムを登録順に実行する
+func (s *SystemScheduler) runPhase(phase Phase, dt float64) {
+	for _, scheduled := range s.systems {
+		if scheduled.phase == phase && scheduled.enabled {
+			scheduled.system(s.world, dt)
+		}
+	}
+}
+
+func (s *SystemScheduler) find(name string) *scheduledSystem {
+	for _, scheduled := range s.systems {
+		if scheduled.name == name {
+			return scheduled
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package ecs
+
+import (
+	"testing"
+
+	"github.com/ganyariya/tinyengine/internal/core"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ UpdateHooks = (*core.Engine)(nil)
+
+// fakeHooks は登録された更新関数を記録する
+type fakeHooks struct {
+	fixed    func(dt float64)
+	stepHz   int
+	variable func(dt float64)
+}
+
+func (h *fakeHooks) SetFixedUpdate(fn func(dt float64), stepHz int) {
+	h.fixed = fn
+	h.stepHz = stepHz
+}
+
+func (h *fakeHooks) SetVariableUpdate(fn func(dt float64)) {
+	h.variable = fn
+}
+
+func TestSystemScheduler_RunsInRegistrationOrder(t *testing.T) {
+	// Arrange
+	scheduler := NewSystemScheduler(NewWorld(), 60)
+	order := make([]string, 0)
+	for _, name := range []string{"input", "movement", "render"} {
+		name := name
+		scheduler.AddSystem(name, PhaseVariable, func(world *World, dt float64) {
+			order = append(order, name)
+		})
+	}
+
+	// Act
+	scheduler.Update(0.001)
+
+	// Assert
+	assert.Equal(t, []string{"input", "movement", "render"}, order)
+}
+
+func TestSystemScheduler_DisabledSystemsAreSkipped(t *testing.T) {
+	scheduler := NewSystemScheduler(NewWorld(), 60)
+	calls := 0
+	scheduler.AddSystem("ai", PhaseVariable, func(world *World, dt float64) { calls++ })
+
+	assert.True(t, scheduler.SetEnabled("ai", false))
+	scheduler.Update(0.016)
+	assert.Equal(t, 0, calls)
+	assert.False(t, scheduler.IsEnabled("ai"))
+
+	scheduler.SetEnabled("ai", true)
+	scheduler.Update(0.016)
+	assert.Equal(t, 1, calls)
+}
+
+func TestSystemScheduler_FixedCadence(t *testing.T) {
+	// Arrange
+	scheduler := NewSystemScheduler(NewWorld(), 4) // 0.25秒ステップ
+	fixedCalls, variableCalls := 0, 0
+	var fixedDt float64
+	scheduler.AddSystem("physics", PhaseFixed, func(world *World, dt float64) {
+		fixedCalls++
+		fixedDt = dt
+	})
+	scheduler.AddSystem("animation", PhaseVariable, func(world *World, dt float64) { variableCalls++ })
+
+	// Act: 0.125秒 × 8フレーム = 1秒
+	for i := 0; i < 8; i++ {
+		scheduler.Update(0.125)
+	}
+
+	// Assert
+	assert.Equal(t, 4, fixedCalls)
+	assert.Equal(t, 0.25, fixedDt)
+	assert.Equal(t, 8, variableCalls)
+}
+
+func TestSystemScheduler_FixedRunsBeforeVariable(t *testing.T) {
+	scheduler := NewSystemScheduler(NewWorld(), 8)
+	order := make([]string, 0)
+	scheduler.AddSystem("render", PhaseVariable, func(world *World, dt float64) { order = append(order, "variable") })
+	scheduler.AddSystem("physics", PhaseFixed, func(world *World, dt float64) { order = append(order, "fixed") })
+
+	steps := scheduler.Update(0.25)
+
+	assert.Equal(t, 2, steps)
+	assert.Equal(t, []string{"fixed", "fixed", "variable"}, order)
+}
+
+func TestSystemScheduler_AddAndRemove(t *testing.T) {
+	scheduler := NewSystemScheduler(NewWorld(), 0)
+	noop := func(world *World, dt float64) {}
+
+	assert.True(t, scheduler.AddSystem("a", PhaseVariable, noop))
+	assert.False(t, scheduler.AddSystem("a", PhaseFixed, noop), "同名のシステムは登録できない")
+	assert.True(t, scheduler.RemoveSystem("a"))
+	assert.False(t, scheduler.RemoveSystem("a"))
+	assert.False(t, scheduler.SetEnabled("a", true))
+}
+
+func TestSystemScheduler_CarriesRemainderToAlpha(t *testing.T) {
+	scheduler := NewSystemScheduler(NewWorld(), 8)
+
+	steps := scheduler.Update(0.1875)
+
+	assert.Equal(t, 1, steps)
+	assert.InDelta(t, 0.5, scheduler.Alpha(), 1e-9)
+}
+
+func TestSystemScheduler_Attach(t *testing.T) {
+	// Arrange
+	scheduler := NewSystemScheduler(NewWorld(), 30)
+	hooks := &fakeHooks{}
+	order := make([]string, 0)
+	scheduler.AddSystem("physics", PhaseFixed, func(world *World, dt float64) { order = append(order, "fixed") })
+	scheduler.AddSystem("animation", PhaseVariable, func(world *World, dt float64) { order = append(order, "variable") })
+
+	// Act
+	scheduler.Attach(hooks)
+	hooks.fixed(1.0 / 30.0)
+	hooks.variable(0.016)
+
+	// Assert
+	assert.Equal(t, 30, hooks.stepHz)
+	assert.Equal(t, []string{"fixed", "variable"}, order)
+}
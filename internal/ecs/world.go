@@ -0,0 +1,159 @@
+package ecs
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Entity はエンティティを識別するID
+type Entity uint32
+
+// componentStore は型を問わずコンポーネントストアを操作するためのインターフェース
+type componentStore interface {
+	remove(entity Entity)
+}
+
+// typedStore は特定の型のコンポーネントを保持するストア
+type typedStore[T any] struct {
+	components map[Entity]T
+}
+
+func (s *typedStore[T]) remove(entity Entity) {
+	delete(s.components, entity)
+}
+
+// World はエンティティとコンポーネントを管理する
+// コンポーネントは型ごとのストアに保持され、reflect.Type をキーとして引かれる
+type World struct {
+	nextID Entity
+	free   []Entity
+	alive  map[Entity]bool
+	stores map[reflect.Type]componentStore
+}
+
+// NewWorld は新しいWorldを作成する
+func NewWorld() *World {
+	return &World{
+		nextID: 1, // 0は無効なエンティティとして予約する
+		free:   make([]Entity, 0),
+		alive:  make(map[Entity]bool),
+		stores: make(map[reflect.Type]componentStore),
+	}
+}
+
+// CreateEntity は新しいエンティティを作成する（破棄済みのIDがあれば再利用する）
+func (w *World) CreateEntity() Entity {
+	var entity Entity
+	if n := len(w.free); n > 0 {
+		entity = w.free[n-1]
+		w.free = w.free[:n-1]
+	} else {
+		entity = w.nextID
+		w.nextID++
+	}
+
+	w.alive[entity] = true
+	return entity
+}
+
+// DestroyEntity はエンティティと全てのコンポーネントを削除し、IDを再利用可能にする
+func (w *World) DestroyEntity(entity Entity) bool {
+	if !w.alive[entity] {
+		return false
+	}
+
+	for _, store := range w.stores {
+		store.remove(entity)
+	}
+	delete(w.alive, entity)
+	w.free = append(w.free, entity)
+	return true
+}
+
+// IsAlive はエンティティが存在するかを確認する
+func (w *World) IsAlive(entity Entity) bool {
+	return w.alive[entity]
+}
+
+// EntityCount は存在するエンティティの数を取得する
+func (w *World) EntityCount() int {
+	return len(w.alive)
+}
+
+// componentType はコンポーネント型のキーを取得する（インターフェース型にも対応）
+func componentType[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// storeFor は型Tのストアを取得する（create が true の場合は必要に応じて作成する）
+func storeFor[T any](w *World, create bool) *typedStore[T] {
+	key := componentType[T]()
+	if store, exists := w.stores[key]; exists {
+		return store.(*typedStore[T])
+	}
+	if !create {
+		return nil
+	}
+
+	store := &typedStore[T]{components: make(map[Entity]T)}
+	w.stores[key] = store
+	return store
+}
+
+// AddComponent はエンティティにコンポーネントを追加する（同じ型があれば置き換える）
+func AddComponent[T any](w *World, entity Entity, component T) error {
+	if !w.alive[entity] {
+		return fmt.Errorf("entity %d does not exist", entity)
+	}
+
+	storeFor[T](w, true).components[entity] = component
+	return nil
+}
+
+// GetComponent はエンティティのコンポーネントを取得する
+func GetComponent[T any](w *World, entity Entity) (T, bool) {
+	store := storeFor[T](w, false)
+	if store == nil {
+		var zero T
+		return zero, false
+	}
+
+	component, exists := store.components[entity]
+	return component, exists
+}
+
+// HasComponent はエンティティがコンポーネントを持っているかを確認する
+func HasComponent[T any](w *World, entity Entity) bool {
+	_, exists := GetComponent[T](w, entity)
+	return exists
+}
+
+// RemoveComponent はエンティティからコンポーネントを削除する
+func RemoveComponent[T any](w *World, entity Entity) bool {
+	store := storeFor[T](w, false)
+	if store == nil {
+		return false
+	}
+
+	if _, exists := store.components[entity]; !exists {
+		return false
+	}
+	delete(store.components, entity)
+	return true
+}
+
+// Query は型Tのコンポーネントを持つエンティティをID順で取得する
+func Query[T any](w *World) []Entity {
+	store := storeFor[T](w, false)
+	if store == nil {
+		return []Entity{}
+	}
+
+	entities := make([]Entity, 0, len(store.components))
+	for entity := range store.components {
+		entities = append(entities, entity)
+	}
+	sort.Slice(entities, func(i, j int) bool { return entities[i] < entities[j] })
+	return entities
+}
@@ -0,0 +1,83 @@
+package ecs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type position struct{ X, Y float64 }
+type velocity struct{ X, Y float64 }
+
+func TestWorld_AddGetRemove(t *testing.T) {
+	// Arrange
+	world := NewWorld()
+	entity := world.CreateEntity()
+
+	// Act
+	require.NoError(t, AddComponent(world, entity, position{X: 1, Y: 2}))
+	got, ok := GetComponent[position](world, entity)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, position{X: 1, Y: 2}, got)
+	_, ok = GetComponent[velocity](world, entity)
+	assert.False(t, ok, "追加していない型は取得できない")
+
+	assert.True(t, RemoveComponent[position](world, entity))
+	assert.False(t, HasComponent[position](world, entity))
+	assert.False(t, RemoveComponent[position](world, entity))
+}
+
+func TestWorld_AddComponent_ReplacesExisting(t *testing.T) {
+	world := NewWorld()
+	entity := world.CreateEntity()
+
+	require.NoError(t, AddComponent(world, entity, position{X: 1}))
+	require.NoError(t, AddComponent(world, entity, position{X: 5}))
+
+	got, _ := GetComponent[position](world, entity)
+	assert.Equal(t, 5.0, got.X)
+}
+
+func TestWorld_AddComponent_UnknownEntity(t *testing.T) {
+	world := NewWorld()
+
+	err := AddComponent(world, Entity(42), position{})
+
+	assert.Error(t, err)
+}
+
+func TestWorld_Query(t *testing.T) {
+	// Arrange
+	world := NewWorld()
+	a := world.CreateEntity()
+	b := world.CreateEntity()
+	c := world.CreateEntity()
+	require.NoError(t, AddComponent(world, c, position{}))
+	require.NoError(t, AddComponent(world, a, position{}))
+	require.NoError(t, AddComponent(world, b, velocity{}))
+
+	// Act & Assert
+	assert.Equal(t, []Entity{a, c}, Query[position](world))
+	assert.Equal(t, []Entity{b}, Query[velocity](world))
+	assert.Empty(t, Query[string](world))
+}
+
+func TestWorld_DestroyEntity_RecyclesID(t *testing.T) {
+	// Arrange
+	world := NewWorld()
+	first := world.CreateEntity()
+	require.NoError(t, AddComponent(world, first, position{X: 3}))
+
+	// Act
+	assert.True(t, world.DestroyEntity(first))
+	recycled := world.CreateEntity()
+
+	// Assert
+	assert.Equal(t, first, recycled, "破棄したIDは再利用される")
+	assert.True(t, world.IsAlive(recycled))
+	assert.False(t, HasComponent[position](world, recycled), "再利用されたエンティティは以前のコンポーネントを持たない")
+	assert.False(t, world.DestroyEntity(Entity(999)))
+}
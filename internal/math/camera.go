@@ -5,6 +5,10 @@ type Camera2D struct {
 	Position Vector2
 	Zoom     float64
 	Rotation float64 // in radians
+
+	// ズーム制限（0以下は制限なし）
+	minZoom float64
+	maxZoom float64
 }
 
 // NewCamera2D creates a new 2D camera with default values
@@ -104,6 +108,29 @@ func (c Camera2D) WorldToScreen(worldPos Vector2, screenWidth, screenHeight floa
 	}
 }
 
+// GetPixelViewMatrix returns the view matrix for pixel-unit world coordinates (Y down, like the screen)
+// The camera position maps to the screen centre and zoom/rotation are applied about it,
+// so one world unit is one pixel at zoom 1 on both axes
+func (c Camera2D) GetPixelViewMatrix(screenWidth, screenHeight float64) Matrix3x3 {
+	center := NewTranslationMatrix3x3(screenWidth/2.0, screenHeight/2.0)
+	return center.Multiply(c.GetViewMatrix())
+}
+
+// WorldToScreenPixels converts pixel-unit world coordinates to screen coordinates
+// It is the inverse of ScreenPixelsToWorld and matches GetPixelViewMatrix
+func (c Camera2D) WorldToScreenPixels(worldPos Vector2, screenWidth, screenHeight float64) Vector2 {
+	return c.GetPixelViewMatrix(screenWidth, screenHeight).TransformPoint(worldPos)
+}
+
+// ScreenPixelsToWorld converts screen coordinates to pixel-unit world coordinates
+func (c Camera2D) ScreenPixelsToWorld(screenPos Vector2, screenWidth, screenHeight float64) Vector2 {
+	inverse, err := c.GetPixelViewMatrix(screenWidth, screenHeight).Inverse()
+	if err != nil {
+		return c.Position // Fallback to the camera position if the matrix is singular
+	}
+	return inverse.TransformPoint(screenPos)
+}
+
 // SetPosition sets the camera position
 func (c *Camera2D) SetPosition(position Vector2) {
 	c.Position = position
@@ -112,8 +139,35 @@ func (c *Camera2D) SetPosition(position Vector2) {
 // SetZoom sets the camera zoom level
 func (c *Camera2D) SetZoom(zoom float64) {
 	if zoom > 0 {
-		c.Zoom = zoom
+		c.Zoom = c.clampZoom(zoom)
+	}
+}
+
+// SetZoomLimits sets the allowed zoom range (values <= 0 mean unbounded)
+func (c *Camera2D) SetZoomLimits(min, max float64) {
+	if min > 0 && max > 0 && min > max {
+		return // 範囲が反転している場合は無視
 	}
+	
+	c.minZoom = min
+	c.maxZoom = max
+	c.Zoom = c.clampZoom(c.Zoom)
+}
+
+// GetZoomLimits returns the configured zoom range (0 means unbounded)
+func (c Camera2D) GetZoomLimits() (float64, float64) {
+	return c.minZoom, c.maxZoom
+}
+
+// clampZoom clamps the zoom value to the configured limits
+func (c Camera2D) clampZoom(zoom float64) float64 {
+	if c.minZoom > 0 && zoom < c.minZoom {
+		return c.minZoom
+	}
+	if c.maxZoom > 0 && zoom > c.maxZoom {
+		return c.maxZoom
+	}
+	return zoom
 }
 
 // SetRotation sets the camera rotation in radians
@@ -134,10 +188,25 @@ func (c *Camera2D) Move(offset Vector2) {
 // ZoomBy multiplies the current zoom by the given factor
 func (c *Camera2D) ZoomBy(factor float64) {
 	if factor > 0 {
-		c.Zoom *= factor
+		c.Zoom = c.clampZoom(c.Zoom * factor)
 	}
 }
 
+// ZoomToPoint multiplies the zoom by the given factor while keeping the world point
+// under the given screen position fixed (e.g. zooming toward the mouse cursor)
+func (c *Camera2D) ZoomToPoint(screenPos Vector2, factor, screenWidth, screenHeight float64) {
+	if factor <= 0 {
+		return
+	}
+	
+	before := c.ScreenToWorld(screenPos, screenWidth, screenHeight)
+	c.ZoomBy(factor)
+	after := c.ScreenToWorld(screenPos, screenWidth, screenHeight)
+	
+	// Shift the camera so the anchor point maps back under the cursor
+	c.Position = c.Position.Add(before.Sub(after))
+}
+
 // Rotate rotates the camera by the given angle in radians
 func (c *Camera2D) Rotate(angle float64) {
 	c.Rotation += angle
@@ -131,6 +131,56 @@ func TestCamera2D_SetZoom(t *testing.T) {
 	assert.Equal(t, 2.5, camera.Zoom)
 }
 
+func TestCamera2D_SetZoomLimits(t *testing.T) {
+	camera := NewCamera2D()
+	camera.SetZoomLimits(0.5, 4.0)
+	
+	minZoom, maxZoom := camera.GetZoomLimits()
+	assert.Equal(t, 0.5, minZoom)
+	assert.Equal(t, 4.0, maxZoom)
+	
+	// 上限・下限でクランプされる
+	camera.SetZoom(10.0)
+	assert.Equal(t, 4.0, camera.Zoom)
+	
+	camera.SetZoom(0.1)
+	assert.Equal(t, 0.5, camera.Zoom)
+	
+	// 範囲内の値はそのまま
+	camera.SetZoom(2.0)
+	assert.Equal(t, 2.0, camera.Zoom)
+}
+
+func TestCamera2D_SetZoomLimits_ClampsCurrentZoom(t *testing.T) {
+	camera := NewCamera2DWithValues(Vector2{X: 0, Y: 0}, 8.0, 0.0)
+	
+	camera.SetZoomLimits(1.0, 3.0)
+	
+	assert.Equal(t, 3.0, camera.Zoom)
+}
+
+func TestCamera2D_SetZoomLimits_InvalidRange(t *testing.T) {
+	camera := NewCamera2D()
+	
+	// 最小値が最大値より大きい場合は無視される
+	camera.SetZoomLimits(5.0, 1.0)
+	
+	minZoom, maxZoom := camera.GetZoomLimits()
+	assert.Equal(t, 0.0, minZoom)
+	assert.Equal(t, 0.0, maxZoom)
+}
+
+func TestCamera2D_ZoomLimits_Unset(t *testing.T) {
+	camera := NewCamera2D()
+	
+	// 制限未設定の場合は従来通り任意の正の値を受け付ける
+	camera.SetZoom(1000.0)
+	assert.Equal(t, 1000.0, camera.Zoom)
+	
+	camera.SetZoom(0.001)
+	assert.Equal(t, 0.001, camera.Zoom)
+}
+
 func TestCamera2D_SetRotation(t *testing.T) {
 	camera := NewCamera2D()
 	rotation := stdmath.Pi / 3
@@ -172,6 +222,17 @@ func TestCamera2D_ZoomBy(t *testing.T) {
 	assert.Equal(t, 3.0, camera.Zoom)
 }
 
+func TestCamera2D_ZoomBy_ClampedByLimits(t *testing.T) {
+	camera := NewCamera2D()
+	camera.SetZoomLimits(0.25, 2.0)
+	
+	camera.ZoomBy(10.0)
+	assert.Equal(t, 2.0, camera.Zoom)
+	
+	camera.ZoomBy(0.01)
+	assert.Equal(t, 0.25, camera.Zoom)
+}
+
 func TestCamera2D_Rotate(t *testing.T) {
 	camera := NewCamera2DWithValues(Vector2{X: 0, Y: 0}, 1.0, stdmath.Pi/4)
 	
@@ -252,4 +313,51 @@ func TestCamera2D_FollowTarget_InvalidParameters(t *testing.T) {
 	// Test invalid delta time
 	camera.FollowTarget(target, 5.0, -1.0)
 	assert.Equal(t, originalPosition, camera.Position)
-}
\ No newline at end of file
+}
+
+func TestCamera2D_ZoomToPoint(t *testing.T) {
+	camera := NewCamera2DWithValues(Vector2{X: 0.5, Y: -0.25}, 1.0, 0)
+	cursor := Vector2{X: 600, Y: 150}
+	anchor := camera.ScreenToWorld(cursor, 800, 600)
+	
+	camera.ZoomToPoint(cursor, 2.0, 800, 600)
+	
+	assert.InDelta(t, 2.0, camera.Zoom, Epsilon)
+	after := camera.ScreenToWorld(cursor, 800, 600)
+	assert.InDelta(t, anchor.X, after.X, 1e-9)
+	assert.InDelta(t, anchor.Y, after.Y, 1e-9)
+}
+
+func TestCamera2D_ZoomToPoint_InvalidFactor(t *testing.T) {
+	camera := NewCamera2D()
+	
+	camera.ZoomToPoint(Vector2{X: 100, Y: 100}, 0, 800, 600)
+	
+	assert.Equal(t, 1.0, camera.Zoom)
+	assert.Equal(t, Vector2{X: 0, Y: 0}, camera.Position)
+}
+
+func TestCamera2D_WorldToScreenPixels(t *testing.T) {
+	camera := NewCamera2DWithValues(Vector2{X: 100, Y: 50}, 2.0, 0)
+	
+	center := camera.WorldToScreenPixels(Vector2{X: 100, Y: 50}, 800, 600)
+	right := camera.WorldToScreenPixels(Vector2{X: 110, Y: 50}, 800, 600)
+	below := camera.WorldToScreenPixels(Vector2{X: 100, Y: 60}, 800, 600)
+	
+	// The camera position is at the screen centre and both axes use the same pixel scale
+	assert.InDelta(t, 400.0, center.X, Epsilon)
+	assert.InDelta(t, 300.0, center.Y, Epsilon)
+	assert.InDelta(t, 420.0, right.X, Epsilon)
+	assert.InDelta(t, 320.0, below.Y, Epsilon, "world Y points down like the screen")
+}
+
+func TestCamera2D_ScreenPixelsToWorld_RoundTrip(t *testing.T) {
+	camera := NewCamera2DWithValues(Vector2{X: -30, Y: 75}, 1.5, 0.6)
+	world := Vector2{X: 12, Y: -40}
+	
+	screen := camera.WorldToScreenPixels(world, 1280, 720)
+	back := camera.ScreenPixelsToWorld(screen, 1280, 720)
+	
+	assert.InDelta(t, world.X, back.X, 1e-9)
+	assert.InDelta(t, world.Y, back.Y, 1e-9)
+}
@@ -0,0 +1,40 @@
+package math
+
+import (
+	stdmath "math"
+)
+
+// CircleCircle reports whether two circles overlap; exactly touching circles count as overlapping
+func CircleCircle(c1 Vector2, r1 float64, c2 Vector2, r2 float64) bool {
+	radiusSum := r1 + r2
+	return c1.Sub(c2).LengthSquared() <= radiusSum*radiusSum
+}
+
+// CircleRect reports whether a circle overlaps a rectangle
+// The circle center is clamped to the rectangle and the squared distance to that closest point is compared
+func CircleRect(center Vector2, radius float64, rect Rect) bool {
+	closest := Vector2{
+		X: stdmath.Max(rect.Min.X, stdmath.Min(center.X, rect.Max.X)),
+		Y: stdmath.Max(rect.Min.Y, stdmath.Min(center.Y, rect.Max.Y)),
+	}
+	return center.Sub(closest).LengthSquared() <= radius*radius
+}
+
+// CircleCirclePenetration returns how deeply two circles overlap and the vector that moves
+// the second circle out of the first (pointing from c1 to c2, with length depth)
+// The bool is false when the circles do not overlap or only touch.
+// Coincident centers are separated along the positive X axis.
+func CircleCirclePenetration(c1 Vector2, r1 float64, c2 Vector2, r2 float64) (float64, Vector2, bool) {
+	delta := c2.Sub(c1)
+	distance := delta.Length()
+	depth := r1 + r2 - distance
+	if depth <= 0 {
+		return 0, Vector2{}, false
+	}
+
+	normal := Vector2{X: 1, Y: 0}
+	if !IsZero(distance) {
+		normal = delta.Scale(1.0 / distance)
+	}
+	return depth, normal.Scale(depth), true
+}
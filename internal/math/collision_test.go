@@ -0,0 +1,79 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircleCircle(t *testing.T) {
+	tests := []struct {
+		name     string
+		c2       Vector2
+		expected bool
+	}{
+		{"overlapping", Vector2{X: 4, Y: 0}, true},
+		{"exactly touching", Vector2{X: 5, Y: 0}, true},
+		{"exactly touching diagonal", Vector2{X: 3, Y: 4}, true},
+		{"separated", Vector2{X: 5.001, Y: 0}, false},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CircleCircle(Vector2{}, 2, tt.c2, 3))
+		})
+	}
+}
+
+func TestCircleRect(t *testing.T) {
+	rect := NewRect(0, 0, 10, 10)
+	
+	tests := []struct {
+		name     string
+		center   Vector2
+		expected bool
+	}{
+		{"center inside", Vector2{X: 5, Y: 5}, true},
+		{"overlapping edge", Vector2{X: 11, Y: 5}, true},
+		{"touching edge", Vector2{X: 12, Y: 5}, true},
+		{"past edge", Vector2{X: 12.5, Y: 5}, false},
+		{"overlapping corner", Vector2{X: 11, Y: 11}, true},
+		// 辺の延長線上では届く距離でも、角からは遠いので重ならない
+		{"near corner diagonal", Vector2{X: 11.5, Y: 11.5}, false},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CircleRect(tt.center, 2, rect))
+		})
+	}
+}
+
+func TestCircleCirclePenetration(t *testing.T) {
+	depth, resolution, ok := CircleCirclePenetration(Vector2{X: 0, Y: 0}, 2, Vector2{X: 0, Y: 4}, 3)
+	
+	assert.True(t, ok)
+	assert.InDelta(t, 1.0, depth, Epsilon)
+	assert.InDelta(t, 0.0, resolution.X, Epsilon)
+	assert.InDelta(t, 1.0, resolution.Y, Epsilon)
+	
+	// 解決ベクトル分だけ動かすと接する
+	moved := Vector2{X: 0, Y: 4}.Add(resolution)
+	assert.InDelta(t, 5.0, moved.Length(), Epsilon)
+}
+
+func TestCircleCirclePenetration_NoOverlap(t *testing.T) {
+	_, _, touching := CircleCirclePenetration(Vector2{}, 2, Vector2{X: 5, Y: 0}, 3)
+	_, _, separated := CircleCirclePenetration(Vector2{}, 2, Vector2{X: 10, Y: 0}, 3)
+	
+	assert.False(t, touching)
+	assert.False(t, separated)
+}
+
+func TestCircleCirclePenetration_CoincidentCenters(t *testing.T) {
+	depth, resolution, ok := CircleCirclePenetration(Vector2{X: 1, Y: 1}, 2, Vector2{X: 1, Y: 1}, 3)
+	
+	assert.True(t, ok)
+	assert.InDelta(t, 5.0, depth, Epsilon)
+	assert.Equal(t, Vector2{X: 5, Y: 0}, resolution)
+}
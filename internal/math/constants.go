@@ -103,6 +103,53 @@ func NormalizeAngle(angle float64) float64 {
 	return angle
 }
 
+// Lerp aとbをtで線形補間する（tはクランプしない）
+func Lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
+
+// Clamp 値をmin〜maxの範囲に制限する（min > maxの場合は入れ替えて扱う）
+func Clamp(value, min, max float64) float64 {
+	if min > max {
+		min, max = max, min
+	}
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
+
+// Clamp01 値を0〜1の範囲に制限する
+func Clamp01(value float64) float64 {
+	return Clamp(value, 0, 1)
+}
+
+// Remap 値をinMin〜inMaxの範囲からoutMin〜outMaxの範囲へ写像する（クランプしない）
+// 入力範囲の幅が0の場合はoutMinを返す
+func Remap(value, inMin, inMax, outMin, outMax float64) float64 {
+	if IsZero(inMax - inMin) {
+		return outMin
+	}
+	return Lerp(outMin, outMax, (value-inMin)/(inMax-inMin))
+}
+
+// NormalizeAngleSigned 角度を(-π, π]の範囲に正規化
+func NormalizeAngleSigned(angle float64) float64 {
+	result := math.Remainder(angle, TwoPi)
+	if result <= -math.Pi {
+		result += TwoPi
+	}
+	return result
+}
+
+// AngleDifference aからbへの最短の回転量を(-π, π]の範囲で返す（正は反時計回り）
+func AngleDifference(a, b float64) float64 {
+	return NormalizeAngleSigned(b - a)
+}
+
 // DegreesToRad 度をラジアンに変換
 func DegreesToRad(degrees float64) float64 {
 	return degrees * DegreesToRadians
@@ -0,0 +1,80 @@
+package math
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeAngleSigned(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float64
+		expected float64
+	}{
+		{"zero", 0, 0},
+		{"within range", 1.0, 1.0},
+		{"positive pi stays", math.Pi, math.Pi},
+		{"negative pi wraps to positive", -math.Pi, math.Pi},
+		{"just over pi", math.Pi + 0.1, -math.Pi + 0.1},
+		{"just under negative pi", -math.Pi - 0.1, math.Pi - 0.1},
+		{"three quarter turn", 3 * HalfPi, -HalfPi},
+		{"many revolutions", 10*TwoPi + 0.5, 0.5},
+		{"many negative revolutions", -7*TwoPi - 0.5, -0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, NormalizeAngleSigned(tt.input), 1e-9)
+		})
+	}
+}
+
+func TestAngleDifference(t *testing.T) {
+	assert.InDelta(t, DegreesToRad(20), AngleDifference(DegreesToRad(350), DegreesToRad(10)), 1e-9)
+	assert.InDelta(t, DegreesToRad(-20), AngleDifference(DegreesToRad(10), DegreesToRad(350)), 1e-9)
+	assert.InDelta(t, HalfPi, AngleDifference(0, HalfPi+5*TwoPi), 1e-9)
+	assert.InDelta(t, 0.0, AngleDifference(1.0, 1.0), 1e-9)
+}
+
+func TestLerp(t *testing.T) {
+	assert.Equal(t, 10.0, Lerp(10, 20, 0))
+	assert.Equal(t, 15.0, Lerp(10, 20, 0.5))
+	assert.Equal(t, 20.0, Lerp(10, 20, 1))
+	assert.Equal(t, 25.0, Lerp(10, 20, 1.5), "t is not clamped")
+}
+
+func TestClamp(t *testing.T) {
+	assert.Equal(t, 5.0, Clamp(5, 0, 10))
+	assert.Equal(t, 0.0, Clamp(-3, 0, 10))
+	assert.Equal(t, 10.0, Clamp(42, 0, 10))
+	assert.Equal(t, 10.0, Clamp(42, 10, 0), "min > max is swapped")
+	assert.Equal(t, 0.0, Clamp(-3, 10, 0))
+}
+
+func TestClamp01(t *testing.T) {
+	tests := []struct {
+		input    float64
+		expected float64
+	}{
+		{-0.5, 0},
+		{0, 0},
+		{0.25, 0.25},
+		{1, 1},
+		{7, 1},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, Clamp01(tt.input), "input %v", tt.input)
+	}
+}
+
+func TestRemap(t *testing.T) {
+	assert.InDelta(t, -1.0, Remap(0, 0, 100, -1, 1), 1e-9)
+	assert.InDelta(t, 0.0, Remap(50, 0, 100, -1, 1), 1e-9)
+	assert.InDelta(t, 1.0, Remap(100, 0, 100, -1, 1), 1e-9)
+	assert.InDelta(t, 0.5, Remap(75, 0, 100, -1, 1), 1e-9)
+	assert.InDelta(t, 2.0, Remap(150, 0, 100, -1, 1), 1e-9, "not clamped")
+	assert.Equal(t, 3.0, Remap(5, 1, 1, 3, 4), "zero-width input range")
+}
@@ -0,0 +1,78 @@
+package math
+
+import (
+	stdmath "math"
+)
+
+// EaseFunc maps normalized time t in [0, 1] to an eased progress value
+// Every easing returns 0 at t=0 and 1 at t=1
+type EaseFunc func(t float64) float64
+
+// EaseLinear returns t unchanged
+func EaseLinear(t float64) float64 {
+	return t
+}
+
+// EaseInQuad accelerates from zero velocity
+func EaseInQuad(t float64) float64 {
+	return t * t
+}
+
+// EaseOutQuad decelerates to zero velocity
+func EaseOutQuad(t float64) float64 {
+	return 1 - (1-t)*(1-t)
+}
+
+// EaseInOutQuad accelerates until halfway, then decelerates
+func EaseInOutQuad(t float64) float64 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return 1 - stdmath.Pow(-2*t+2, 2)/2
+}
+
+// EaseInCubic accelerates from zero velocity more sharply than EaseInQuad
+func EaseInCubic(t float64) float64 {
+	return t * t * t
+}
+
+// EaseOutCubic decelerates to zero velocity more sharply than EaseOutQuad
+func EaseOutCubic(t float64) float64 {
+	return 1 - stdmath.Pow(1-t, 3)
+}
+
+// EaseInOutCubic accelerates until halfway, then decelerates
+func EaseInOutCubic(t float64) float64 {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	return 1 - stdmath.Pow(-2*t+2, 3)/2
+}
+
+// EaseOutBounce decelerates with a series of diminishing bounces toward 1
+// Unlike the other easings it is not monotonic
+func EaseOutBounce(t float64) float64 {
+	const (
+		n1 = 7.5625
+		d1 = 2.75
+	)
+
+	switch {
+	case t < 1/d1:
+		return n1 * t * t
+	case t < 2/d1:
+		t -= 1.5 / d1
+		return n1*t*t + 0.75
+	case t < 2.5/d1:
+		t -= 2.25 / d1
+		return n1*t*t + 0.9375
+	default:
+		t -= 2.625 / d1
+		return n1*t*t + 0.984375
+	}
+}
+
+// EaseInOutSine eases in and out along a half cosine wave
+func EaseInOutSine(t float64) float64 {
+	return -(stdmath.Cos(stdmath.Pi*t) - 1) / 2
+}
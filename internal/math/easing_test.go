@@ -0,0 +1,58 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var easingsUnderTest = map[string]EaseFunc{
+	"Linear":     EaseLinear,
+	"InQuad":     EaseInQuad,
+	"OutQuad":    EaseOutQuad,
+	"InOutQuad":  EaseInOutQuad,
+	"InCubic":    EaseInCubic,
+	"OutCubic":   EaseOutCubic,
+	"InOutCubic": EaseInOutCubic,
+	"OutBounce":  EaseOutBounce,
+	"InOutSine":  EaseInOutSine,
+}
+
+func TestEasing_Endpoints(t *testing.T) {
+	for name, ease := range easingsUnderTest {
+		t.Run(name, func(t *testing.T) {
+			assert.InDelta(t, 0.0, ease(0), Epsilon)
+			assert.InDelta(t, 1.0, ease(1), Epsilon)
+		})
+	}
+}
+
+func TestEasing_Monotonic(t *testing.T) {
+	for name, ease := range easingsUnderTest {
+		if name == "OutBounce" {
+			continue // バウンドするため単調ではない
+		}
+		t.Run(name, func(t *testing.T) {
+			previous := ease(0)
+			for i := 1; i <= 20; i++ {
+				value := ease(float64(i) / 20)
+				assert.GreaterOrEqual(t, value, previous, "t=%v", float64(i)/20)
+				previous = value
+			}
+		})
+	}
+}
+
+func TestEasing_InOutSymmetricAtMidpoint(t *testing.T) {
+	assert.InDelta(t, 0.5, EaseInOutQuad(0.5), Epsilon)
+	assert.InDelta(t, 0.5, EaseInOutCubic(0.5), Epsilon)
+	assert.InDelta(t, 0.5, EaseInOutSine(0.5), Epsilon)
+}
+
+func TestEaseOutBounce_StaysInRange(t *testing.T) {
+	for i := 0; i <= 100; i++ {
+		value := EaseOutBounce(float64(i) / 100)
+		assert.GreaterOrEqual(t, value, 0.0)
+		assert.LessOrEqual(t, value, 1.0+Epsilon)
+	}
+}
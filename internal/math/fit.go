@@ -0,0 +1,32 @@
+package math
+
+import (
+	stdmath "math"
+)
+
+// FitContain computes the uniform scale that fits the whole design size inside the target size
+// (letterboxing/pillarboxing), along with the offsets that center the scaled design in the target
+// Returns a zero scale and offset if the design size is not positive
+func FitContain(designW, designH, targetW, targetH float64) (scale float64, offsetX, offsetY float64) {
+	if designW <= 0 || designH <= 0 {
+		return 0, 0, 0
+	}
+	scale = stdmath.Min(targetW/designW, targetH/designH)
+	return scale, fitOffset(designW, targetW, scale), fitOffset(designH, targetH, scale)
+}
+
+// FitCover computes the uniform scale that makes the design size fully cover the target size
+// (cropping the overflowing axis), along with the offsets that center the scaled design in the target
+// Offsets are negative on the cropped axis. Returns a zero scale and offset if the design size is not positive
+func FitCover(designW, designH, targetW, targetH float64) (scale float64, offsetX, offsetY float64) {
+	if designW <= 0 || designH <= 0 {
+		return 0, 0, 0
+	}
+	scale = stdmath.Max(targetW/designW, targetH/designH)
+	return scale, fitOffset(designW, targetW, scale), fitOffset(designH, targetH, scale)
+}
+
+// fitOffset returns the offset that centers a scaled design extent within the target extent
+func fitOffset(design, target, scale float64) float64 {
+	return (target - design*scale) / 2.0
+}
@@ -0,0 +1,61 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFitContain(t *testing.T) {
+	tests := []struct {
+		name                    string
+		targetW, targetH        float64
+		scale, offsetX, offsetY float64
+	}{
+		{"wider target (pillarbox)", 1600, 600, 1, 400, 0},
+		{"narrower target (letterbox)", 400, 600, 0.5, 0, 150},
+		{"equal aspect ratio", 1600, 1200, 2, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scale, offsetX, offsetY := FitContain(800, 600, tt.targetW, tt.targetH)
+
+			assert.InDelta(t, tt.scale, scale, Epsilon)
+			assert.InDelta(t, tt.offsetX, offsetX, Epsilon)
+			assert.InDelta(t, tt.offsetY, offsetY, Epsilon)
+		})
+	}
+}
+
+func TestFitCover(t *testing.T) {
+	tests := []struct {
+		name                    string
+		targetW, targetH        float64
+		scale, offsetX, offsetY float64
+	}{
+		{"wider target (crop top/bottom)", 1600, 600, 2, 0, -300},
+		{"narrower target (crop left/right)", 400, 600, 1, -200, 0},
+		{"equal aspect ratio", 400, 300, 0.5, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scale, offsetX, offsetY := FitCover(800, 600, tt.targetW, tt.targetH)
+
+			assert.InDelta(t, tt.scale, scale, Epsilon)
+			assert.InDelta(t, tt.offsetX, offsetX, Epsilon)
+			assert.InDelta(t, tt.offsetY, offsetY, Epsilon)
+		})
+	}
+}
+
+func TestFit_DegenerateDesignSize(t *testing.T) {
+	scale, offsetX, offsetY := FitContain(0, 600, 800, 600)
+	assert.Equal(t, 0.0, scale)
+	assert.Equal(t, 0.0, offsetX)
+	assert.Equal(t, 0.0, offsetY)
+
+	scale, _, _ = FitCover(800, -1, 800, 600)
+	assert.Equal(t, 0.0, scale)
+}
@@ -2,6 +2,7 @@ package math
 
 import (
 	"errors"
+	"fmt"
 	"math"
 )
 
@@ -78,6 +79,33 @@ func (m Matrix3x3) TransformPoint(point Vector2) Vector2 {
 	return result.ToVector2()
 }
 
+// TransformPoints transforms each point with TransformPoint and returns the results in a new slice
+func (m Matrix3x3) TransformPoints(points []Vector2) []Vector2 {
+	result := make([]Vector2, len(points))
+	for i, point := range points {
+		result[i] = m.TransformPoint(point)
+	}
+	return result
+}
+
+// TransformVertices transforms packed (x, y, z) vertex triples, returning a new slice
+// x and y are transformed as points; z is copied unchanged.
+// Panics if len(vertices) is not a multiple of 3.
+func (m Matrix3x3) TransformVertices(vertices []float32) []float32 {
+	if len(vertices)%3 != 0 {
+		panic(fmt.Sprintf("math: TransformVertices requires packed (x, y, z) triples, got %d floats", len(vertices)))
+	}
+	
+	result := make([]float32, len(vertices))
+	for i := 0; i < len(vertices); i += 3 {
+		point := m.TransformPoint(Vector2{X: float64(vertices[i]), Y: float64(vertices[i+1])})
+		result[i] = float32(point.X)
+		result[i+1] = float32(point.Y)
+		result[i+2] = vertices[i+2]
+	}
+	return result
+}
+
 // TransformVector transforms a 2D vector (treats as direction with Z=0)
 func (m Matrix3x3) TransformVector(vector Vector2) Vector2 {
 	v3 := Vector3{X: vector.X, Y: vector.Y, Z: 0}
@@ -0,0 +1,110 @@
+package math
+
+import (
+	stdmath "math"
+)
+
+// Matrix4x4 represents a 4x4 matrix in row-major [row][column] order, as used by OpenGL shaders
+// Use ToArray to obtain the column-major layout expected by glUniformMatrix4fv
+type Matrix4x4 [4][4]float32
+
+// NewIdentityMatrix4x4 creates a new identity matrix
+func NewIdentityMatrix4x4() Matrix4x4 {
+	return Matrix4x4{
+		{1, 0, 0, 0},
+		{0, 1, 0, 0},
+		{0, 0, 1, 0},
+		{0, 0, 0, 1},
+	}
+}
+
+// NewOrtho creates an orthographic projection mapping the given box to normalized device coordinates
+// (left, bottom) maps to (-1, -1) and (right, top) maps to (1, 1); near maps to -1 and far to 1 in Z.
+// Passing top < bottom produces a Y-down (screen-space) projection.
+func NewOrtho(left, right, bottom, top, near, far float32) Matrix4x4 {
+	return Matrix4x4{
+		{2 / (right - left), 0, 0, -(right + left) / (right - left)},
+		{0, 2 / (top - bottom), 0, -(top + bottom) / (top - bottom)},
+		{0, 0, -2 / (far - near), -(far + near) / (far - near)},
+		{0, 0, 0, 1},
+	}
+}
+
+// NewTranslationMatrix4x4 creates a translation matrix
+func NewTranslationMatrix4x4(dx, dy, dz float32) Matrix4x4 {
+	return Matrix4x4{
+		{1, 0, 0, dx},
+		{0, 1, 0, dy},
+		{0, 0, 1, dz},
+		{0, 0, 0, 1},
+	}
+}
+
+// NewScaleMatrix4x4 creates a scale matrix
+func NewScaleMatrix4x4(sx, sy, sz float32) Matrix4x4 {
+	return Matrix4x4{
+		{sx, 0, 0, 0},
+		{0, sy, 0, 0},
+		{0, 0, sz, 0},
+		{0, 0, 0, 1},
+	}
+}
+
+// NewRotationZMatrix4x4 creates a rotation matrix around the Z axis (angle in radians)
+func NewRotationZMatrix4x4(angle float64) Matrix4x4 {
+	sin, cos := stdmath.Sincos(angle)
+	s, c := float32(sin), float32(cos)
+
+	return Matrix4x4{
+		{c, -s, 0, 0},
+		{s, c, 0, 0},
+		{0, 0, 1, 0},
+		{0, 0, 0, 1},
+	}
+}
+
+// FromMatrix3x3 embeds a 2D homogeneous transform in the XY plane, leaving Z unchanged
+func FromMatrix3x3(m Matrix3x3) Matrix4x4 {
+	return Matrix4x4{
+		{float32(m[0][0]), float32(m[0][1]), 0, float32(m[0][2])},
+		{float32(m[1][0]), float32(m[1][1]), 0, float32(m[1][2])},
+		{0, 0, 1, 0},
+		{float32(m[2][0]), float32(m[2][1]), 0, float32(m[2][2])},
+	}
+}
+
+// Multiply multiplies this matrix with another matrix (m * other)
+func (m Matrix4x4) Multiply(other Matrix4x4) Matrix4x4 {
+	var result Matrix4x4
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			for k := 0; k < 4; k++ {
+				result[i][j] += m[i][k] * other[k][j]
+			}
+		}
+	}
+	return result
+}
+
+// TransformPoint transforms a 3D point (treated as homogeneous with W=1) and divides by W
+func (m Matrix4x4) TransformPoint(x, y, z float32) (float32, float32, float32) {
+	rx := m[0][0]*x + m[0][1]*y + m[0][2]*z + m[0][3]
+	ry := m[1][0]*x + m[1][1]*y + m[1][2]*z + m[1][3]
+	rz := m[2][0]*x + m[2][1]*y + m[2][2]*z + m[2][3]
+	w := m[3][0]*x + m[3][1]*y + m[3][2]*z + m[3][3]
+	if w == 0 || w == 1 {
+		return rx, ry, rz
+	}
+	return rx / w, ry / w, rz / w
+}
+
+// ToArray returns the matrix in column-major order, as expected by glUniformMatrix4fv (transpose=false)
+func (m Matrix4x4) ToArray() [16]float32 {
+	var result [16]float32
+	for column := 0; column < 4; column++ {
+		for row := 0; row < 4; row++ {
+			result[column*4+row] = m[row][column]
+		}
+	}
+	return result
+}
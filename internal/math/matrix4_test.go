@@ -0,0 +1,75 @@
+package math
+
+import (
+	stdmath "math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOrtho_MapsCornersToNDC(t *testing.T) {
+	m := NewOrtho(0, 800, 0, 600, -1, 1)
+
+	x, y, _ := m.TransformPoint(0, 0, 0)
+	assert.InDelta(t, -1.0, x, 1e-6)
+	assert.InDelta(t, -1.0, y, 1e-6)
+
+	x, y, _ = m.TransformPoint(800, 600, 0)
+	assert.InDelta(t, 1.0, x, 1e-6)
+	assert.InDelta(t, 1.0, y, 1e-6)
+
+	x, y, _ = m.TransformPoint(400, 300, 0)
+	assert.InDelta(t, 0.0, x, 1e-6)
+	assert.InDelta(t, 0.0, y, 1e-6)
+}
+
+func TestNewOrtho_YDownScreenSpace(t *testing.T) {
+	// bottom=高さ, top=0 で左上原点のピクセル座標になる
+	m := NewOrtho(0, 800, 600, 0, -1, 1)
+
+	x, y, _ := m.TransformPoint(0, 0, 0)
+	assert.InDelta(t, -1.0, x, 1e-6)
+	assert.InDelta(t, 1.0, y, 1e-6)
+}
+
+func TestMatrix4x4_ToArray_ColumnMajor(t *testing.T) {
+	m := NewTranslationMatrix4x4(3, 4, 5)
+
+	array := m.ToArray()
+
+	assert.Equal(t, float32(1), array[0])
+	assert.Equal(t, [3]float32{3, 4, 5}, [3]float32{array[12], array[13], array[14]}, "translation lives in the last column")
+	assert.Equal(t, float32(1), array[15])
+}
+
+func TestMatrix4x4_MultiplyComposesTransforms(t *testing.T) {
+	// 拡大してから平行移動
+	m := NewTranslationMatrix4x4(10, 0, 0).Multiply(NewScaleMatrix4x4(2, 3, 1))
+
+	x, y, z := m.TransformPoint(1, 1, 0)
+
+	assert.InDelta(t, 12.0, x, 1e-6)
+	assert.InDelta(t, 3.0, y, 1e-6)
+	assert.InDelta(t, 0.0, z, 1e-6)
+	assert.Equal(t, m, NewIdentityMatrix4x4().Multiply(m))
+}
+
+func TestNewRotationZMatrix4x4(t *testing.T) {
+	x, y, _ := NewRotationZMatrix4x4(stdmath.Pi/2).TransformPoint(1, 0, 0)
+
+	assert.InDelta(t, 0.0, x, 1e-6)
+	assert.InDelta(t, 1.0, y, 1e-6)
+}
+
+func TestFromMatrix3x3_MatchesTransformGLMatrix(t *testing.T) {
+	transform := NewTransformWithValues(Vector2{X: 5, Y: -3}, 0.6, Vector2{X: 2, Y: 0.5})
+
+	m := FromMatrix3x3(transform.ToMatrix())
+
+	assert.Equal(t, transform.ToGLMatrix(), m.ToArray())
+	point := transform.TransformPoint(Vector2{X: 1, Y: 2})
+	x, y, z := m.TransformPoint(1, 2, 7)
+	assert.InDelta(t, point.X, x, 1e-5)
+	assert.InDelta(t, point.Y, y, 1e-5)
+	assert.Equal(t, float32(7), z, "Z is unchanged")
+}
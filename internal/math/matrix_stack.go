@@ -0,0 +1,70 @@
+package math
+
+// MatrixStack is a stack of 2D transformation matrices for hierarchical drawing
+// The top of the stack is the current transform; Push saves it and Pop restores it
+type MatrixStack struct {
+	stack []Matrix3x3
+}
+
+// NewMatrixStack creates a new stack whose current transform is the identity
+func NewMatrixStack() *MatrixStack {
+	return &MatrixStack{
+		stack: []Matrix3x3{NewIdentityMatrix3x3()},
+	}
+}
+
+// Push saves the current transform by duplicating it onto the stack
+func (s *MatrixStack) Push() {
+	s.stack = append(s.stack, s.Top())
+}
+
+// Pop restores the previously saved transform
+// Returns false (leaving the stack unchanged) if there is no saved transform
+func (s *MatrixStack) Pop() bool {
+	if len(s.stack) <= 1 {
+		return false
+	}
+	s.stack = s.stack[:len(s.stack)-1]
+	return true
+}
+
+// Top returns the current transform
+func (s *MatrixStack) Top() Matrix3x3 {
+	return s.stack[len(s.stack)-1]
+}
+
+// Depth returns the number of saved transforms (0 when only the base transform remains)
+func (s *MatrixStack) Depth() int {
+	return len(s.stack) - 1
+}
+
+// Set replaces the current transform
+func (s *MatrixStack) Set(m Matrix3x3) {
+	s.stack[len(s.stack)-1] = m
+}
+
+// Multiply post-multiplies the current transform by m, so m is applied to points first
+func (s *MatrixStack) Multiply(m Matrix3x3) {
+	s.Set(s.Top().Multiply(m))
+}
+
+// Translate applies a translation in the current local space
+func (s *MatrixStack) Translate(dx, dy float64) {
+	s.Multiply(NewTranslationMatrix3x3(dx, dy))
+}
+
+// Rotate applies a rotation (in radians) in the current local space
+func (s *MatrixStack) Rotate(angle float64) {
+	s.Multiply(NewRotationMatrix3x3(angle))
+}
+
+// Scale applies a scale in the current local space
+func (s *MatrixStack) Scale(sx, sy float64) {
+	s.Multiply(NewScaleMatrix3x3(sx, sy))
+}
+
+// Reset discards all saved transforms and sets the current transform to the identity
+func (s *MatrixStack) Reset() {
+	s.stack = s.stack[:1]
+	s.stack[0] = NewIdentityMatrix3x3()
+}
@@ -0,0 +1,45 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatrixStack_PushPop(t *testing.T) {
+	stack := NewMatrixStack()
+	assert.True(t, stack.Top().IsIdentity())
+	
+	stack.Translate(10, 0)
+	stack.Push()
+	stack.Translate(0, 5)
+	assert.Equal(t, Vector2{X: 10, Y: 5}, stack.Top().TransformPoint(Vector2{}))
+	assert.Equal(t, 1, stack.Depth())
+	
+	assert.True(t, stack.Pop())
+	assert.Equal(t, Vector2{X: 10, Y: 0}, stack.Top().TransformPoint(Vector2{}))
+	assert.False(t, stack.Pop(), "the base transform cannot be popped")
+}
+
+func TestMatrixStack_LocalSpaceOrder(t *testing.T) {
+	stack := NewMatrixStack()
+	
+	// Translate then scale: the scale applies in the translated local space
+	stack.Translate(10, 10)
+	stack.Scale(2, 2)
+	
+	result := stack.Top().TransformPoint(Vector2{X: 1, Y: 1})
+	assert.InDelta(t, 12.0, result.X, Epsilon)
+	assert.InDelta(t, 12.0, result.Y, Epsilon)
+}
+
+func TestMatrixStack_Reset(t *testing.T) {
+	stack := NewMatrixStack()
+	stack.Push()
+	stack.Rotate(1.0)
+	
+	stack.Reset()
+	
+	assert.Equal(t, 0, stack.Depth())
+	assert.True(t, stack.Top().IsIdentity())
+}
@@ -142,6 +142,44 @@ func TestMatrix3x3_Determinant(t *testing.T) {
 	assert.Equal(t, 6.0, det2)
 }
 
+func TestMatrix3x3_TransformPoints(t *testing.T) {
+	m := NewTranslationMatrix3x3(5, -2).Multiply(NewRotationMatrix3x3(math.Pi / 3))
+	points := []Vector2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: -3, Y: 4.5}}
+	
+	result := m.TransformPoints(points)
+	
+	assert.Len(t, result, len(points))
+	for i, point := range points {
+		expected := m.TransformPoint(point)
+		assert.InDelta(t, expected.X, result[i].X, Epsilon)
+		assert.InDelta(t, expected.Y, result[i].Y, Epsilon)
+	}
+}
+
+func TestMatrix3x3_TransformVertices(t *testing.T) {
+	m := NewScaleMatrix3x3(2, 3).Multiply(NewRotationMatrix3x3(0.7))
+	vertices := []float32{1, 2, 0.5, -4, 0, 0, 3, -3, 1}
+	
+	result := m.TransformVertices(vertices)
+	
+	assert.Len(t, result, len(vertices))
+	for i := 0; i < len(vertices); i += 3 {
+		expected := m.TransformPoint(Vector2{X: float64(vertices[i]), Y: float64(vertices[i+1])})
+		assert.InDelta(t, expected.X, result[i], 1e-5)
+		assert.InDelta(t, expected.Y, result[i+1], 1e-5)
+		assert.Equal(t, vertices[i+2], result[i+2], "z is preserved")
+	}
+	assert.Equal(t, []float32{1, 2, 0.5, -4, 0, 0, 3, -3, 1}, vertices, "input is not modified")
+}
+
+func TestMatrix3x3_TransformVertices_PanicsOnPartialTriple(t *testing.T) {
+	m := NewIdentityMatrix3x3()
+	
+	assert.PanicsWithValue(t, "math: TransformVertices requires packed (x, y, z) triples, got 4 floats", func() {
+		m.TransformVertices([]float32{1, 2, 3, 4})
+	})
+}
+
 func TestMatrix3x3_Inverse(t *testing.T) {
 	// Invertible matrix
 	matrix := Matrix3x3{
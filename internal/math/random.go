@@ -0,0 +1,106 @@
+package math
+
+import (
+	stdmath "math"
+)
+
+// SplitMix64 の定数
+const (
+	splitMixIncrement = 0x9E3779B97F4A7C15
+	splitMixMul1      = 0xBF58476D1CE4E5B9
+	splitMixMul2      = 0x94D049BB133111EB
+	
+	// float64の仮数部に収まる53ビットの正規化係数
+	float64Unit = 1.0 / (1 << 53)
+)
+
+// Rng is a deterministic, seedable pseudo random number generator
+// It uses the SplitMix64 algorithm, so the same seed yields the same sequence on every platform
+type Rng struct {
+	state uint64
+}
+
+// NewRng creates a new random number generator with the given seed
+func NewRng(seed uint64) *Rng {
+	return &Rng{state: seed}
+}
+
+// Uint64 returns the next pseudo random 64-bit value
+func (r *Rng) Uint64() uint64 {
+	r.state += splitMixIncrement
+	z := r.state
+	z = (z ^ (z >> 30)) * splitMixMul1
+	z = (z ^ (z >> 27)) * splitMixMul2
+	return z ^ (z >> 31)
+}
+
+// Float64 returns a pseudo random value in [0, 1)
+func (r *Rng) Float64() float64 {
+	return float64(r.Uint64()>>11) * float64Unit
+}
+
+// Range returns a pseudo random value in [min, max)
+func (r *Rng) Range(min, max float64) float64 {
+	return Lerp(min, max, r.Float64())
+}
+
+// UnitVector2 returns a pseudo random direction of length 1, uniformly distributed by angle
+func (r *Rng) UnitVector2() Vector2 {
+	sin, cos := stdmath.Sincos(r.Float64() * TwoPi)
+	return Vector2{X: cos, Y: sin}
+}
+
+// IntN returns a pseudo random integer in [0, n) (returns 0 if n <= 0)
+func (r *Rng) IntN(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	
+	// 剰余による偏りを避けるため、範囲外の値は棄却する
+	bound := uint64(n)
+	limit := ^uint64(0) - (^uint64(0) % bound)
+	for {
+		value := r.Uint64()
+		if value < limit {
+			return int(value % bound)
+		}
+	}
+}
+
+// WeightedPick returns an index chosen with probability proportional to its weight
+// Negative weights are treated as zero; returns -1 if the total weight is zero
+func (r *Rng) WeightedPick(weights []float64) int {
+	total := 0.0
+	for _, w := range weights {
+		if w > 0 {
+			total += w
+		}
+	}
+	if total <= 0 {
+		return -1
+	}
+	
+	target := r.Float64() * total
+	last := -1
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		if target < w {
+			return i
+		}
+		target -= w
+		last = i
+	}
+	
+	// 浮動小数点誤差で末尾を超えた場合は最後の有効なインデックスを返す
+	return last
+}
+
+// Shuffle randomly permutes the slice in place using the Fisher-Yates algorithm
+func Shuffle[T any](r *Rng, s []T) {
+	for i := len(s) - 1; i > 0; i-- {
+		j := r.IntN(i + 1)
+		s[i], s[j] = s[j], s[i]
+	}
+}
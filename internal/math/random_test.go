@@ -0,0 +1,129 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRng_SameSeedSameSequence(t *testing.T) {
+	a := NewRng(42)
+	b := NewRng(42)
+	
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, a.Uint64(), b.Uint64())
+	}
+}
+
+func TestRng_Float64Range(t *testing.T) {
+	rng := NewRng(1)
+	
+	for i := 0; i < 1000; i++ {
+		value := rng.Float64()
+		assert.GreaterOrEqual(t, value, 0.0)
+		assert.Less(t, value, 1.0)
+	}
+}
+
+func TestRng_SameSeedSameDerivedSequence(t *testing.T) {
+	a := NewRng(2024)
+	b := NewRng(2024)
+	
+	for i := 0; i < 50; i++ {
+		assert.Equal(t, a.Float64(), b.Float64())
+		assert.Equal(t, a.Range(-5, 5), b.Range(-5, 5))
+		assert.Equal(t, a.IntN(100), b.IntN(100))
+		assert.Equal(t, a.UnitVector2(), b.UnitVector2())
+	}
+}
+
+func TestRng_DifferentSeedsDiffer(t *testing.T) {
+	assert.NotEqual(t, NewRng(1).Uint64(), NewRng(2).Uint64())
+}
+
+func TestRng_Range(t *testing.T) {
+	rng := NewRng(3)
+	
+	for i := 0; i < 1000; i++ {
+		value := rng.Range(-2.5, 4)
+		assert.GreaterOrEqual(t, value, -2.5)
+		assert.Less(t, value, 4.0)
+	}
+}
+
+func TestRng_UnitVector2(t *testing.T) {
+	rng := NewRng(5)
+	
+	// 全象限に分布する単位ベクトル
+	quadrants := map[[2]bool]bool{}
+	for i := 0; i < 200; i++ {
+		v := rng.UnitVector2()
+		assert.InDelta(t, 1.0, v.Length(), EpsilonNormal)
+		quadrants[[2]bool{v.X >= 0, v.Y >= 0}] = true
+	}
+	assert.Len(t, quadrants, 4)
+}
+
+func TestRng_IntN(t *testing.T) {
+	rng := NewRng(7)
+	
+	for i := 0; i < 1000; i++ {
+		value := rng.IntN(6)
+		assert.GreaterOrEqual(t, value, 0)
+		assert.Less(t, value, 6)
+	}
+	
+	// 0以下の場合は0を返す
+	assert.Equal(t, 0, rng.IntN(0))
+	assert.Equal(t, 0, rng.IntN(-3))
+}
+
+func TestShuffle_Reproducible(t *testing.T) {
+	a := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	b := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	
+	Shuffle(NewRng(123), a)
+	Shuffle(NewRng(123), b)
+	
+	// 同じシードでは同じ並びになる
+	assert.Equal(t, a, b)
+	// 要素自体は失われない
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, a)
+	// 元の順序から並び替えられている
+	assert.NotEqual(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, a)
+}
+
+func TestShuffle_EmptyAndSingle(t *testing.T) {
+	rng := NewRng(1)
+	
+	assert.NotPanics(t, func() {
+		Shuffle(rng, []string{})
+		Shuffle(rng, []string{"a"})
+	})
+}
+
+func TestRng_WeightedPick_Distribution(t *testing.T) {
+	rng := NewRng(2024)
+	weights := []float64{1, 3, 0, 6}
+	counts := make([]int, len(weights))
+	samples := 100000
+	
+	for i := 0; i < samples; i++ {
+		counts[rng.WeightedPick(weights)]++
+	}
+	
+	// 重み0のインデックスは選ばれない
+	assert.Equal(t, 0, counts[2])
+	// 重みの比率に近い分布になる
+	assert.InDelta(t, 0.1, float64(counts[0])/float64(samples), 0.01)
+	assert.InDelta(t, 0.3, float64(counts[1])/float64(samples), 0.01)
+	assert.InDelta(t, 0.6, float64(counts[3])/float64(samples), 0.01)
+}
+
+func TestRng_WeightedPick_ZeroTotal(t *testing.T) {
+	rng := NewRng(1)
+	
+	assert.Equal(t, -1, rng.WeightedPick(nil))
+	assert.Equal(t, -1, rng.WeightedPick([]float64{0, 0}))
+	assert.Equal(t, -1, rng.WeightedPick([]float64{-1, 0}))
+}
@@ -0,0 +1,108 @@
+package math
+
+import (
+	stdmath "math"
+)
+
+// Rect represents an axis-aligned rectangle defined by its min and max corners
+type Rect struct {
+	Min, Max Vector2
+}
+
+// NewRect creates a new rectangle from its top-left corner and size
+func NewRect(x, y, w, h float64) Rect {
+	return Rect{
+		Min: Vector2{X: x, Y: y},
+		Max: Vector2{X: x + w, Y: y + h},
+	}
+}
+
+// NewRectFromCenter creates a new rectangle centered on center with the given size
+func NewRectFromCenter(center Vector2, size Vector2) Rect {
+	half := size.Scale(0.5)
+	return Rect{
+		Min: center.Sub(half),
+		Max: center.Add(half),
+	}
+}
+
+// Width returns the width of the rectangle
+func (r Rect) Width() float64 {
+	return r.Max.X - r.Min.X
+}
+
+// Height returns the height of the rectangle
+func (r Rect) Height() float64 {
+	return r.Max.Y - r.Min.Y
+}
+
+// Center returns the center point of the rectangle
+func (r Rect) Center() Vector2 {
+	return Vector2{
+		X: (r.Min.X + r.Max.X) / 2.0,
+		Y: (r.Min.Y + r.Max.Y) / 2.0,
+	}
+}
+
+// Inflate grows the rectangle by dx on each horizontal side and dy on each vertical side
+// Negative values deflate the rectangle; it never becomes inverted (collapses to its center instead)
+func (r Rect) Inflate(dx, dy float64) Rect {
+	center := r.Center()
+	result := Rect{
+		Min: Vector2{X: r.Min.X - dx, Y: r.Min.Y - dy},
+		Max: Vector2{X: r.Max.X + dx, Y: r.Max.Y + dy},
+	}
+	
+	// 反転した場合は中心に潰す
+	if result.Min.X > result.Max.X {
+		result.Min.X = center.X
+		result.Max.X = center.X
+	}
+	if result.Min.Y > result.Max.Y {
+		result.Min.Y = center.Y
+		result.Max.Y = center.Y
+	}
+	
+	return result
+}
+
+// Offset returns the rectangle moved by the given delta
+func (r Rect) Offset(delta Vector2) Rect {
+	return Rect{
+		Min: r.Min.Add(delta),
+		Max: r.Max.Add(delta),
+	}
+}
+
+// Contains reports whether p lies inside the rectangle; points on the edges are contained
+func (r Rect) Contains(p Vector2) bool {
+	return p.X >= r.Min.X && p.X <= r.Max.X &&
+		p.Y >= r.Min.Y && p.Y <= r.Max.Y
+}
+
+// Intersects reports whether the two rectangles overlap
+// Rectangles are closed, so rectangles that only touch along an edge or corner intersect
+func (r Rect) Intersects(other Rect) bool {
+	return r.Min.X <= other.Max.X && other.Min.X <= r.Max.X &&
+		r.Min.Y <= other.Max.Y && other.Min.Y <= r.Max.Y
+}
+
+// Intersection returns the overlapping region of the two rectangles
+// The bool is false when they do not intersect; touching rectangles yield a zero-area rectangle
+func (r Rect) Intersection(other Rect) (Rect, bool) {
+	if !r.Intersects(other) {
+		return Rect{}, false
+	}
+	return Rect{
+		Min: Vector2{X: stdmath.Max(r.Min.X, other.Min.X), Y: stdmath.Max(r.Min.Y, other.Min.Y)},
+		Max: Vector2{X: stdmath.Min(r.Max.X, other.Max.X), Y: stdmath.Min(r.Max.Y, other.Max.Y)},
+	}, true
+}
+
+// Union returns the smallest rectangle containing both rectangles
+func (r Rect) Union(other Rect) Rect {
+	return Rect{
+		Min: Vector2{X: stdmath.Min(r.Min.X, other.Min.X), Y: stdmath.Min(r.Min.Y, other.Min.Y)},
+		Max: Vector2{X: stdmath.Max(r.Max.X, other.Max.X), Y: stdmath.Max(r.Max.Y, other.Max.Y)},
+	}
+}
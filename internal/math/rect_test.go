@@ -0,0 +1,133 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRect_NewRect(t *testing.T) {
+	r := NewRect(10, 20, 30, 40)
+	
+	assert.Equal(t, Vector2{X: 10, Y: 20}, r.Min)
+	assert.Equal(t, Vector2{X: 40, Y: 60}, r.Max)
+	assert.Equal(t, 30.0, r.Width())
+	assert.Equal(t, 40.0, r.Height())
+}
+
+func TestRect_Center(t *testing.T) {
+	r := NewRect(0, 0, 100, 50)
+	
+	assert.Equal(t, Vector2{X: 50, Y: 25}, r.Center())
+}
+
+func TestRect_Inflate(t *testing.T) {
+	r := NewRect(10, 10, 20, 20)
+	
+	result := r.Inflate(5, 2)
+	
+	assert.Equal(t, Vector2{X: 5, Y: 8}, result.Min)
+	assert.Equal(t, Vector2{X: 35, Y: 32}, result.Max)
+	assert.Equal(t, r.Center(), result.Center())
+}
+
+func TestRect_Deflate(t *testing.T) {
+	r := NewRect(10, 10, 20, 20)
+	
+	result := r.Inflate(-5, -5)
+	
+	assert.Equal(t, Vector2{X: 15, Y: 15}, result.Min)
+	assert.Equal(t, Vector2{X: 25, Y: 25}, result.Max)
+}
+
+func TestRect_DeflatePastZero(t *testing.T) {
+	r := NewRect(10, 10, 20, 20)
+	
+	// 幅・高さ以上に縮めても反転せず中心に潰れる
+	result := r.Inflate(-50, -15)
+	
+	assert.Equal(t, Vector2{X: 20, Y: 20}, result.Min)
+	assert.Equal(t, Vector2{X: 20, Y: 20}, result.Max)
+	assert.Equal(t, 0.0, result.Width())
+	assert.Equal(t, 0.0, result.Height())
+}
+
+func TestRect_Offset(t *testing.T) {
+	r := NewRect(10, 10, 20, 20)
+	
+	result := r.Offset(Vector2{X: 5, Y: -3})
+	
+	assert.Equal(t, Vector2{X: 15, Y: 7}, result.Min)
+	assert.Equal(t, Vector2{X: 35, Y: 27}, result.Max)
+	assert.Equal(t, r.Width(), result.Width())
+	assert.Equal(t, r.Height(), result.Height())
+}
+
+func TestRect_NewRectFromCenter(t *testing.T) {
+	r := NewRectFromCenter(Vector2{X: 10, Y: 10}, Vector2{X: 8, Y: 4})
+	
+	assert.Equal(t, Vector2{X: 6, Y: 8}, r.Min)
+	assert.Equal(t, Vector2{X: 14, Y: 12}, r.Max)
+	assert.Equal(t, Vector2{X: 10, Y: 10}, r.Center())
+}
+
+func TestRect_Contains(t *testing.T) {
+	r := NewRect(0, 0, 10, 10)
+	
+	assert.True(t, r.Contains(Vector2{X: 5, Y: 5}))
+	assert.True(t, r.Contains(Vector2{X: 0, Y: 10}), "edges are contained")
+	assert.False(t, r.Contains(Vector2{X: 10.01, Y: 5}))
+	assert.False(t, r.Contains(Vector2{X: 5, Y: -1}))
+}
+
+func TestRect_Intersects(t *testing.T) {
+	r := NewRect(0, 0, 10, 10)
+	
+	tests := []struct {
+		name     string
+		other    Rect
+		expected bool
+	}{
+		{"overlapping", NewRect(5, 5, 10, 10), true},
+		{"full containment", NewRect(2, 2, 3, 3), true},
+		{"touching edge", NewRect(10, 0, 5, 10), true},
+		{"touching corner", NewRect(10, 10, 5, 5), true},
+		{"disjoint horizontally", NewRect(11, 0, 5, 10), false},
+		{"disjoint vertically", NewRect(0, -6, 10, 5), false},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, r.Intersects(tt.other))
+			assert.Equal(t, tt.expected, tt.other.Intersects(r), "symmetric")
+		})
+	}
+}
+
+func TestRect_Intersection(t *testing.T) {
+	r := NewRect(0, 0, 10, 10)
+	
+	overlap, ok := r.Intersection(NewRect(5, -5, 10, 10))
+	assert.True(t, ok)
+	assert.Equal(t, NewRect(5, 0, 5, 5), overlap)
+	
+	inner := NewRect(2, 3, 4, 5)
+	contained, ok := r.Intersection(inner)
+	assert.True(t, ok)
+	assert.Equal(t, inner, contained)
+	
+	touching, ok := r.Intersection(NewRect(10, 2, 5, 5))
+	assert.True(t, ok)
+	assert.Equal(t, 0.0, touching.Width())
+	assert.Equal(t, 5.0, touching.Height())
+	
+	_, ok = r.Intersection(NewRect(20, 20, 5, 5))
+	assert.False(t, ok)
+}
+
+func TestRect_Union(t *testing.T) {
+	r := NewRect(0, 0, 10, 10)
+	
+	assert.Equal(t, NewRect(0, -5, 25, 15), r.Union(NewRect(20, -5, 5, 5)))
+	assert.Equal(t, r, r.Union(NewRect(2, 2, 3, 3)), "contained rectangle does not grow the union")
+}
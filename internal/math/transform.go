@@ -40,6 +40,12 @@ func (t Transform) ToMatrix() Matrix3x3 {
 	return translation.Multiply(rotation).Multiply(scale)
 }
 
+// ToGLMatrix converts the transform to a column-major 4x4 matrix array, as expected by
+// glUniformMatrix4fv (with transpose=false). The 2D transform is embedded in the XY plane with Z unchanged
+func (t Transform) ToGLMatrix() [16]float32 {
+	return FromMatrix3x3(t.ToMatrix()).ToArray()
+}
+
 // ToInverseMatrix converts the transform to an inverse transformation matrix
 func (t Transform) ToInverseMatrix() (Matrix3x3, error) {
 	matrix := t.ToMatrix()
@@ -116,6 +122,21 @@ func (t *Transform) RotateDegrees(degrees float64) {
 	t.Rotation += DegreesToRad(degrees)
 }
 
+// FaceDirection rotates the transform so that Forward() points along the given direction
+// A zero-length direction leaves the rotation unchanged
+func (t *Transform) FaceDirection(dir Vector2) {
+	if IsZero(dir.LengthSquared()) {
+		return
+	}
+	t.Rotation = dir.Angle()
+}
+
+// FaceTarget rotates the transform so that Forward() points from its position toward the target
+// A target at the current position leaves the rotation unchanged
+func (t *Transform) FaceTarget(target Vector2) {
+	t.FaceDirection(target.Sub(t.Position))
+}
+
 // ScaleBy multiplies the current scale by the given scale
 func (t *Transform) ScaleBy(scale Vector2) {
 	t.Scale.X *= scale.X
@@ -169,6 +190,71 @@ func (t Transform) Combine(other Transform) Transform {
 	}
 }
 
+// RelativeTo returns this transform expressed in the space of the given parent,
+// such that parent.Combine(result) reproduces this transform
+// Returns an error if the parent transform is not invertible (e.g. zero scale)
+func (t Transform) RelativeTo(parent Transform) (Transform, error) {
+	localPosition, err := parent.InverseTransformPoint(t.Position)
+	if err != nil {
+		return Transform{}, err
+	}
+	
+	return Transform{
+		Position: localPosition,
+		Rotation: t.Rotation - parent.Rotation,
+		Scale:    Vector2{X: t.Scale.X / parent.Scale.X, Y: t.Scale.Y / parent.Scale.Y},
+	}, nil
+}
+
+// Lerp interpolates between this transform and target by alpha (0 = this, 1 = target)
+// Position and scale are interpolated linearly; rotation follows the shortest arc,
+// so interpolating from 350° to 10° passes through 0° rather than 180°
+func (t Transform) Lerp(target Transform, alpha float64) Transform {
+	rotationDelta := AngleDifference(t.Rotation, target.Rotation)
+	
+	return Transform{
+		Position: t.Position.Lerp(target.Position, alpha),
+		Rotation: t.Rotation + rotationDelta*alpha,
+		Scale:    t.Scale.Lerp(target.Scale, alpha),
+	}
+}
+
+// LerpTo is an alias of Lerp, kept so existing callers continue to compile
+func (t Transform) LerpTo(other Transform, alpha float64) Transform {
+	return t.Lerp(other, alpha)
+}
+
+// SmoothDamp moves the position toward target using a critically damped spring
+// velocity carries the current speed between calls and must persist across frames.
+// smoothTime is roughly the time to reach the target; the result is frame-rate independent
+// and never overshoots the target. A non-positive smoothTime snaps to the target.
+func (t *Transform) SmoothDamp(target Vector2, velocity *Vector2, smoothTime, deltaTime float64) {
+	if deltaTime <= 0 {
+		return
+	}
+	if smoothTime <= 0 {
+		t.Position = target
+		*velocity = Vector2{}
+		return
+	}
+	
+	omega := 2.0 / smoothTime
+	x := omega * deltaTime
+	decay := 1.0 / (1.0 + x + 0.48*x*x + 0.235*x*x*x) // approximates exp(-x)
+	
+	change := t.Position.Sub(target)
+	temp := velocity.Add(change.Scale(omega)).Scale(deltaTime)
+	*velocity = velocity.Sub(temp.Scale(omega)).Scale(decay)
+	output := target.Add(change.Add(temp).Scale(decay))
+	
+	// Clamp to the target if this step would pass it
+	if target.Sub(t.Position).Dot(output.Sub(target)) > 0 {
+		output = target
+		*velocity = Vector2{}
+	}
+	t.Position = output
+}
+
 // Equals checks if two transforms are equal (within tolerance)
 func (t Transform) Equals(other Transform) bool {
 	return t.Position.Distance(other.Position) < Epsilon &&
@@ -182,4 +268,57 @@ func (t *Transform) Reset() {
 	t.Position = Vector2{X: 0, Y: 0}
 	t.Rotation = 0
 	t.Scale = Vector2{X: 1, Y: 1}
-}
\ No newline at end of file
+}
+
+// MaxTransformDepth limits how many ancestors TransformNode walks, guarding against
+// accidental cycles and runaway hierarchies
+const MaxTransformDepth = 64
+
+// TransformNode is a transform expressed in its parent's space
+// A nil Parent means Local is already in world space
+type TransformNode struct {
+	Local  Transform
+	Parent *TransformNode
+}
+
+// NewTransformNode creates a node with the given local transform and parent (which may be nil)
+func NewTransformNode(local Transform, parent *TransformNode) *TransformNode {
+	return &TransformNode{
+		Local:  local,
+		Parent: parent,
+	}
+}
+
+// WorldMatrix returns the node's local-to-world matrix by multiplying up the parent chain
+// The walk stops at the first repeated node (a cycle) or after MaxTransformDepth ancestors
+func (n *TransformNode) WorldMatrix() Matrix3x3 {
+	result := n.Local.ToMatrix()
+	visited := map[*TransformNode]bool{n: true}
+	
+	for parent, depth := n.Parent, 0; parent != nil && depth < MaxTransformDepth; parent, depth = parent.Parent, depth+1 {
+		if visited[parent] {
+			break
+		}
+		visited[parent] = true
+		result = parent.Local.ToMatrix().Multiply(result)
+	}
+	return result
+}
+
+// WorldTransform decomposes WorldMatrix into position, rotation and scale
+// Non-uniform parent scale combined with child rotation introduces shear, which cannot be
+// represented and is dropped; the X axis direction and overall area are preserved
+func (n *TransformNode) WorldTransform() Transform {
+	m := n.WorldMatrix()
+	scaleX := stdmath.Hypot(m[0][0], m[1][0])
+	scaleY := 0.0
+	if !IsZero(scaleX) {
+		scaleY = m.Determinant() / scaleX
+	}
+	
+	return Transform{
+		Position: Vector2{X: m[0][2], Y: m[1][2]},
+		Rotation: stdmath.Atan2(m[1][0], m[0][0]),
+		Scale:    Vector2{X: scaleX, Y: scaleY},
+	}
+}
@@ -235,6 +235,196 @@ func TestTransform_Combine(t *testing.T) {
 	assert.InDelta(t, 1.0, combined.Scale.Y, Epsilon)
 }
 
+func TestTransform_RelativeTo(t *testing.T) {
+	child := NewTransformWithValues(
+		Vector2{X: 12, Y: -4},
+		stdmath.Pi/3,
+		Vector2{X: 1.5, Y: 0.75},
+	)
+	
+	parents := []Transform{
+		NewTransform(),
+		NewTransformWithValues(Vector2{X: 5, Y: 3}, 0, Vector2{X: 1, Y: 1}),
+		NewTransformWithValues(Vector2{X: -2, Y: 7}, stdmath.Pi/4, Vector2{X: 2, Y: 2}),
+		NewTransformWithValues(Vector2{X: 10, Y: 10}, -stdmath.Pi/6, Vector2{X: 0.5, Y: 3}),
+	}
+	
+	for _, parent := range parents {
+		relative, err := child.RelativeTo(parent)
+		assert.NoError(t, err)
+		
+		// parent.Combine(relative) は元のchildを再現する
+		reconstructed := parent.Combine(relative)
+		assert.InDelta(t, child.Position.X, reconstructed.Position.X, 1e-9)
+		assert.InDelta(t, child.Position.Y, reconstructed.Position.Y, 1e-9)
+		assert.InDelta(t, child.Rotation, reconstructed.Rotation, 1e-9)
+		assert.InDelta(t, child.Scale.X, reconstructed.Scale.X, 1e-9)
+		assert.InDelta(t, child.Scale.Y, reconstructed.Scale.Y, 1e-9)
+	}
+}
+
+func TestTransform_RelativeTo_NonInvertibleParent(t *testing.T) {
+	child := NewTransformWithValues(Vector2{X: 1, Y: 2}, 0, Vector2{X: 1, Y: 1})
+	parent := NewTransformWithValues(Vector2{X: 0, Y: 0}, 0, Vector2{X: 0, Y: 1})
+	
+	_, err := child.RelativeTo(parent)
+	
+	assert.Error(t, err)
+}
+
+func TestTransform_ToGLMatrix(t *testing.T) {
+	transform := NewTransformWithValues(
+		Vector2{X: 100, Y: -50},
+		stdmath.Pi/3,
+		Vector2{X: 2, Y: 0.5},
+	)
+	
+	glMatrix := transform.ToGLMatrix()
+	
+	// Column-major: element (row, col) is at index col*4+row
+	for _, point := range []Vector2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 3, Y: -7}} {
+		x := float64(glMatrix[0])*point.X + float64(glMatrix[4])*point.Y + float64(glMatrix[12])
+		y := float64(glMatrix[1])*point.X + float64(glMatrix[5])*point.Y + float64(glMatrix[13])
+		w := float64(glMatrix[3])*point.X + float64(glMatrix[7])*point.Y + float64(glMatrix[15])
+		
+		expected := transform.TransformPoint(point)
+		assert.InDelta(t, expected.X, x, 1e-4)
+		assert.InDelta(t, expected.Y, y, 1e-4)
+		assert.Equal(t, 1.0, w)
+	}
+	
+	// Z passes through untouched
+	assert.Equal(t, float32(1), glMatrix[10])
+}
+
+func TestTransform_FaceDirection(t *testing.T) {
+	t.Run("right", func(t *testing.T) {
+		transform := NewTransform()
+		transform.Rotation = 1.0
+		
+		transform.FaceDirection(Vector2{X: 5, Y: 0})
+		
+		assert.InDelta(t, 0.0, transform.Rotation, Epsilon)
+	})
+	
+	t.Run("up", func(t *testing.T) {
+		transform := NewTransform()
+		
+		// Screen space is Y-down, so "up" is negative Y
+		transform.FaceDirection(Vector2{X: 0, Y: -2})
+		
+		assert.InDelta(t, -stdmath.Pi/2, transform.Rotation, Epsilon)
+		assert.InDelta(t, 0.0, transform.Forward().X, Epsilon)
+		assert.InDelta(t, -1.0, transform.Forward().Y, Epsilon)
+	})
+	
+	t.Run("zero direction is a no-op", func(t *testing.T) {
+		transform := NewTransform()
+		transform.Rotation = 0.75
+		
+		transform.FaceDirection(Vector2{X: 0, Y: 0})
+		
+		assert.Equal(t, 0.75, transform.Rotation)
+	})
+}
+
+func TestTransform_FaceTarget(t *testing.T) {
+	transform := NewTransform()
+	transform.Position = Vector2{X: 10, Y: 10}
+	
+	transform.FaceTarget(Vector2{X: 20, Y: 20})
+	assert.InDelta(t, stdmath.Pi/4, transform.Rotation, Epsilon)
+	
+	transform.FaceTarget(Vector2{X: 10, Y: 10})
+	assert.InDelta(t, stdmath.Pi/4, transform.Rotation, Epsilon, "target at the current position keeps rotation")
+}
+
+func TestTransform_LerpTo(t *testing.T) {
+	from := NewTransformWithValues(Vector2{X: 0, Y: 0}, 0, Vector2{X: 1, Y: 1})
+	to := NewTransformWithValues(Vector2{X: 10, Y: -20}, stdmath.Pi/2, Vector2{X: 3, Y: 5})
+	
+	mid := from.LerpTo(to, 0.5)
+	
+	assert.InDelta(t, 5.0, mid.Position.X, 1e-9)
+	assert.InDelta(t, -10.0, mid.Position.Y, 1e-9)
+	assert.InDelta(t, stdmath.Pi/4, mid.Rotation, 1e-9)
+	assert.InDelta(t, 2.0, mid.Scale.X, 1e-9)
+	assert.InDelta(t, 3.0, mid.Scale.Y, 1e-9)
+	assert.True(t, from.LerpTo(to, 0).Equals(from))
+	assert.True(t, from.LerpTo(to, 1).Equals(to))
+}
+
+func TestTransform_LerpTo_ShortestRotation(t *testing.T) {
+	// 350° → 10° は 0° を経由する
+	from := NewTransformWithValues(Vector2{}, DegreesToRad(350), Vector2{X: 1, Y: 1})
+	to := NewTransformWithValues(Vector2{}, DegreesToRad(10), Vector2{X: 1, Y: 1})
+	
+	mid := from.LerpTo(to, 0.5)
+	
+	assert.InDelta(t, 0.0, stdmath.Remainder(mid.Rotation, TwoPi), 1e-9)
+}
+
+func TestTransform_Lerp_ShortestRotationAcrossBoundary(t *testing.T) {
+	from := NewTransformWithValues(Vector2{X: 0, Y: 0}, DegreesToRad(350), Vector2{X: 1, Y: 1})
+	to := NewTransformWithValues(Vector2{X: 10, Y: 0}, DegreesToRad(10), Vector2{X: 3, Y: 1})
+	
+	mid := from.Lerp(to, 0.5)
+	
+	assert.InDelta(t, 0.0, stdmath.Remainder(mid.Rotation, TwoPi), Epsilon, "0°, not 180°")
+	assert.InDelta(t, 5.0, mid.Position.X, Epsilon)
+	assert.InDelta(t, 2.0, mid.Scale.X, Epsilon)
+	
+	// 逆方向も同じく0°を通る
+	back := to.Lerp(from, 0.5)
+	assert.InDelta(t, 0.0, stdmath.Remainder(back.Rotation, TwoPi), Epsilon)
+}
+
+func TestTransform_SmoothDamp_ConvergesWithoutOvershoot(t *testing.T) {
+	// Arrange
+	transform := NewTransform()
+	target := Vector2{X: 100, Y: 0}
+	velocity := Vector2{}
+	
+	// Act
+	for i := 0; i < 180; i++ {
+		transform.SmoothDamp(target, &velocity, 0.3, 1.0/60.0)
+		assert.LessOrEqual(t, transform.Position.X, target.X, "frame %d overshoots", i)
+	}
+	
+	// Assert
+	assert.InDelta(t, 100.0, transform.Position.X, 0.01)
+	assert.InDelta(t, 0.0, velocity.Length(), 0.1)
+}
+
+func TestTransform_SmoothDamp_FrameRateIndependent(t *testing.T) {
+	target := Vector2{X: 50, Y: -20}
+	
+	at60 := NewTransform()
+	velocity60 := Vector2{}
+	for i := 0; i < 30; i++ {
+		at60.SmoothDamp(target, &velocity60, 0.5, 1.0/60.0)
+	}
+	
+	at120 := NewTransform()
+	velocity120 := Vector2{}
+	for i := 0; i < 60; i++ {
+		at120.SmoothDamp(target, &velocity120, 0.5, 1.0/120.0)
+	}
+	
+	assert.InDelta(t, at60.Position.X, at120.Position.X, 0.5)
+	assert.InDelta(t, at60.Position.Y, at120.Position.Y, 0.5)
+}
+
+func TestTransform_SmoothDamp_ZeroSmoothTimeSnaps(t *testing.T) {
+	transform := NewTransform()
+	velocity := Vector2{X: 3, Y: 3}
+	
+	transform.SmoothDamp(Vector2{X: 7, Y: 8}, &velocity, 0, 1.0/60.0)
+	
+	assert.Equal(t, Vector2{X: 7, Y: 8}, transform.Position)
+	assert.Equal(t, Vector2{}, velocity)
+}
+
 func TestTransform_Equals(t *testing.T) {
 	t1 := NewTransformWithValues(
 		Vector2{X: 1, Y: 2},
@@ -269,4 +459,54 @@ func TestTransform_Reset(t *testing.T) {
 	
 	expected := NewTransform()
 	assert.True(t, transform.Equals(expected))
-}
\ No newline at end of file
+}
+
+func TestTransformNode_ChildUnderRotatedParent(t *testing.T) {
+	// Arrange: 90°回転した親の下で (1,0) ずらした子
+	parent := NewTransformNode(NewTransformWithValues(Vector2{X: 10, Y: 5}, HalfPi, Vector2{X: 1, Y: 1}), nil)
+	child := NewTransformNode(NewTransformWithValues(Vector2{X: 1, Y: 0}, 0, Vector2{X: 1, Y: 1}), parent)
+	
+	// Act
+	world := child.WorldTransform()
+	
+	// Assert
+	assert.InDelta(t, 10.0, world.Position.X, Epsilon)
+	assert.InDelta(t, 6.0, world.Position.Y, Epsilon)
+	assert.InDelta(t, HalfPi, world.Rotation, Epsilon)
+	assert.InDelta(t, 1.0, world.Scale.X, Epsilon)
+	assert.InDelta(t, 1.0, world.Scale.Y, Epsilon)
+}
+
+func TestTransformNode_ThreeLevels(t *testing.T) {
+	root := NewTransformNode(NewTransformWithValues(Vector2{X: 0, Y: 0}, 0, Vector2{X: 2, Y: 2}), nil)
+	middle := NewTransformNode(NewTransformWithValues(Vector2{X: 5, Y: 0}, HalfPi, Vector2{X: 1, Y: 1}), root)
+	leaf := NewTransformNode(NewTransformWithValues(Vector2{X: 1, Y: 0}, 0, Vector2{X: 1, Y: 1}), middle)
+	
+	world := leaf.WorldTransform()
+	
+	assert.InDelta(t, 10.0, world.Position.X, Epsilon)
+	assert.InDelta(t, 2.0, world.Position.Y, Epsilon)
+	assert.InDelta(t, 2.0, world.Scale.X, Epsilon)
+	assert.InDelta(t, 2.0, world.Scale.Y, Epsilon)
+	
+	expected := root.Local.ToMatrix().Multiply(middle.Local.ToMatrix()).Multiply(leaf.Local.ToMatrix())
+	assert.True(t, leaf.WorldMatrix().Equals(expected))
+}
+
+func TestTransformNode_NoParentIsLocal(t *testing.T) {
+	local := NewTransformWithValues(Vector2{X: 3, Y: 4}, 0.5, Vector2{X: 2, Y: 3})
+	node := NewTransformNode(local, nil)
+	
+	assert.True(t, node.WorldTransform().Equals(local))
+}
+
+func TestTransformNode_CycleTerminates(t *testing.T) {
+	a := NewTransformNode(NewTransformWithValues(Vector2{X: 1, Y: 0}, 0, Vector2{X: 1, Y: 1}), nil)
+	b := NewTransformNode(NewTransformWithValues(Vector2{X: 1, Y: 0}, 0, Vector2{X: 1, Y: 1}), a)
+	a.Parent = b
+	
+	// 循環していても無限ループにならず、各ノードは一度だけ適用される
+	world := a.WorldTransform()
+	
+	assert.InDelta(t, 2.0, world.Position.X, Epsilon)
+}
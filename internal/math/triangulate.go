@@ -0,0 +1,221 @@
+package math
+
+import (
+	"errors"
+	stdmath "math"
+)
+
+// Triangulation errors
+var (
+	ErrPolygonTooFewVertices   = errors.New("polygon needs at least 3 vertices")
+	ErrPolygonDegenerate       = errors.New("polygon is degenerate (zero area)")
+	ErrPolygonSelfIntersecting = errors.New("polygon is self-intersecting")
+)
+
+// Triangulate splits a simple (non-self-intersecting) polygon into triangles using ear clipping
+// The polygon may be convex or concave and in either winding order
+// Returns triangle indices into the input slice, three per triangle, wound counter-clockwise
+// (in a Y-up coordinate system; clockwise on screen with Y down)
+func Triangulate(polygon []Vector2) ([]uint32, error) {
+	n := len(polygon)
+	if n < 3 {
+		return nil, ErrPolygonTooFewVertices
+	}
+	
+	if allCollinear(polygon) {
+		return nil, ErrPolygonDegenerate
+	}
+	if IsSelfIntersecting(polygon) {
+		return nil, ErrPolygonSelfIntersecting
+	}
+	area := SignedArea(polygon)
+	if IsZero(area) {
+		return nil, ErrPolygonDegenerate
+	}
+	
+	// Work on a counter-clockwise list of remaining vertex indices
+	remaining := make([]int, n)
+	for i := range remaining {
+		if area > 0 {
+			remaining[i] = i
+		} else {
+			remaining[i] = n - 1 - i
+		}
+	}
+	
+	indices := make([]uint32, 0, (n-2)*3)
+	for len(remaining) > 3 {
+		ear := findEar(polygon, remaining)
+		if ear < 0 {
+			// No proper ear: drop a collinear vertex, which contributes no area
+			ear = findCollinear(polygon, remaining)
+			if ear < 0 {
+				return nil, ErrPolygonDegenerate
+			}
+			remaining = append(remaining[:ear], remaining[ear+1:]...)
+			continue
+		}
+		
+		prev, curr, next := neighbours(remaining, ear)
+		indices = append(indices, uint32(prev), uint32(curr), uint32(next))
+		remaining = append(remaining[:ear], remaining[ear+1:]...)
+	}
+	
+	a, b, c := remaining[0], remaining[1], remaining[2]
+	if !IsZero(triangleCross(polygon[a], polygon[b], polygon[c])) {
+		indices = append(indices, uint32(a), uint32(b), uint32(c))
+	}
+	
+	return indices, nil
+}
+
+// SignedArea returns the signed area of a polygon (positive for counter-clockwise winding in Y-up space)
+func SignedArea(polygon []Vector2) float64 {
+	area := 0.0
+	for i := range polygon {
+		j := (i + 1) % len(polygon)
+		area += polygon[i].Cross(polygon[j])
+	}
+	return area / 2.0
+}
+
+// IsConvexPolygon reports whether the polygon is convex (collinear vertices are allowed)
+func IsConvexPolygon(polygon []Vector2) bool {
+	n := len(polygon)
+	if n < 3 {
+		return false
+	}
+	
+	sign := 0.0
+	for i := 0; i < n; i++ {
+		cross := triangleCross(polygon[i], polygon[(i+1)%n], polygon[(i+2)%n])
+		if IsZero(cross) {
+			continue
+		}
+		if sign == 0 {
+			sign = cross
+		} else if (cross > 0) != (sign > 0) {
+			return false
+		}
+	}
+	return sign != 0
+}
+
+// neighbours returns the polygon indices of the vertex at position i in remaining and its neighbours
+func neighbours(remaining []int, i int) (int, int, int) {
+	n := len(remaining)
+	return remaining[(i+n-1)%n], remaining[i], remaining[(i+1)%n]
+}
+
+// findEar returns the position in remaining of a vertex that can be clipped, or -1
+func findEar(polygon []Vector2, remaining []int) int {
+	for i := range remaining {
+		prev, curr, next := neighbours(remaining, i)
+		a, b, c := polygon[prev], polygon[curr], polygon[next]
+		
+		// Reflex or collinear vertices are not ears
+		if triangleCross(a, b, c) <= ZeroThreshold {
+			continue
+		}
+		
+		isEar := true
+		for _, other := range remaining {
+			if other == prev || other == curr || other == next {
+				continue
+			}
+			p := polygon[other]
+			if p == a || p == b || p == c {
+				continue
+			}
+			if pointInTriangle(p, a, b, c) {
+				isEar = false
+				break
+			}
+		}
+		if isEar {
+			return i
+		}
+	}
+	return -1
+}
+
+// findCollinear returns the position in remaining of a vertex collinear with its neighbours, or -1
+func findCollinear(polygon []Vector2, remaining []int) int {
+	for i := range remaining {
+		prev, curr, next := neighbours(remaining, i)
+		if IsZero(triangleCross(polygon[prev], polygon[curr], polygon[next])) {
+			return i
+		}
+	}
+	return -1
+}
+
+// triangleCross returns the z component of (b-a) x (c-b)
+func triangleCross(a, b, c Vector2) float64 {
+	return b.Sub(a).Cross(c.Sub(b))
+}
+
+// pointInTriangle reports whether p lies inside or on the edge of the counter-clockwise triangle abc
+func pointInTriangle(p, a, b, c Vector2) bool {
+	return orientation(a, b, p) >= -ZeroThreshold &&
+		orientation(b, c, p) >= -ZeroThreshold &&
+		orientation(c, a, p) >= -ZeroThreshold
+}
+
+// orientation returns the z component of (b-a) x (p-a)
+func orientation(a, b, p Vector2) float64 {
+	return b.Sub(a).Cross(p.Sub(a))
+}
+
+// allCollinear reports whether every vertex lies on a single line
+func allCollinear(polygon []Vector2) bool {
+	for i := 2; i < len(polygon); i++ {
+		if !IsZero(orientation(polygon[0], polygon[1], polygon[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSelfIntersecting reports whether any two non-adjacent edges of the polygon intersect
+func IsSelfIntersecting(polygon []Vector2) bool {
+	n := len(polygon)
+	for i := 0; i < n; i++ {
+		a1, a2 := polygon[i], polygon[(i+1)%n]
+		for j := i + 1; j < n; j++ {
+			// Skip adjacent edges, which always share a vertex
+			if j == i+1 || (i == 0 && j == n-1) {
+				continue
+			}
+			b1, b2 := polygon[j], polygon[(j+1)%n]
+			if segmentsIntersect(a1, a2, b1, b2) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// segmentsIntersect reports whether segments p1-p2 and q1-q2 intersect (including touching)
+func segmentsIntersect(p1, p2, q1, q2 Vector2) bool {
+	d1 := orientation(q1, q2, p1)
+	d2 := orientation(q1, q2, p2)
+	d3 := orientation(p1, p2, q1)
+	d4 := orientation(p1, p2, q2)
+	
+	if ((d1 > ZeroThreshold && d2 < -ZeroThreshold) || (d1 < -ZeroThreshold && d2 > ZeroThreshold)) &&
+		((d3 > ZeroThreshold && d4 < -ZeroThreshold) || (d3 < -ZeroThreshold && d4 > ZeroThreshold)) {
+		return true
+	}
+	
+	return (IsZero(d1) && onSegment(q1, q2, p1)) ||
+		(IsZero(d2) && onSegment(q1, q2, p2)) ||
+		(IsZero(d3) && onSegment(p1, p2, q1)) ||
+		(IsZero(d4) && onSegment(p1, p2, q2))
+}
+
+// onSegment reports whether p, known to be collinear with a-b, lies within the segment's bounding box
+func onSegment(a, b, p Vector2) bool {
+	return p.X >= stdmath.Min(a.X, b.X)-ZeroThreshold && p.X <= stdmath.Max(a.X, b.X)+ZeroThreshold &&
+		p.Y >= stdmath.Min(a.Y, b.Y)-ZeroThreshold && p.Y <= stdmath.Max(a.Y, b.Y)+ZeroThreshold
+}
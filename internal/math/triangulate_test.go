@@ -0,0 +1,100 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pentagram is a five-pointed star drawn by joining every second vertex of a regular pentagon
+// Every turn has the same sign, so it looks convex vertex by vertex, but its edges cross
+var pentagram = []Vector2{
+	{X: 0, Y: 1}, {X: 0.588, Y: -0.809}, {X: -0.951, Y: 0.309}, {X: 0.951, Y: 0.309}, {X: -0.588, Y: -0.809},
+}
+
+// triangulatedArea sums the absolute areas of the triangles described by indices
+func triangulatedArea(polygon []Vector2, indices []uint32) float64 {
+	total := 0.0
+	for i := 0; i+2 < len(indices); i += 3 {
+		triangle := []Vector2{polygon[indices[i]], polygon[indices[i+1]], polygon[indices[i+2]]}
+		area := SignedArea(triangle)
+		if area < 0 {
+			area = -area
+		}
+		total += area
+	}
+	return total
+}
+
+func TestTriangulate_ConvexQuad(t *testing.T) {
+	quad := []Vector2{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 1}, {X: 0, Y: 1}}
+	
+	indices, err := Triangulate(quad)
+	
+	require.NoError(t, err)
+	assert.Len(t, indices, 6)
+	assert.InDelta(t, 2.0, triangulatedArea(quad, indices), Epsilon)
+}
+
+func TestTriangulate_ConcaveL(t *testing.T) {
+	// Clockwise "L" shape with a reflex vertex at (1, 1)
+	lShape := []Vector2{
+		{X: 0, Y: 0}, {X: 0, Y: 3}, {X: 1, Y: 3},
+		{X: 1, Y: 1}, {X: 3, Y: 1}, {X: 3, Y: 0},
+	}
+	
+	indices, err := Triangulate(lShape)
+	
+	require.NoError(t, err)
+	assert.Len(t, indices, 12, "n-2 triangles")
+	assert.InDelta(t, 5.0, triangulatedArea(lShape, indices), Epsilon)
+	
+	// Every triangle must be counter-clockwise and lie inside the L (no triangle covers the notch)
+	for i := 0; i < len(indices); i += 3 {
+		a, b, c := lShape[indices[i]], lShape[indices[i+1]], lShape[indices[i+2]]
+		assert.Greater(t, SignedArea([]Vector2{a, b, c}), 0.0)
+		centroid := Vector2{X: (a.X + b.X + c.X) / 3, Y: (a.Y + b.Y + c.Y) / 3}
+		assert.False(t, centroid.X > 1 && centroid.Y > 1, "triangle centroid %v lies in the notch", centroid)
+	}
+}
+
+func TestTriangulate_CollinearVertexOnEdge(t *testing.T) {
+	square := []Vector2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}}
+	
+	indices, err := Triangulate(square)
+	
+	require.NoError(t, err)
+	assert.InDelta(t, 4.0, triangulatedArea(square, indices), Epsilon)
+}
+
+func TestTriangulate_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		polygon  []Vector2
+		expected error
+	}{
+		{"too few vertices", []Vector2{{X: 0, Y: 0}, {X: 1, Y: 1}}, ErrPolygonTooFewVertices},
+		{"collinear", []Vector2{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}, {X: 3, Y: 3}}, ErrPolygonDegenerate},
+		{"bow tie", []Vector2{{X: 0, Y: 0}, {X: 2, Y: 2}, {X: 2, Y: 0}, {X: 0, Y: 2}}, ErrPolygonSelfIntersecting},
+		{"pentagram", pentagram, ErrPolygonSelfIntersecting},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Triangulate(tt.polygon)
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestIsConvexPolygon(t *testing.T) {
+	assert.True(t, IsConvexPolygon([]Vector2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}, {X: 0, Y: 1}}))
+	assert.False(t, IsConvexPolygon([]Vector2{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 1, Y: 0.5}, {X: 2, Y: 2}, {X: 0, Y: 2}}))
+}
+
+func TestIsSelfIntersecting(t *testing.T) {
+	assert.False(t, IsSelfIntersecting([]Vector2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}, {X: 0, Y: 1}}))
+	assert.True(t, IsSelfIntersecting([]Vector2{{X: 0, Y: 0}, {X: 2, Y: 2}, {X: 2, Y: 0}, {X: 0, Y: 2}}))
+	assert.True(t, IsSelfIntersecting(pentagram))
+}
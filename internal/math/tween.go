@@ -0,0 +1,56 @@
+package math
+
+// Tween interpolates a value from start to end over duration seconds using an easing curve
+type Tween struct {
+	start    float64
+	end      float64
+	duration float64
+	elapsed  float64
+	ease     EaseFunc
+}
+
+// NewTween creates a new tween; a nil ease defaults to EaseLinear
+func NewTween(start, end, duration float64, ease EaseFunc) *Tween {
+	if ease == nil {
+		ease = EaseLinear
+	}
+	return &Tween{
+		start:    start,
+		end:      end,
+		duration: duration,
+		ease:     ease,
+	}
+}
+
+// Update advances the tween by deltaTime seconds and returns the current value
+// Elapsed time is clamped at duration, so the value never overshoots end
+func (tw *Tween) Update(deltaTime float64) float64 {
+	tw.elapsed += deltaTime
+	if tw.elapsed > tw.duration {
+		tw.elapsed = tw.duration
+	}
+	if tw.elapsed < 0 {
+		tw.elapsed = 0
+	}
+	return tw.Value()
+}
+
+// Value returns the current value without advancing the tween
+func (tw *Tween) Value() float64 {
+	if tw.IsDone() {
+		return tw.end
+	}
+	progress := tw.ease(tw.elapsed / tw.duration)
+	return tw.start + (tw.end-tw.start)*progress
+}
+
+// IsDone reports whether the tween has reached its duration
+// A zero or negative duration completes immediately
+func (tw *Tween) IsDone() bool {
+	return tw.elapsed >= tw.duration
+}
+
+// Reset rewinds the tween to its start
+func (tw *Tween) Reset() {
+	tw.elapsed = 0
+}
@@ -0,0 +1,58 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTween_LinearSteps(t *testing.T) {
+	tween := NewTween(10, 20, 1.0, EaseLinear)
+	
+	assert.InDelta(t, 12.5, tween.Update(0.25), Epsilon)
+	assert.InDelta(t, 15.0, tween.Update(0.25), Epsilon)
+	assert.False(t, tween.IsDone())
+}
+
+func TestTween_ReachesExactlyEnd(t *testing.T) {
+	// Arrange
+	tween := NewTween(0, 100, 1.0, EaseInOutCubic)
+	
+	// Act: 0.3秒刻みで1.2秒進める（最後の1回で時間を超える）
+	var value float64
+	for i := 0; i < 4; i++ {
+		value = tween.Update(0.3)
+	}
+	
+	// Assert
+	assert.Equal(t, 100.0, value)
+	assert.True(t, tween.IsDone())
+	assert.Equal(t, 100.0, tween.Update(5.0), "完了後も終点を超えない")
+}
+
+func TestTween_NeverOvershootsWithBounce(t *testing.T) {
+	tween := NewTween(0, 1, 2.0, EaseOutBounce)
+	
+	for i := 0; i < 30; i++ {
+		value := tween.Update(0.1)
+		assert.LessOrEqual(t, value, 1.0+Epsilon)
+	}
+	assert.Equal(t, 1.0, tween.Value())
+}
+
+func TestTween_Reset(t *testing.T) {
+	tween := NewTween(5, 15, 1.0, nil)
+	tween.Update(2.0)
+	
+	tween.Reset()
+	
+	assert.False(t, tween.IsDone())
+	assert.Equal(t, 5.0, tween.Value())
+}
+
+func TestTween_ZeroDuration(t *testing.T) {
+	tween := NewTween(0, 7, 0, EaseLinear)
+	
+	assert.True(t, tween.IsDone())
+	assert.Equal(t, 7.0, tween.Update(0))
+}
@@ -44,6 +44,12 @@ func (v Vector2) Dot(other Vector2) float64 {
 	return v.X*other.X + v.Y*other.Y
 }
 
+// Cross returns the 2D cross product (the Z component of the 3D cross product)
+// Positive when other is counter-clockwise from v, negative when clockwise, zero when parallel
+func (v Vector2) Cross(other Vector2) float64 {
+	return v.X*other.Y - v.Y*other.X
+}
+
 // Length calculates the length of the vector
 func (v Vector2) Length() float64 {
 	return math.Sqrt(v.X*v.X + v.Y*v.Y)
@@ -63,11 +69,84 @@ func (v Vector2) Normalize() Vector2 {
 	return Vector2{X: v.X / length, Y: v.Y / length}
 }
 
+// Angle returns the angle of the vector in radians, measured from the positive X axis (atan2(Y, X))
+func (v Vector2) Angle() float64 {
+	return math.Atan2(v.Y, v.X)
+}
+
+// Rotate returns the vector rotated counter-clockwise by angleRadians around the origin
+func (v Vector2) Rotate(angleRadians float64) Vector2 {
+	sin, cos := math.Sincos(angleRadians)
+	return Vector2{
+		X: v.X*cos - v.Y*sin,
+		Y: v.X*sin + v.Y*cos,
+	}
+}
+
+// RotateAround returns the point rotated counter-clockwise by angleRadians around pivot
+func (v Vector2) RotateAround(pivot Vector2, angleRadians float64) Vector2 {
+	return v.Sub(pivot).Rotate(angleRadians).Add(pivot)
+}
+
+// Reflect returns the vector reflected off a surface with the given normal (v - 2*(v·n)*n)
+// normal MUST be unit length; a non-normalized normal scales the reflected component incorrectly.
+// Use ReflectUnnormalized when the normal's length is not guaranteed.
+func (v Vector2) Reflect(normal Vector2) Vector2 {
+	return v.Sub(normal.Scale(2 * v.Dot(normal)))
+}
+
+// ReflectUnnormalized is like Reflect but normalizes the normal first
+// A zero-length normal returns the vector unchanged
+func (v Vector2) ReflectUnnormalized(normal Vector2) Vector2 {
+	return v.Reflect(normal.Normalize())
+}
+
+// AngleBetween returns the signed angle in radians from v to other, in [-π, π]
+// Positive when other is counter-clockwise from v; zero if either vector has zero length
+func (v Vector2) AngleBetween(other Vector2) float64 {
+	return math.Atan2(v.Cross(other), v.Dot(other))
+}
+
+// SnapToGrid rounds each component to the nearest multiple of cellSize
+// A zero or negative cellSize returns the vector unchanged
+func (v Vector2) SnapToGrid(cellSize float64) Vector2 {
+	if cellSize <= 0 {
+		return v
+	}
+	return Vector2{
+		X: math.Round(v.X/cellSize) * cellSize,
+		Y: math.Round(v.Y/cellSize) * cellSize,
+	}
+}
+
+// SnapToGridFloor rounds each component down to the multiple of cellSize at or below it
+// (the origin of the containing cell). A zero or negative cellSize returns the vector unchanged
+func (v Vector2) SnapToGridFloor(cellSize float64) Vector2 {
+	if cellSize <= 0 {
+		return v
+	}
+	return Vector2{
+		X: math.Floor(v.X/cellSize) * cellSize,
+		Y: math.Floor(v.Y/cellSize) * cellSize,
+	}
+}
+
 // Distance calculates the distance between two vectors
 func (v Vector2) Distance(other Vector2) float64 {
 	return v.Sub(other).Length()
 }
 
+// Lerp linearly interpolates between v and other by t (0 = v, 1 = other)
+// t is not clamped, so values outside [0, 1] extrapolate along the line
+func (v Vector2) Lerp(other Vector2, t float64) Vector2 {
+	return v.Add(other.Sub(v).Scale(t))
+}
+
+// LerpClamped is like Lerp but clamps t into [0, 1]
+func (v Vector2) LerpClamped(other Vector2, t float64) Vector2 {
+	return v.Lerp(other, Clamp01(t))
+}
+
 // ToVector3 converts Vector2 to Vector3 with Z=1 (for homogeneous coordinates)
 func (v Vector2) ToVector3() Vector3 {
 	return Vector3{X: v.X, Y: v.Y, Z: 1.0}
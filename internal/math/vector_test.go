@@ -1,6 +1,7 @@
 package math
 
 import (
+	stdmath "math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -89,6 +90,122 @@ func TestVector2_Normalize_ZeroVector(t *testing.T) {
 	assert.Equal(t, expected, result)
 }
 
+func TestVector2_Angle(t *testing.T) {
+	assert.InDelta(t, 0.0, Vector2{X: 1, Y: 0}.Angle(), Epsilon)
+	assert.InDelta(t, HalfPi, Vector2{X: 0, Y: 3}.Angle(), Epsilon)
+	assert.InDelta(t, HalfPi*2, Vector2{X: -1, Y: 0}.Angle(), Epsilon)
+}
+
+func TestVector2_Cross(t *testing.T) {
+	x := Vector2{X: 1, Y: 0}
+	
+	assert.InDelta(t, 1.0, x.Cross(Vector2{X: 0, Y: 1}), Epsilon, "perpendicular counter-clockwise")
+	assert.InDelta(t, -1.0, x.Cross(Vector2{X: 0, Y: -1}), Epsilon, "perpendicular clockwise")
+	assert.InDelta(t, 0.0, x.Cross(Vector2{X: 5, Y: 0}), Epsilon, "parallel")
+	assert.InDelta(t, 0.0, x.Cross(Vector2{X: -2, Y: 0}), Epsilon, "anti-parallel")
+	assert.InDelta(t, -7.0, Vector2{X: 2, Y: 3}.Cross(Vector2{X: 4, Y: 2.5}), Epsilon)
+}
+
+func TestVector2_AngleBetween(t *testing.T) {
+	x := Vector2{X: 1, Y: 0}
+	
+	assert.InDelta(t, HalfPi, x.AngleBetween(Vector2{X: 0, Y: 2}), Epsilon, "perpendicular counter-clockwise")
+	assert.InDelta(t, -HalfPi, x.AngleBetween(Vector2{X: 0, Y: -2}), Epsilon, "perpendicular clockwise")
+	assert.InDelta(t, 0.0, x.AngleBetween(Vector2{X: 3, Y: 0}), Epsilon, "parallel")
+	assert.InDelta(t, stdmath.Pi, stdmath.Abs(x.AngleBetween(Vector2{X: -1, Y: 0})), Epsilon, "anti-parallel")
+	
+	// 350° to 10° wraps to +20° rather than -340°
+	from := Vector2{X: stdmath.Cos(DegreesToRad(350)), Y: stdmath.Sin(DegreesToRad(350))}
+	to := Vector2{X: stdmath.Cos(DegreesToRad(10)), Y: stdmath.Sin(DegreesToRad(10))}
+	assert.InDelta(t, DegreesToRad(20), from.AngleBetween(to), Epsilon)
+}
+
+func TestVector2_Rotate(t *testing.T) {
+	result := Vector2{X: 1, Y: 0}.Rotate(HalfPi)
+	
+	assert.InDelta(t, 0.0, result.X, Epsilon)
+	assert.InDelta(t, 1.0, result.Y, Epsilon)
+	
+	full := Vector2{X: 3, Y: -4}.Rotate(TwoPi)
+	assert.InDelta(t, 3.0, full.X, Epsilon)
+	assert.InDelta(t, -4.0, full.Y, Epsilon)
+}
+
+func TestVector2_Rotate_PreservesLength(t *testing.T) {
+	v := Vector2{X: 3, Y: 4}
+	
+	assert.InDelta(t, v.Length(), v.Rotate(1.234).Length(), Epsilon)
+}
+
+func TestVector2_RotateAround(t *testing.T) {
+	pivot := Vector2{X: 5, Y: 5}
+	
+	result := Vector2{X: 6, Y: 5}.RotateAround(pivot, HalfPi)
+	assert.InDelta(t, 5.0, result.X, Epsilon)
+	assert.InDelta(t, 6.0, result.Y, Epsilon)
+	
+	self := pivot.RotateAround(pivot, 1.0)
+	assert.InDelta(t, pivot.X, self.X, Epsilon)
+	assert.InDelta(t, pivot.Y, self.Y, Epsilon)
+}
+
+func TestVector2_Reflect(t *testing.T) {
+	result := Vector2{X: 1, Y: -1}.Reflect(Vector2{X: 0, Y: 1})
+	
+	assert.InDelta(t, 1.0, result.X, Epsilon)
+	assert.InDelta(t, 1.0, result.Y, Epsilon)
+}
+
+func TestVector2_Reflect_OffOwnNegativeNormalInverts(t *testing.T) {
+	v := Vector2{X: 3, Y: 4}
+	normal := v.Normalize().Scale(-1)
+	
+	result := v.Reflect(normal)
+	
+	assert.InDelta(t, -3.0, result.X, Epsilon)
+	assert.InDelta(t, -4.0, result.Y, Epsilon)
+}
+
+func TestVector2_ReflectUnnormalized(t *testing.T) {
+	result := Vector2{X: 1, Y: -1}.ReflectUnnormalized(Vector2{X: 0, Y: 10})
+	assert.InDelta(t, 1.0, result.X, Epsilon)
+	assert.InDelta(t, 1.0, result.Y, Epsilon)
+	
+	unchanged := Vector2{X: 2, Y: 5}.ReflectUnnormalized(Vector2{})
+	assert.Equal(t, Vector2{X: 2, Y: 5}, unchanged)
+}
+
+func TestVector2_SnapToGrid(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Vector2
+		expected Vector2
+	}{
+		{"positive", Vector2{X: 37, Y: 12}, Vector2{X: 32, Y: 16}},
+		{"negative", Vector2{X: -37, Y: -12}, Vector2{X: -32, Y: -16}},
+		{"on grid", Vector2{X: 48, Y: -16}, Vector2{X: 48, Y: -16}},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.input.SnapToGrid(16))
+		})
+	}
+}
+
+func TestVector2_SnapToGridFloor(t *testing.T) {
+	assert.Equal(t, Vector2{X: 32, Y: 0}, Vector2{X: 47.9, Y: 15}.SnapToGridFloor(16))
+	assert.Equal(t, Vector2{X: -48, Y: -16}, Vector2{X: -37, Y: -0.5}.SnapToGridFloor(16))
+}
+
+func TestVector2_SnapToGrid_InvalidCellSize(t *testing.T) {
+	v := Vector2{X: 3.7, Y: -1.2}
+	
+	assert.Equal(t, v, v.SnapToGrid(0))
+	assert.Equal(t, v, v.SnapToGrid(-8))
+	assert.Equal(t, v, v.SnapToGridFloor(0))
+}
+
 func TestVector2_Distance(t *testing.T) {
 	v1 := Vector2{X: 1, Y: 1}
 	v2 := Vector2{X: 4, Y: 5}
@@ -99,6 +216,54 @@ func TestVector2_Distance(t *testing.T) {
 	assert.Equal(t, expected, result)
 }
 
+func TestVector2_Lerp(t *testing.T) {
+	a := Vector2{X: 0, Y: 10}
+	b := Vector2{X: 10, Y: -10}
+	tests := []struct {
+		name     string
+		t        float64
+		expected Vector2
+	}{
+		{"start", 0, Vector2{X: 0, Y: 10}},
+		{"midpoint", 0.5, Vector2{X: 5, Y: 0}},
+		{"end", 1, Vector2{X: 10, Y: -10}},
+		{"extrapolate before", -0.5, Vector2{X: -5, Y: 20}},
+		{"extrapolate after", 1.5, Vector2{X: 15, Y: -20}},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := a.Lerp(b, tt.t)
+			assert.InDelta(t, tt.expected.X, result.X, 1e-9)
+			assert.InDelta(t, tt.expected.Y, result.Y, 1e-9)
+		})
+	}
+}
+
+func TestVector2_LerpClamped(t *testing.T) {
+	a := Vector2{X: 0, Y: 10}
+	b := Vector2{X: 10, Y: -10}
+	tests := []struct {
+		name     string
+		t        float64
+		expected Vector2
+	}{
+		{"start", 0, Vector2{X: 0, Y: 10}},
+		{"midpoint", 0.5, Vector2{X: 5, Y: 0}},
+		{"end", 1, Vector2{X: 10, Y: -10}},
+		{"clamped below", -0.5, Vector2{X: 0, Y: 10}},
+		{"clamped above", 1.5, Vector2{X: 10, Y: -10}},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := a.LerpClamped(b, tt.t)
+			assert.InDelta(t, tt.expected.X, result.X, 1e-9)
+			assert.InDelta(t, tt.expected.Y, result.Y, 1e-9)
+		})
+	}
+}
+
 func TestVector2_ToVector3(t *testing.T) {
 	v2 := Vector2{X: 3, Y: 4}
 	
@@ -0,0 +1,72 @@
+package physics
+
+import (
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+)
+
+// Body は物理演算で移動する質点
+// Static な物体は力や重力の影響を受けず、位置が固定される
+type Body struct {
+	Position mathlib.Vector2
+	Velocity mathlib.Vector2
+	Mass     float64
+	Static   bool
+
+	force mathlib.Vector2 // 現在のステップで蓄積された力
+}
+
+// NewBody は指定位置・質量の動的な物体を作成する
+func NewBody(position mathlib.Vector2, mass float64) *Body {
+	return &Body{
+		Position: position,
+		Mass:     mass,
+	}
+}
+
+// NewStaticBody は指定位置に固定された物体を作成する
+func NewStaticBody(position mathlib.Vector2) *Body {
+	return &Body{
+		Position: position,
+		Static:   true,
+	}
+}
+
+// ApplyForce は現在のステップで作用する力を加える
+func (b *Body) ApplyForce(force mathlib.Vector2) {
+	if b.Static {
+		return
+	}
+	b.force = b.force.Add(force)
+}
+
+// Force は現在のステップで蓄積された力を取得する
+func (b *Body) Force() mathlib.Vector2 {
+	return b.force
+}
+
+// InverseMass は質量の逆数を返す（静的な物体・質量0以下の物体は0）
+func (b *Body) InverseMass() float64 {
+	if b.Static || b.Mass <= 0 {
+		return 0
+	}
+	return 1.0 / b.Mass
+}
+
+// integrate は蓄積された力と重力で速度・位置を更新する（半陰的オイラー法）
+func (b *Body) integrate(gravity mathlib.Vector2, dt float64) {
+	defer b.clearForce()
+
+	inverseMass := b.InverseMass()
+	if inverseMass == 0 {
+		return
+	}
+
+	acceleration := b.force.Scale(inverseMass).Add(gravity)
+	b.Velocity = b.Velocity.Add(acceleration.Scale(dt))
+	b.Position = b.Position.Add(b.Velocity.Scale(dt))
+}
+
+// clearForce は蓄積された力をリセットする
+func (b *Body) clearForce() {
+	b.force = mathlib.Vector2{}
+}
@@ -0,0 +1,54 @@
+package physics
+
+import (
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+)
+
+// SpringConstraint は2つの物体をばねでつなぐ拘束
+// フックの法則に従い、自然長からの伸びに比例した力と相対速度に比例した減衰力を加える
+type SpringConstraint struct {
+	BodyA      *Body
+	BodyB      *Body
+	RestLength float64 // 自然長
+	Stiffness  float64 // ばね定数
+	Damping    float64 // 減衰係数
+}
+
+// NewSpringConstraint は新しいSpringConstraintを作成する
+func NewSpringConstraint(a, b *Body, restLength, stiffness, damping float64) *SpringConstraint {
+	return &SpringConstraint{
+		BodyA:      a,
+		BodyB:      b,
+		RestLength: restLength,
+		Stiffness:  stiffness,
+		Damping:    damping,
+	}
+}
+
+// Length は2つの物体間の現在の距離を返す
+func (s *SpringConstraint) Length() float64 {
+	return s.BodyA.Position.Distance(s.BodyB.Position)
+}
+
+// Force はBodyAに作用するばねの力を計算する（BodyBには逆向きの力が作用する）
+// 伸びている場合はBodyBの方向へ引き、縮んでいる場合は押し返す
+// 2つの物体が同じ位置にある場合は方向が定まらないため0を返す
+func (s *SpringConstraint) Force() mathlib.Vector2 {
+	delta := s.BodyB.Position.Sub(s.BodyA.Position)
+	distance := delta.Length()
+	if mathlib.IsZero(distance) {
+		return mathlib.Vector2{}
+	}
+
+	direction := delta.Scale(1.0 / distance)
+	relativeSpeed := s.BodyB.Velocity.Sub(s.BodyA.Velocity).Dot(direction)
+	magnitude := s.Stiffness*(distance-s.RestLength) + s.Damping*relativeSpeed
+	return direction.Scale(magnitude)
+}
+
+// Apply はばねの力を両方の物体に加える
+func (s *SpringConstraint) Apply(dt float64) {
+	force := s.Force()
+	s.BodyA.ApplyForce(force)
+	s.BodyB.ApplyForce(force.Scale(-1))
+}
@@ -0,0 +1,86 @@
+package physics
+
+import (
+	"testing"
+
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpringConstraint_ForceMagnitude(t *testing.T) {
+	// Arrange: 自然長2のばねを5まで伸ばす
+	a := NewBody(mathlib.Vector2{X: 0, Y: 0}, 1)
+	b := NewBody(mathlib.Vector2{X: 3, Y: 4}, 1)
+	spring := NewSpringConstraint(a, b, 2, 10, 0)
+
+	// Act
+	force := spring.Force()
+
+	// Assert: |F| = stiffness * (dist - restLength) = 10 * 3
+	assert.InDelta(t, 30.0, force.Length(), 1e-9)
+	assert.InDelta(t, 18.0, force.X, 1e-9, "BodyBの方向へ引く")
+	assert.InDelta(t, 24.0, force.Y, 1e-9)
+}
+
+func TestSpringConstraint_CompressedPushesApart(t *testing.T) {
+	a := NewBody(mathlib.Vector2{X: 0, Y: 0}, 1)
+	b := NewBody(mathlib.Vector2{X: 1, Y: 0}, 1)
+	spring := NewSpringConstraint(a, b, 3, 5, 0)
+
+	spring.Apply(1.0 / 60.0)
+
+	assert.InDelta(t, -10.0, a.Force().X, 1e-9)
+	assert.InDelta(t, 10.0, b.Force().X, 1e-9)
+}
+
+func TestSpringConstraint_DampingOpposesRelativeVelocity(t *testing.T) {
+	a := NewStaticBody(mathlib.Vector2{X: 0, Y: 0})
+	b := NewBody(mathlib.Vector2{X: 2, Y: 0}, 1)
+	b.Velocity = mathlib.Vector2{X: 4, Y: 0}
+	spring := NewSpringConstraint(a, b, 2, 10, 0.5)
+
+	spring.Apply(1.0 / 60.0)
+
+	// 自然長なのでばね力は0、離れる速度に対して引き戻す減衰力のみ
+	assert.InDelta(t, -2.0, b.Force().X, 1e-9)
+}
+
+func TestSpringConstraint_HangingBodyConvergesToRestLength(t *testing.T) {
+	// Arrange
+	world := NewWorld()
+	anchor := NewStaticBody(mathlib.Vector2{X: 0, Y: 0})
+	weight := NewBody(mathlib.Vector2{X: 0, Y: 5}, 1)
+	spring := NewSpringConstraint(anchor, weight, 3, 50, 5)
+	world.AddBody(anchor)
+	world.AddBody(weight)
+	world.AddConstraint(spring)
+
+	// Act: 60Hzで10秒
+	for i := 0; i < 600; i++ {
+		world.Step(1.0 / 60.0)
+	}
+
+	// Assert
+	assert.InDelta(t, 3.0, spring.Length(), 1e-3)
+	assert.InDelta(t, 0.0, weight.Velocity.Length(), 1e-3)
+	assert.Equal(t, mathlib.Vector2{X: 0, Y: 0}, anchor.Position, "静的な物体は動かない")
+}
+
+func TestSpringConstraint_HangingUnderGravity(t *testing.T) {
+	// 重力下では自然長 + mg/k の位置で釣り合う
+	world := NewWorld()
+	world.SetGravity(mathlib.Vector2{X: 0, Y: 10})
+	anchor := NewStaticBody(mathlib.Vector2{X: 0, Y: 0})
+	weight := NewBody(mathlib.Vector2{X: 0, Y: 3}, 2)
+	spring := NewSpringConstraint(anchor, weight, 3, 40, 8)
+	world.AddBody(anchor)
+	world.AddBody(weight)
+	world.AddConstraint(spring)
+
+	for i := 0; i < 600; i++ {
+		world.Step(1.0 / 60.0)
+	}
+
+	assert.InDelta(t, 3.0+2.0*10.0/40.0, spring.Length(), 1e-3)
+	assert.Equal(t, mathlib.Vector2{X: 0, Y: 0}, anchor.Position)
+}
@@ -0,0 +1,83 @@
+package physics
+
+import (
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+)
+
+// Constraint は物理ステップごとに物体へ力を加える拘束
+type Constraint interface {
+	// Apply は現在の状態から力を計算して物体に加える
+	Apply(dt float64)
+}
+
+// World は物体と拘束を保持し、物理ステップを進める
+type World struct {
+	bodies      []*Body
+	constraints []Constraint
+	gravity     mathlib.Vector2
+}
+
+// NewWorld は重力なしの新しいWorldを作成する
+func NewWorld() *World {
+	return &World{}
+}
+
+// SetGravity は全ての動的な物体に作用する重力加速度を設定する
+func (w *World) SetGravity(gravity mathlib.Vector2) {
+	w.gravity = gravity
+}
+
+// GetGravity は重力加速度を取得する
+func (w *World) GetGravity() mathlib.Vector2 {
+	return w.gravity
+}
+
+// AddBody は物体を追加する
+func (w *World) AddBody(body *Body) {
+	w.bodies = append(w.bodies, body)
+}
+
+// RemoveBody は物体を削除する
+func (w *World) RemoveBody(body *Body) {
+	for i, b := range w.bodies {
+		if b == body {
+			w.bodies = append(w.bodies[:i], w.bodies[i+1:]...)
+			return
+		}
+	}
+}
+
+// Bodies は登録されている物体を取得する
+func (w *World) Bodies() []*Body {
+	return append([]*Body(nil), w.bodies...)
+}
+
+// AddConstraint は拘束を追加する
+func (w *World) AddConstraint(constraint Constraint) {
+	w.constraints = append(w.constraints, constraint)
+}
+
+// RemoveConstraint は拘束を削除する
+func (w *World) RemoveConstraint(constraint Constraint) {
+	for i, c := range w.constraints {
+		if c == constraint {
+			w.constraints = append(w.constraints[:i], w.constraints[i+1:]...)
+			return
+		}
+	}
+}
+
+// Step は物理演算をdt秒進める
+// 拘束による力を加えた後、全ての物体を積分する
+func (w *World) Step(dt float64) {
+	if dt <= 0 {
+		return
+	}
+
+	for _, constraint := range w.constraints {
+		constraint.Apply(dt)
+	}
+	for _, body := range w.bodies {
+		body.integrate(w.gravity, dt)
+	}
+}
@@ -0,0 +1,57 @@
+package physics
+
+import (
+	"testing"
+
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorld_StepIntegratesForceAndGravity(t *testing.T) {
+	// Arrange
+	world := NewWorld()
+	world.SetGravity(mathlib.Vector2{X: 0, Y: 10})
+	body := NewBody(mathlib.Vector2{X: 0, Y: 0}, 2)
+	world.AddBody(body)
+
+	// Act
+	body.ApplyForce(mathlib.Vector2{X: 4, Y: 0})
+	world.Step(0.5)
+
+	// Assert: a = F/m + g = (2, 10)
+	assert.InDelta(t, 1.0, body.Velocity.X, 1e-9)
+	assert.InDelta(t, 5.0, body.Velocity.Y, 1e-9)
+	assert.InDelta(t, 0.5, body.Position.X, 1e-9)
+	assert.InDelta(t, 2.5, body.Position.Y, 1e-9)
+	assert.Equal(t, mathlib.Vector2{}, body.Force(), "力はステップごとにリセットされる")
+}
+
+func TestWorld_StaticBodyIgnoresForces(t *testing.T) {
+	world := NewWorld()
+	world.SetGravity(mathlib.Vector2{X: 0, Y: 10})
+	body := NewStaticBody(mathlib.Vector2{X: 1, Y: 1})
+	world.AddBody(body)
+
+	body.ApplyForce(mathlib.Vector2{X: 100, Y: 0})
+	world.Step(1.0)
+
+	assert.Equal(t, mathlib.Vector2{X: 1, Y: 1}, body.Position)
+	assert.Equal(t, 0.0, body.InverseMass())
+}
+
+func TestWorld_RemoveBodyAndConstraint(t *testing.T) {
+	world := NewWorld()
+	a := NewBody(mathlib.Vector2{}, 1)
+	b := NewBody(mathlib.Vector2{X: 5}, 1)
+	spring := NewSpringConstraint(a, b, 1, 10, 0)
+	world.AddBody(a)
+	world.AddBody(b)
+	world.AddConstraint(spring)
+
+	world.RemoveConstraint(spring)
+	world.RemoveBody(b)
+	world.Step(1.0)
+
+	assert.Len(t, world.Bodies(), 1)
+	assert.Equal(t, mathlib.Vector2{}, a.Position, "拘束を外したので動かない")
+}
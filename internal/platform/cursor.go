@@ -0,0 +1,61 @@
+package platform
+
+import (
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// CursorMode はマウスカーソルの表示・移動の扱いを表す
+type CursorMode int
+
+const (
+	CursorNormal   CursorMode = iota // 通常どおり表示する（メニュー向け）
+	CursorHidden                     // ウィンドウ上では非表示にする
+	CursorDisabled                   // 非表示にしてウィンドウ内に固定する（一人称視点のマウスルック向け）
+)
+
+// String はカーソルモードの名前を返す
+func (m CursorMode) String() string {
+	switch m {
+	case CursorNormal:
+		return "Normal"
+	case CursorHidden:
+		return "Hidden"
+	case CursorDisabled:
+		return "Disabled"
+	default:
+		return "Unknown"
+	}
+}
+
+// glfwCursorMode はカーソルモードに対応する glfw.CursorMode の値を返す
+// 未知のモードは通常表示として扱う
+func glfwCursorMode(mode CursorMode) int {
+	switch mode {
+	case CursorHidden:
+		return glfw.CursorHidden
+	case CursorDisabled:
+		return glfw.CursorDisabled
+	default:
+		return glfw.CursorNormal
+	}
+}
+
+// SetCursorMode はカーソルモードを設定する
+// 初期化前に設定した場合はウィンドウ作成時に反映する
+func (w *Window) SetCursorMode(mode CursorMode) {
+	w.cursorMode = mode
+	w.applyCursorMode()
+}
+
+// GetCursorMode は現在のカーソルモードを返す
+func (w *Window) GetCursorMode() CursorMode {
+	return w.cursorMode
+}
+
+// applyCursorMode は保持しているカーソルモードをGLFWに反映する
+func (w *Window) applyCursorMode() {
+	if w.window == nil {
+		return
+	}
+	w.window.SetInputMode(glfw.CursorMode, glfwCursorMode(w.cursorMode))
+}
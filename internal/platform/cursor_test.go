@@ -0,0 +1,46 @@
+package platform
+
+import (
+	"testing"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursorMode_String(t *testing.T) {
+	assert.Equal(t, "Normal", CursorNormal.String())
+	assert.Equal(t, "Hidden", CursorHidden.String())
+	assert.Equal(t, "Disabled", CursorDisabled.String())
+	assert.Equal(t, "Unknown", CursorMode(99).String())
+}
+
+func TestGLFWCursorMode(t *testing.T) {
+	tests := []struct {
+		mode     CursorMode
+		expected int
+	}{
+		{CursorNormal, glfw.CursorNormal},
+		{CursorHidden, glfw.CursorHidden},
+		{CursorDisabled, glfw.CursorDisabled},
+		{CursorMode(99), glfw.CursorNormal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, glfwCursorMode(tt.mode))
+		})
+	}
+}
+
+func TestWindow_CursorMode(t *testing.T) {
+	window := NewWindow(WindowConfig{Title: "テスト", Width: 100, Height: 100})
+
+	// デフォルトは通常表示
+	assert.Equal(t, CursorNormal, window.GetCursorMode())
+
+	// 未初期化のウィンドウではパニックせず設定値だけを保持する
+	assert.NotPanics(t, func() {
+		window.SetCursorMode(CursorDisabled)
+	})
+	assert.Equal(t, CursorDisabled, window.GetCursorMode())
+}
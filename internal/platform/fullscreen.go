@@ -0,0 +1,74 @@
+package platform
+
+import (
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// windowRect はウィンドウモードでの位置とサイズ
+type windowRect struct {
+	X, Y          int
+	Width, Height int
+}
+
+// fullscreenState はフルスクリーン状態と、復帰先のウィンドウ位置・サイズを管理する
+type fullscreenState struct {
+	active bool
+	saved  windowRect
+}
+
+// enter はフルスクリーンに切り替える前のウィンドウ位置・サイズを保存する
+// 既にフルスクリーンの場合は保存済みの値を維持して false を返す
+func (s *fullscreenState) enter(current windowRect) bool {
+	if s.active {
+		return false
+	}
+	s.saved = current
+	s.active = true
+	return true
+}
+
+// exit はフルスクリーンを解除し、復帰先のウィンドウ位置・サイズを返す
+// フルスクリーンでない場合は false を返す
+func (s *fullscreenState) exit() (windowRect, bool) {
+	if !s.active {
+		return windowRect{}, false
+	}
+	s.active = false
+	return s.saved, true
+}
+
+// SetFullscreen はプライマリモニターのフルスクリーンとウィンドウモードを切り替える
+// フルスクリーン解除時は切り替え前のウィンドウ位置・サイズに戻す
+// 未初期化のウィンドウでは何もしない
+func (w *Window) SetFullscreen(enabled bool) {
+	if w.window == nil || enabled == w.fullscreen.active {
+		return
+	}
+
+	if !enabled {
+		rect, _ := w.fullscreen.exit()
+		w.window.SetMonitor(nil, rect.X, rect.Y, rect.Width, rect.Height, glfw.DontCare)
+		return
+	}
+
+	monitor := glfw.GetPrimaryMonitor()
+	if monitor == nil {
+		return
+	}
+	mode := monitor.GetVideoMode()
+
+	x, y := w.window.GetPos()
+	width, height := w.window.GetSize()
+	w.fullscreen.enter(windowRect{X: x, Y: y, Width: width, Height: height})
+	w.window.SetMonitor(monitor, 0, 0, mode.Width, mode.Height, mode.RefreshRate)
+}
+
+// IsFullscreen はフルスクリーンかを返す
+func (w *Window) IsFullscreen() bool {
+	return w.fullscreen.active
+}
+
+// ToggleFullscreen はフルスクリーンとウィンドウモードを入れ替える（F11キーなどに割り当てる）
+func (w *Window) ToggleFullscreen() {
+	w.SetFullscreen(!w.fullscreen.active)
+}
@@ -0,0 +1,57 @@
+package platform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFullscreenState_EnterAndExit(t *testing.T) {
+	// Arrange
+	state := fullscreenState{}
+	windowed := windowRect{X: 100, Y: 50, Width: 800, Height: 600}
+
+	// Act
+	entered := state.enter(windowed)
+	rect, exited := state.exit()
+
+	// Assert
+	assert.True(t, entered)
+	assert.True(t, exited)
+	assert.Equal(t, windowed, rect, "切り替え前の位置とサイズに戻る")
+	assert.False(t, state.active)
+}
+
+func TestFullscreenState_EnterTwiceKeepsFirstRect(t *testing.T) {
+	// Arrange
+	state := fullscreenState{}
+	windowed := windowRect{X: 100, Y: 50, Width: 800, Height: 600}
+	state.enter(windowed)
+
+	// Act
+	// フルスクリーン中のサイズで上書きしない
+	entered := state.enter(windowRect{X: 0, Y: 0, Width: 1920, Height: 1080})
+	rect, _ := state.exit()
+
+	// Assert
+	assert.False(t, entered)
+	assert.Equal(t, windowed, rect)
+}
+
+func TestFullscreenState_ExitWithoutEnter(t *testing.T) {
+	state := fullscreenState{}
+
+	_, exited := state.exit()
+
+	assert.False(t, exited)
+}
+
+func TestWindow_SetFullscreen_WithoutWindow(t *testing.T) {
+	window := NewWindow(WindowConfig{Title: "テスト", Width: 100, Height: 100})
+
+	// 未初期化のウィンドウではパニックせず状態も変わらない
+	assert.NotPanics(t, func() {
+		window.SetFullscreen(true)
+	})
+	assert.False(t, window.IsFullscreen())
+}
@@ -0,0 +1,79 @@
+package platform
+
+import (
+	"sync"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// refCounter は初期化・終了処理を参照カウントで管理する
+// 最初の取得時にのみ初期化し、最後の解放時にのみ終了処理を行う
+type refCounter struct {
+	mu        sync.Mutex
+	count     int
+	init      func() error
+	terminate func()
+}
+
+// newRefCounter は新しいrefCounterを作成する
+func newRefCounter(init func() error, terminate func()) *refCounter {
+	return &refCounter{
+		init:      init,
+		terminate: terminate,
+	}
+}
+
+// Acquire は参照を取得する（初回のみ初期化を行う）
+func (c *refCounter) Acquire() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.count == 0 {
+		if err := c.init(); err != nil {
+			return err
+		}
+	}
+	c.count++
+	return nil
+}
+
+// Release は参照を解放する（最後の参照の場合のみ終了処理を行う）
+func (c *refCounter) Release() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.count == 0 {
+		return
+	}
+	c.count--
+	if c.count == 0 {
+		c.terminate()
+	}
+}
+
+// Count は現在の参照数を返す
+func (c *refCounter) Count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.count
+}
+
+// glfwRefs はプロセス全体で共有されるGLFWの参照カウンタ
+var glfwRefs = newRefCounter(glfw.Init, glfw.Terminate)
+
+// AcquireGLFW はGLFWの参照を取得する
+// 複数のウィンドウが存在する場合でもglfw.Initは一度だけ呼び出される
+func AcquireGLFW() error {
+	return glfwRefs.Acquire()
+}
+
+// ReleaseGLFW はGLFWの参照を解放する
+// 最後の参照が解放された時のみglfw.Terminateが呼び出される
+func ReleaseGLFW() {
+	glfwRefs.Release()
+}
+
+// GLFWRefCount は現在のGLFW参照数を返す
+func GLFWRefCount() int {
+	return glfwRefs.Count()
+}
@@ -0,0 +1,95 @@
+package platform
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefCounter_InitAndTerminateOnce(t *testing.T) {
+	initCount := 0
+	terminateCount := 0
+	counter := newRefCounter(
+		func() error { initCount++; return nil },
+		func() { terminateCount++ },
+	)
+	
+	// 2つのウィンドウが参照を取得
+	assert.NoError(t, counter.Acquire())
+	assert.NoError(t, counter.Acquire())
+	assert.Equal(t, 1, initCount)
+	assert.Equal(t, 2, counter.Count())
+	
+	// 1つ目を解放しても終了処理は行われない
+	counter.Release()
+	assert.Equal(t, 0, terminateCount)
+	assert.Equal(t, 1, counter.Count())
+	
+	// 最後の参照を解放すると終了処理が行われる
+	counter.Release()
+	assert.Equal(t, 1, terminateCount)
+	assert.Equal(t, 0, counter.Count())
+}
+
+func TestRefCounter_ReinitializeAfterTerminate(t *testing.T) {
+	initCount := 0
+	counter := newRefCounter(
+		func() error { initCount++; return nil },
+		func() {},
+	)
+	
+	assert.NoError(t, counter.Acquire())
+	counter.Release()
+	assert.NoError(t, counter.Acquire())
+	
+	assert.Equal(t, 2, initCount)
+}
+
+func TestRefCounter_InitError(t *testing.T) {
+	counter := newRefCounter(
+		func() error { return errors.New("init failed") },
+		func() {},
+	)
+	
+	err := counter.Acquire()
+	
+	assert.Error(t, err)
+	assert.Equal(t, 0, counter.Count())
+}
+
+func TestRefCounter_ReleaseWithoutAcquire(t *testing.T) {
+	terminateCount := 0
+	counter := newRefCounter(
+		func() error { return nil },
+		func() { terminateCount++ },
+	)
+	
+	// 取得していない状態で解放しても終了処理は行われない
+	counter.Release()
+	
+	assert.Equal(t, 0, terminateCount)
+	assert.Equal(t, 0, counter.Count())
+}
+
+func TestWindow_DestroyTwiceReleasesOnce(t *testing.T) {
+	// Arrange
+	terminateCount := 0
+	original := glfwRefs
+	glfwRefs = newRefCounter(func() error { return nil }, func() { terminateCount++ })
+	defer func() { glfwRefs = original }()
+
+	// 2つのウィンドウがGLFWの参照を持っている状態
+	assert.NoError(t, AcquireGLFW())
+	assert.NoError(t, AcquireGLFW())
+	window := &Window{initialized: true}
+
+	// Act
+	window.Destroy()
+	window.Destroy()
+
+	// Assert
+	// 2回目の Destroy で残りのウィンドウの参照を解放しない
+	assert.Equal(t, 1, GLFWRefCount())
+	assert.Equal(t, 0, terminateCount)
+}
@@ -0,0 +1,157 @@
+package platform
+
+import (
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// 入力状態として保持するキー・マウスボタンの範囲
+const (
+	firstKey         = int(glfw.KeySpace)
+	lastKey          = int(glfw.KeyLast)
+	lastMouseButton  = int(glfw.MouseButtonLast)
+	keyStateSize     = lastKey + 1
+	mouseButtonCount = lastMouseButton + 1
+)
+
+// inputSource は入力状態の取得元（*glfw.Window が満たす）
+// テストでは任意の状態を返す偽の実装に差し替える
+type inputSource interface {
+	GetKey(key glfw.Key) glfw.Action
+	GetMouseButton(button glfw.MouseButton) glfw.Action
+	GetCursorPos() (x, y float64)
+}
+
+// inputState は1フレーム分のキー・マウスの状態のスナップショット
+type inputState struct {
+	keys           [keyStateSize]bool
+	mouseButtons   [mouseButtonCount]bool
+	mouseX, mouseY float64
+}
+
+// capture は取得元から現在の入力状態を読み取る
+func (s *inputState) capture(source inputSource) {
+	for key := firstKey; key <= lastKey; key++ {
+		s.keys[key] = source.GetKey(glfw.Key(key)) != glfw.Release
+	}
+	for button := 0; button <= lastMouseButton; button++ {
+		s.mouseButtons[button] = source.GetMouseButton(glfw.MouseButton(button)) != glfw.Release
+	}
+	s.mouseX, s.mouseY = source.GetCursorPos()
+}
+
+// isKeyPressed はスナップショット時点でキーが押されていたかを返す（範囲外のキーは false）
+func (s *inputState) isKeyPressed(key int) bool {
+	if key < 0 || key >= keyStateSize {
+		return false
+	}
+	return s.keys[key]
+}
+
+// isMouseButtonPressed はスナップショット時点でマウスボタンが押されていたかを返す
+func (s *inputState) isMouseButtonPressed(button int) bool {
+	if button < 0 || button >= mouseButtonCount {
+		return false
+	}
+	return s.mouseButtons[button]
+}
+
+// GLFWInput はGLFWウィンドウの入力を扱う InputManager の実装
+// Update() の時点の状態をスナップショットとして保持するため、
+// 同じフレーム内の問い合わせは常に一貫した結果を返す
+type GLFWInput struct {
+	source   inputSource
+	current  inputState
+	previous inputState
+
+	// スクロール量はコールバックでしか取得できないため、Update() までの分を積算する
+	pendingScrollX, pendingScrollY float64
+	scrollX, scrollY               float64
+
+	// 文字入力もコールバックでしか取得できないため、Update() までの分を溜めておく
+	pendingRunes []rune
+	typedRunes   []rune
+}
+
+// NewGLFWInput は指定したGLFWウィンドウの入力を扱う GLFWInput を作成する
+// スクロール量と文字入力を受け取るため、ウィンドウのスクロール・文字コールバックを設定する
+func NewGLFWInput(window *glfw.Window) *GLFWInput {
+	input := newGLFWInput(window)
+	window.SetScrollCallback(func(_ *glfw.Window, xoff, yoff float64) {
+		input.onScroll(xoff, yoff)
+	})
+	window.SetCharCallback(func(_ *glfw.Window, char rune) {
+		input.onChar(char)
+	})
+	return input
+}
+
+// newGLFWInput は指定した取得元から入力を読み取る GLFWInput を作成する
+func newGLFWInput(source inputSource) *GLFWInput {
+	return &GLFWInput{
+		source: source,
+	}
+}
+
+// Update は現在の入力状態を読み取ってスナップショットを更新する
+// フレームの先頭（PollEvents の後）で1回呼び出す
+func (i *GLFWInput) Update() {
+	i.previous = i.current
+	i.current.capture(i.source)
+
+	i.scrollX, i.scrollY = i.pendingScrollX, i.pendingScrollY
+	i.pendingScrollX, i.pendingScrollY = 0, 0
+
+	i.typedRunes = append(i.typedRunes[:0], i.pendingRunes...)
+	i.pendingRunes = i.pendingRunes[:0]
+}
+
+// onScroll はスクロールコールバックから呼び出され、次の Update() までスクロール量を積算する
+func (i *GLFWInput) onScroll(xoff, yoff float64) {
+	i.pendingScrollX += xoff
+	i.pendingScrollY += yoff
+}
+
+// GetScrollDelta は前回から直近の Update() までに積算したスクロール量を取得する
+// スクロールしていないフレームでは (0, 0) を返す
+func (i *GLFWInput) GetScrollDelta() (float64, float64) {
+	return i.scrollX, i.scrollY
+}
+
+// IsKeyPressed は直近の Update() の時点でキーが押されていたかを確認する
+func (i *GLFWInput) IsKeyPressed(key int) bool {
+	return i.current.isKeyPressed(key)
+}
+
+// IsKeyJustPressed は直近の Update() でキーが押された瞬間かを確認する
+// 前フレームで離されていて今フレームで押されている場合のみ true になる
+func (i *GLFWInput) IsKeyJustPressed(key int) bool {
+	return i.current.isKeyPressed(key) && !i.previous.isKeyPressed(key)
+}
+
+// IsKeyJustReleased は直近の Update() でキーが離された瞬間かを確認する
+// 前フレームで押されていて今フレームで離されている場合のみ true になる
+func (i *GLFWInput) IsKeyJustReleased(key int) bool {
+	return !i.current.isKeyPressed(key) && i.previous.isKeyPressed(key)
+}
+
+// GetMousePosition は直近の Update() の時点のマウス座標を取得する
+func (i *GLFWInput) GetMousePosition() (float64, float64) {
+	return i.current.mouseX, i.current.mouseY
+}
+
+// IsMouseButtonPressed は直近の Update() の時点でマウスボタンが押されていたかを確認する
+func (i *GLFWInput) IsMouseButtonPressed(button int) bool {
+	return i.current.isMouseButtonPressed(button)
+}
+
+// onChar は文字コールバックから呼び出され、次の Update() まで入力された文字を溜める
+func (i *GLFWInput) onChar(char rune) {
+	i.pendingRunes = append(i.pendingRunes, char)
+}
+
+// GetTypedRunes は前回から直近の Update() までに入力された文字を入力順に取得する
+// キーコードとは別に扱うため、Shift やキーボードレイアウトによる変換は OS が解決済み
+// 返すスライスは次の Update() まで有効
+func (i *GLFWInput) GetTypedRunes() []rune {
+	return i.typedRunes
+}
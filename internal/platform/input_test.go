@@ -0,0 +1,208 @@
+package platform
+
+import (
+	"testing"
+
+	"github.com/ganyariya/tinyengine/pkg/tinyengine"
+	"github.com/go-gl/glfw/v3.3/glfw"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ tinyengine.InputManager = (*GLFWInput)(nil)
+
+// fakeInputSource はテスト用に入力状態を直接指定できる取得元
+type fakeInputSource struct {
+	keys           map[glfw.Key]bool
+	mouseButtons   map[glfw.MouseButton]bool
+	mouseX, mouseY float64
+}
+
+func newFakeInputSource() *fakeInputSource {
+	return &fakeInputSource{
+		keys:         make(map[glfw.Key]bool),
+		mouseButtons: make(map[glfw.MouseButton]bool),
+	}
+}
+
+func (f *fakeInputSource) GetKey(key glfw.Key) glfw.Action {
+	if f.keys[key] {
+		return glfw.Press
+	}
+	return glfw.Release
+}
+
+func (f *fakeInputSource) GetMouseButton(button glfw.MouseButton) glfw.Action {
+	if f.mouseButtons[button] {
+		return glfw.Press
+	}
+	return glfw.Release
+}
+
+func (f *fakeInputSource) GetCursorPos() (float64, float64) {
+	return f.mouseX, f.mouseY
+}
+
+func TestGLFWInput_Update_SnapshotsState(t *testing.T) {
+	// Arrange
+	source := newFakeInputSource()
+	input := newGLFWInput(source)
+	source.keys[glfw.KeySpace] = true
+	source.mouseButtons[glfw.MouseButtonLeft] = true
+	source.mouseX, source.mouseY = 120, 80
+
+	// Act
+	input.Update()
+
+	// Assert
+	assert.True(t, input.IsKeyPressed(int(glfw.KeySpace)))
+	assert.False(t, input.IsKeyPressed(int(glfw.KeyA)))
+	assert.True(t, input.IsMouseButtonPressed(int(glfw.MouseButtonLeft)))
+	assert.False(t, input.IsMouseButtonPressed(int(glfw.MouseButtonRight)))
+	x, y := input.GetMousePosition()
+	assert.Equal(t, 120.0, x)
+	assert.Equal(t, 80.0, y)
+}
+
+func TestGLFWInput_ConsistentWithinFrame(t *testing.T) {
+	// Arrange
+	source := newFakeInputSource()
+	input := newGLFWInput(source)
+	source.keys[glfw.KeyW] = true
+	input.Update()
+
+	// Act
+	// フレームの途中で実際の状態が変わっても次の Update() までは反映されない
+	source.keys[glfw.KeyW] = false
+	source.mouseX = 500
+
+	// Assert
+	assert.True(t, input.IsKeyPressed(int(glfw.KeyW)))
+	x, _ := input.GetMousePosition()
+	assert.Equal(t, 0.0, x)
+
+	input.Update()
+	assert.False(t, input.IsKeyPressed(int(glfw.KeyW)))
+}
+
+func TestGLFWInput_OutOfRange(t *testing.T) {
+	// Arrange
+	input := newGLFWInput(newFakeInputSource())
+	input.Update()
+
+	// Act & Assert
+	assert.False(t, input.IsKeyPressed(int(glfw.KeyUnknown)))
+	assert.False(t, input.IsKeyPressed(int(glfw.KeyLast)+1))
+	assert.False(t, input.IsMouseButtonPressed(-1))
+	assert.False(t, input.IsMouseButtonPressed(int(glfw.MouseButtonLast)+1))
+}
+
+func TestGLFWInput_IsKeyJustPressed_OnlyFirstFrame(t *testing.T) {
+	// Arrange
+	source := newFakeInputSource()
+	input := newGLFWInput(source)
+	input.Update()
+	key := int(glfw.KeySpace)
+
+	// Act & Assert
+	// キーを押したまま数フレーム経過させる
+	source.keys[glfw.KeySpace] = true
+	input.Update()
+	assert.True(t, input.IsKeyJustPressed(key), "押された最初のフレーム")
+	assert.True(t, input.IsKeyPressed(key))
+
+	for frame := 0; frame < 3; frame++ {
+		input.Update()
+		assert.False(t, input.IsKeyJustPressed(key), "押し続けている間は false")
+		assert.True(t, input.IsKeyPressed(key))
+		assert.False(t, input.IsKeyJustReleased(key))
+	}
+}
+
+func TestGLFWInput_IsKeyJustReleased(t *testing.T) {
+	// Arrange
+	source := newFakeInputSource()
+	input := newGLFWInput(source)
+	key := int(glfw.KeyEnter)
+	source.keys[glfw.KeyEnter] = true
+	input.Update()
+	input.Update()
+
+	// Act
+	source.keys[glfw.KeyEnter] = false
+	input.Update()
+
+	// Assert
+	assert.True(t, input.IsKeyJustReleased(key))
+	assert.False(t, input.IsKeyJustPressed(key))
+
+	input.Update()
+	assert.False(t, input.IsKeyJustReleased(key), "離された次のフレームでは false")
+}
+
+func TestGLFWInput_GetScrollDelta_AccumulatesUntilUpdate(t *testing.T) {
+	// Arrange
+	input := newGLFWInput(newFakeInputSource())
+
+	// Act
+	// 1フレームの間に複数回スクロールイベントが届く
+	input.onScroll(0, 1)
+	input.onScroll(0.5, 2)
+	beforeX, beforeY := input.GetScrollDelta()
+	input.Update()
+	x, y := input.GetScrollDelta()
+
+	// Assert
+	assert.Zero(t, beforeX, "Update() までは反映されない")
+	assert.Zero(t, beforeY)
+	assert.Equal(t, 0.5, x)
+	assert.Equal(t, 3.0, y)
+}
+
+func TestGLFWInput_GetScrollDelta_ResetsEachUpdate(t *testing.T) {
+	// Arrange
+	input := newGLFWInput(newFakeInputSource())
+	input.onScroll(0, -1)
+	input.Update()
+
+	// Act
+	// スクロールのないフレーム
+	input.Update()
+	x, y := input.GetScrollDelta()
+
+	// Assert
+	assert.Zero(t, x)
+	assert.Zero(t, y)
+}
+
+func TestGLFWInput_GetTypedRunes_AccumulatesUntilUpdate(t *testing.T) {
+	// Arrange
+	input := newGLFWInput(newFakeInputSource())
+
+	// Act
+	for _, char := range "Hiあ" {
+		input.onChar(char)
+	}
+	before := input.GetTypedRunes()
+	input.Update()
+
+	// Assert
+	assert.Empty(t, before, "Update() までは反映されない")
+	assert.Equal(t, []rune("Hiあ"), input.GetTypedRunes())
+}
+
+func TestGLFWInput_GetTypedRunes_ClearsEachUpdate(t *testing.T) {
+	// Arrange
+	input := newGLFWInput(newFakeInputSource())
+	input.onChar('a')
+	input.Update()
+
+	// Act
+	input.onChar('b')
+	input.Update()
+	second := string(input.GetTypedRunes())
+	input.Update()
+
+	// Assert
+	assert.Equal(t, "b", second, "前のフレームの文字は残らない")
+	assert.Empty(t, input.GetTypedRunes())
+}
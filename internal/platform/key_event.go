@@ -0,0 +1,51 @@
+package platform
+
+import (
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// KeyAction はキーイベントの種類を表す
+type KeyAction int
+
+const (
+	KeyRelease KeyAction = KeyAction(glfw.Release) // キーが離された
+	KeyPress   KeyAction = KeyAction(glfw.Press)   // キーが押された
+	KeyRepeat  KeyAction = KeyAction(glfw.Repeat)  // キーが押し続けられている
+)
+
+// KeyEvent はキー入力イベントを表す
+// Key は物理位置に基づくキーコード、Scancode はプラットフォーム固有のキー識別子
+type KeyEvent struct {
+	Key      int
+	Scancode int
+	Action   KeyAction
+	Mods     int
+}
+
+// KeyCallback はキー入力イベントを受け取るコールバック
+type KeyCallback func(event KeyEvent)
+
+// newKeyEvent はGLFWのキーコールバック引数からKeyEventを作成する
+func newKeyEvent(key glfw.Key, scancode int, action glfw.Action, mods glfw.ModifierKey) KeyEvent {
+	return KeyEvent{
+		Key:      int(key),
+		Scancode: scancode,
+		Action:   KeyAction(action),
+		Mods:     int(mods),
+	}
+}
+
+// SetKeyCallback はキー入力イベントのコールバックを設定する（nilで解除）
+// スキャンコードも通知されるため、レイアウトに依存しないキー名の表示に利用できる
+func (w *Window) SetKeyCallback(callback KeyCallback) {
+	if w.window == nil {
+		return
+	}
+	if callback == nil {
+		w.window.SetKeyCallback(nil)
+		return
+	}
+	w.window.SetKeyCallback(func(_ *glfw.Window, key glfw.Key, scancode int, action glfw.Action, mods glfw.ModifierKey) {
+		callback(newKeyEvent(key, scancode, action, mods))
+	})
+}
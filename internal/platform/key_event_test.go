@@ -0,0 +1,26 @@
+package platform
+
+import (
+	"testing"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewKeyEvent_ExposesScancode(t *testing.T) {
+	event := newKeyEvent(glfw.KeyA, 38, glfw.Press, glfw.ModShift)
+
+	assert.Equal(t, int(glfw.KeyA), event.Key)
+	assert.Equal(t, 38, event.Scancode)
+	assert.Equal(t, KeyPress, event.Action)
+	assert.Equal(t, int(glfw.ModShift), event.Mods)
+}
+
+func TestWindow_SetKeyCallback_WithoutWindow(t *testing.T) {
+	window := NewWindow(WindowConfig{Title: "テスト", Width: 100, Height: 100})
+
+	// 未初期化のウィンドウではパニックせず何もしない
+	assert.NotPanics(t, func() {
+		window.SetKeyCallback(func(event KeyEvent) {})
+	})
+}
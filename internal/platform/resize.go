@@ -0,0 +1,57 @@
+package platform
+
+import (
+	"github.com/go-gl/gl/v4.1-core/gl"
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// ResizeCallback はフレームバッファのサイズ変更を受け取るコールバック
+// width, height はピクセル単位（高DPI環境ではウィンドウサイズと異なる）
+type ResizeCallback func(width, height int)
+
+// SetResizeCallback はフレームバッファのサイズ変更時のコールバックを設定する（nilで解除）
+// 初期化前に設定した場合も、初期化後のサイズ変更で呼び出される
+func (w *Window) SetResizeCallback(callback ResizeCallback) {
+	w.resizeCallback = callback
+}
+
+// GetFramebufferSize はフレームバッファのサイズをピクセルで返す
+// 未初期化の場合は設定のウィンドウサイズを返す
+func (w *Window) GetFramebufferSize() (int, int) {
+	if w.window != nil {
+		return w.window.GetFramebufferSize()
+	}
+	return w.config.Width, w.config.Height
+}
+
+// registerResizeCallback はGLFWのフレームバッファサイズ変更イベントを handleResize に接続する
+func (w *Window) registerResizeCallback() {
+	w.window.SetFramebufferSizeCallback(func(_ *glfw.Window, width, height int) {
+		w.handleResize(width, height)
+	})
+}
+
+// handleResize はフレームバッファのサイズ変更に合わせてビューポートを更新し、コールバックに通知する
+// 最小化などでサイズが0になった場合は何もしない
+func (w *Window) handleResize(width, height int) {
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	w.setViewport(width, height)
+	if w.resizeCallback != nil {
+		w.resizeCallback(width, height)
+	}
+}
+
+// setViewport はビューポートをフレームバッファ全体に設定する
+func (w *Window) setViewport(width, height int) {
+	if w.viewport != nil {
+		w.viewport(0, 0, int32(width), int32(height))
+	}
+}
+
+// glViewport はOpenGLのビューポートを設定する（Window の viewport のデフォルト値）
+func glViewport(x, y, width, height int32) {
+	gl.Viewport(x, y, width, height)
+}
@@ -0,0 +1,77 @@
+package platform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// viewportRecorder は設定されたビューポートを記録する
+type viewportRecorder struct {
+	calls [][4]int32
+}
+
+func (r *viewportRecorder) viewport(x, y, width, height int32) {
+	r.calls = append(r.calls, [4]int32{x, y, width, height})
+}
+
+func TestWindow_HandleResize_DispatchesToCallback(t *testing.T) {
+	// Arrange
+	window := NewWindow(WindowConfig{Title: "テスト", Width: 800, Height: 600})
+	recorder := &viewportRecorder{}
+	window.viewport = recorder.viewport
+
+	var sizes [][2]int
+	window.SetResizeCallback(func(width, height int) {
+		sizes = append(sizes, [2]int{width, height})
+	})
+
+	// Act
+	window.handleResize(1024, 768)
+	window.handleResize(1920, 1080)
+
+	// Assert
+	assert.Equal(t, [][2]int{{1024, 768}, {1920, 1080}}, sizes)
+	assert.Equal(t, [][4]int32{{0, 0, 1024, 768}, {0, 0, 1920, 1080}}, recorder.calls)
+}
+
+func TestWindow_HandleResize_IgnoresZeroSize(t *testing.T) {
+	// Arrange
+	window := NewWindow(WindowConfig{Title: "テスト", Width: 800, Height: 600})
+	recorder := &viewportRecorder{}
+	window.viewport = recorder.viewport
+	called := false
+	window.SetResizeCallback(func(width, height int) { called = true })
+
+	// Act
+	// 最小化時はサイズ0で通知される
+	window.handleResize(0, 0)
+
+	// Assert
+	assert.False(t, called)
+	assert.Empty(t, recorder.calls)
+}
+
+func TestWindow_HandleResize_WithoutCallback(t *testing.T) {
+	// Arrange
+	window := NewWindow(WindowConfig{Title: "テスト", Width: 800, Height: 600})
+	recorder := &viewportRecorder{}
+	window.viewport = recorder.viewport
+
+	// Act
+	window.SetResizeCallback(func(width, height int) {})
+	window.SetResizeCallback(nil)
+	window.handleResize(640, 480)
+
+	// Assert
+	assert.Equal(t, [][4]int32{{0, 0, 640, 480}}, recorder.calls, "コールバックがなくてもビューポートは更新する")
+}
+
+func TestWindow_GetFramebufferSize_WithoutWindow(t *testing.T) {
+	window := NewWindow(WindowConfig{Title: "テスト", Width: 800, Height: 600})
+
+	width, height := window.GetFramebufferSize()
+
+	assert.Equal(t, 800, width)
+	assert.Equal(t, 600, height)
+}
@@ -6,22 +6,46 @@ import (
 
 // Timer は時間管理を行う
 type Timer struct {
+	now       func() time.Time // 現在時刻の取得（テストで差し替える）
 	startTime time.Time
+	lastTime  time.Time // GetDelta を最後に呼び出した時刻
 }
 
 // NewTimer は新しいタイマーインスタンスを作成する
 func NewTimer() *Timer {
+	return newTimerWithClock(time.Now)
+}
+
+// newTimerWithClock は現在時刻の取得関数を指定してタイマーを作成する
+func newTimerWithClock(now func() time.Time) *Timer {
+	start := now()
 	return &Timer{
-		startTime: time.Now(),
+		now:       now,
+		startTime: start,
+		lastTime:  start,
 	}
 }
 
 // GetTime は開始からの経過時間を秒で返す
 func (t *Timer) GetTime() float64 {
-	return time.Since(t.startTime).Seconds()
+	return t.Elapsed().Seconds()
+}
+
+// Elapsed は開始からの経過時間を返す
+func (t *Timer) Elapsed() time.Duration {
+	return t.now().Sub(t.startTime)
+}
+
+// GetDelta は前回の GetDelta 呼び出し（初回は開始時）からの経過時間を秒で返す
+func (t *Timer) GetDelta() float64 {
+	current := t.now()
+	delta := current.Sub(t.lastTime)
+	t.lastTime = current
+	return delta.Seconds()
 }
 
 // Reset はタイマーをリセットする
 func (t *Timer) Reset() {
-	t.startTime = time.Now()
-}
\ No newline at end of file
+	t.startTime = t.now()
+	t.lastTime = t.startTime
+}
@@ -36,4 +36,60 @@ func TestTimer_Reset(t *testing.T) {
 	
 	// リセット後は時間が小さくなっている
 	assert.Less(t, timeAfterReset, timeBeforeReset)
-}
\ No newline at end of file
+}
+
+// fakeClock はテスト用に手動で進める時計
+type fakeClock struct {
+	current time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.current
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.current = c.current.Add(d)
+}
+
+func TestTimer_GetTimeAndElapsed(t *testing.T) {
+	// Arrange
+	clock := &fakeClock{current: time.Unix(1000, 0)}
+	timer := newTimerWithClock(clock.now)
+
+	// Act
+	clock.advance(1500 * time.Millisecond)
+
+	// Assert
+	assert.Equal(t, 1500*time.Millisecond, timer.Elapsed())
+	assert.Equal(t, 1.5, timer.GetTime())
+}
+
+func TestTimer_GetDelta(t *testing.T) {
+	// Arrange
+	clock := &fakeClock{current: time.Unix(1000, 0)}
+	timer := newTimerWithClock(clock.now)
+
+	// Act & Assert
+	clock.advance(250 * time.Millisecond)
+	assert.Equal(t, 0.25, timer.GetDelta(), "初回は開始時からの経過時間")
+
+	clock.advance(500 * time.Millisecond)
+	assert.Equal(t, 0.5, timer.GetDelta(), "前回の呼び出しからの経過時間")
+	assert.Equal(t, 0.0, timer.GetDelta())
+	assert.Equal(t, 0.75, timer.GetTime(), "GetDelta は経過時間に影響しない")
+}
+
+func TestTimer_Reset_WithClock(t *testing.T) {
+	// Arrange
+	clock := &fakeClock{current: time.Unix(1000, 0)}
+	timer := newTimerWithClock(clock.now)
+	clock.advance(2 * time.Second)
+
+	// Act
+	timer.Reset()
+	clock.advance(100 * time.Millisecond)
+
+	// Assert
+	assert.Equal(t, 100*time.Millisecond, timer.Elapsed())
+	assert.Equal(t, 0.1, timer.GetDelta(), "リセット時点からの差分になる")
+}
@@ -19,12 +19,20 @@ type Window struct {
 	config      WindowConfig
 	window      *glfw.Window
 	initialized bool
+	vsync       bool
+	cursorMode  CursorMode
+
+	fullscreen     fullscreenState
+	resizeCallback ResizeCallback
+	viewport       func(x, y, width, height int32) // ビューポートの設定（テストで差し替える）
 }
 
 // NewWindow は新しいウィンドウインスタンスを作成する
 func NewWindow(config WindowConfig) *Window {
 	return &Window{
-		config: config,
+		config:   config,
+		vsync:    true,
+		viewport: glViewport,
 	}
 }
 
@@ -38,12 +46,14 @@ func (w *Window) Initialize() error {
 	}
 	
 	if err := w.createWindow(); err != nil {
-		glfw.Terminate()
+		ReleaseGLFW()
 		return fmt.Errorf("window creation failed: %w", err)
 	}
 	
 	if err := w.initOpenGL(); err != nil {
-		w.Destroy()
+		w.window.Destroy()
+		w.window = nil
+		ReleaseGLFW()
 		return fmt.Errorf("OpenGL initialization failed: %w", err)
 	}
 	
@@ -53,7 +63,8 @@ func (w *Window) Initialize() error {
 
 // initGLFW initializes GLFW and sets hints
 func (w *Window) initGLFW() error {
-	if err := glfw.Init(); err != nil {
+	// 複数ウィンドウに対応するため参照カウントで初期化する
+	if err := AcquireGLFW(); err != nil {
 		return err
 	}
 	
@@ -75,6 +86,8 @@ func (w *Window) createWindow() error {
 	
 	w.window = window
 	w.window.MakeContextCurrent()
+	w.registerResizeCallback()
+	w.applyCursorMode()
 	return nil
 }
 
@@ -84,11 +97,43 @@ func (w *Window) initOpenGL() error {
 		return err
 	}
 	
-	// VSync有効化
-	glfw.SwapInterval(1)
+	// VSync設定（デフォルトは有効）
+	glfw.SwapInterval(swapInterval(w.vsync))
 	return nil
 }
 
+// SetVSync は垂直同期の有効・無効を切り替える
+// 初期化前に呼び出した場合は初期化時に反映する
+func (w *Window) SetVSync(enabled bool) {
+	w.vsync = enabled
+	if w.window != nil {
+		// スワップ間隔は現在のコンテキストに対して設定される
+		w.window.MakeContextCurrent()
+		glfw.SwapInterval(swapInterval(enabled))
+	}
+}
+
+// VSyncEnabled は垂直同期が有効かを返す
+func (w *Window) VSyncEnabled() bool {
+	return w.vsync
+}
+
+// swapInterval は垂直同期の設定に対応するスワップ間隔を返す
+func swapInterval(vsync bool) int {
+	if vsync {
+		return 1
+	}
+	return 0
+}
+
+// MakeCurrent はこのウィンドウのOpenGLコンテキストを現在のスレッドで有効にする
+// 複数ウィンドウを使用する場合は描画前に呼び出す
+func (w *Window) MakeCurrent() {
+	if w.window != nil {
+		w.window.MakeContextCurrent()
+	}
+}
+
 // ShouldClose はウィンドウが閉じられるべきかを返す
 func (w *Window) ShouldClose() bool {
 	if w.window == nil {
@@ -125,7 +170,8 @@ func (w *Window) Destroy() {
 	}
 	
 	if w.initialized {
-		glfw.Terminate()
+		// 他のウィンドウが残っている場合はGLFWを終了しない
+		ReleaseGLFW()
 		w.initialized = false
 	}
 }
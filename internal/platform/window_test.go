@@ -45,4 +45,19 @@ func TestWindow_InitializeAndDestroy(t *testing.T) {
 	
 	// 終了処理
 	window.Destroy()
-}
\ No newline at end of file
+}
+func TestWindow_VSync(t *testing.T) {
+	window := NewWindow(WindowConfig{Title: "テスト", Width: 100, Height: 100})
+
+	// デフォルトは有効
+	assert.True(t, window.VSyncEnabled())
+
+	// 未初期化でも設定値は保持され、初期化時に反映される
+	window.SetVSync(false)
+	assert.False(t, window.VSyncEnabled())
+	assert.Equal(t, 0, swapInterval(window.VSyncEnabled()))
+
+	window.SetVSync(true)
+	assert.True(t, window.VSyncEnabled())
+	assert.Equal(t, 1, swapInterval(window.VSyncEnabled()))
+}
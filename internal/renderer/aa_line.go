@@ -0,0 +1,163 @@
+package renderer
+
+import (
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+	"github.com/go-gl/gl/v4.1-core/gl"
+)
+
+// アンチエイリアス線の定数
+const (
+	AALineShaderName     = "line_aa"
+	AALineVertexStride   = 3   // 頂点あたりのfloat数: x, y, 中心線からの距離
+	DefaultAALineFeather = 1.0 // デフォルトのぼかし幅（ピクセル）
+)
+
+// アンチエイリアス線シェーダーソースコード
+// 線を四角形に展開し、各フラグメントの中心線からの距離で縁をなめらかにする
+const (
+	AALineVertexShaderSource = `#version 410 core
+layout (location = 0) in vec2 aPos;
+layout (location = 1) in float aEdgeDistance;
+
+uniform mat4 u_transform;
+
+out float vEdgeDistance;
+
+void main()
+{
+    vEdgeDistance = aEdgeDistance;
+    gl_Position = u_transform * vec4(aPos, 0.0, 1.0);
+}`
+
+	AALineFragmentShaderSource = `#version 410 core
+in float vEdgeDistance;
+
+uniform vec4 u_color;
+uniform float u_extent;   // 中心線から四角形の縁までの距離
+uniform float u_feather;
+
+out vec4 FragColor;
+
+void main()
+{
+    float coverage = 1.0 - smoothstep(u_extent - u_feather, u_extent, abs(vEdgeDistance));
+    if (coverage <= 0.0) {
+        discard;
+    }
+    FragColor = vec4(u_color.rgb, u_color.a * coverage);
+}`
+)
+
+// aaLineQuadIndices は線の四角形を構成する2つの三角形
+var aaLineQuadIndices = []uint32{0, 1, 2, 2, 3, 0}
+
+// BuildAALineQuad は線分を幅とぼかし幅の分だけ太らせた四角形の頂点データを作成する
+// 各頂点は x, y と中心線からの符号付き距離（ピクセル）を持ち、距離はフラグメント間で線形補間される
+// 長さ0の線分の場合は nil を返す
+func BuildAALineQuad(x1, y1, x2, y2, width, feather float32) []float32 {
+	start := mathlib.Vector2{X: float64(x1), Y: float64(y1)}
+	end := mathlib.Vector2{X: float64(x2), Y: float64(y2)}
+	direction := end.Sub(start)
+	if mathlib.IsZero(direction.LengthSquared()) {
+		return nil
+	}
+
+	// ぼかしの中央が線の本来の縁に来るよう、ぼかし幅の半分だけ外側に広げる
+	extent := float64(aaLineExtent(width, feather))
+	normal := perpendicular(direction.Normalize()).Scale(extent)
+
+	corners := []struct {
+		point    mathlib.Vector2
+		distance float64
+	}{
+		{start.Add(normal), extent},
+		{end.Add(normal), extent},
+		{end.Sub(normal), -extent},
+		{start.Sub(normal), -extent},
+	}
+
+	vertices := make([]float32, 0, len(corners)*AALineVertexStride)
+	for _, corner := range corners {
+		vertices = append(vertices, float32(corner.point.X), float32(corner.point.Y), float32(corner.distance))
+	}
+	return vertices
+}
+
+// aaLineExtent は中心線から展開した四角形の縁までの距離を計算する
+func aaLineExtent(width, feather float32) float32 {
+	return width/2 + feather/2
+}
+
+// AALineCoverage は中心線からの距離における線の被覆率（0〜1）を計算する
+// フラグメントシェーダーと同じ計算で、線の本来の縁（width/2）で0.5、四角形の縁で0になる
+func AALineCoverage(distance, width, feather float32) float32 {
+	if distance < 0 {
+		distance = -distance
+	}
+	extent := aaLineExtent(width, feather)
+	return 1.0 - smoothstep(extent-feather, extent, distance)
+}
+
+// DrawLineAA はアンチエイリアスされた線を描画する
+// feather はなめらかにする縁の幅（ピクセル）で、0以下の場合はデフォルト値を使用する
+func (r *OpenGLRenderer) DrawLineAA(x1, y1, x2, y2, width, feather float32, color Color) {
+	if r.shaderManager == nil {
+		return
+	}
+	r.flushState()
+	if feather <= 0 {
+		feather = DefaultAALineFeather
+	}
+
+	shader := r.shaderManager.GetShader(AALineShaderName)
+	vertices := BuildAALineQuad(x1, y1, x2, y2, width, feather)
+	if shader == nil || vertices == nil {
+		return
+	}
+
+	previousShader := r.shaderManager.GetCurrentShader()
+	defer func() {
+		if previousShader != "" {
+			r.shaderManager.UseShader(previousShader)
+		}
+	}()
+
+	vao := r.bufferPool.GetVAO()
+	vbo := r.bufferPool.GetVBO()
+	ebo := r.bufferPool.GetEBO()
+	defer func() {
+		gl.BindVertexArray(0)
+		r.bufferPool.ReturnVAO(vao)
+		r.bufferPool.ReturnVBO(vbo)
+		r.bufferPool.ReturnEBO(ebo)
+	}()
+
+	gl.BindVertexArray(vao)
+	gl.BindBuffer(gl.ARRAY_BUFFER, vbo)
+	r.bufferPool.UploadFloat32(gl.ARRAY_BUFFER, vbo, vertices)
+	gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, ebo)
+	r.bufferPool.UploadUint32(gl.ELEMENT_ARRAY_BUFFER, ebo, aaLineQuadIndices)
+
+	stride := int32(AALineVertexStride * FloatSizeBytes)
+	gl.VertexAttribPointer(0, 2, gl.FLOAT, false, stride, gl.PtrOffset(0))
+	gl.EnableVertexAttribArray(0)
+	gl.VertexAttribPointer(1, 1, gl.FLOAT, false, stride, gl.PtrOffset(2*FloatSizeBytes))
+	gl.EnableVertexAttribArray(1)
+
+	r.shaderManager.UseShader(AALineShaderName)
+	r.applyDrawUniforms(shader, color)
+	if loc := shader.GetUniformLocation("u_extent"); loc != -1 {
+		gl.Uniform1f(loc, aaLineExtent(width, feather))
+	}
+	if loc := shader.GetUniformLocation("u_feather"); loc != -1 {
+		gl.Uniform1f(loc, feather)
+	}
+
+	if !gl.IsEnabled(gl.BLEND) {
+		gl.Enable(gl.BLEND)
+		defer gl.Disable(gl.BLEND)
+	}
+	gl.BlendFunc(gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA)
+	defer r.applyBlendState()
+	gl.DrawElements(gl.TRIANGLES, int32(len(aaLineQuadIndices)), gl.UNSIGNED_INT, gl.PtrOffset(0))
+}
@@ -0,0 +1,58 @@
+package renderer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildAALineQuad_Diagonal(t *testing.T) {
+	// Arrange: (0,0)→(10,10) の斜め線、幅2・ぼかし幅2 → 中心線から2まで展開
+	extent := float32(2)
+	offset := extent / float32(math.Sqrt2) // 法線方向 (-1,1)/√2 × extent
+
+	// Act
+	vertices := BuildAALineQuad(0, 0, 10, 10, 2, 2)
+
+	// Assert
+	require.Len(t, vertices, 4*AALineVertexStride)
+	expected := [][3]float32{
+		{-offset, offset, extent},
+		{10 - offset, 10 + offset, extent},
+		{10 + offset, 10 - offset, -extent},
+		{offset, -offset, -extent},
+	}
+	for i, e := range expected {
+		base := i * AALineVertexStride
+		assert.InDelta(t, e[0], vertices[base], 1e-5, "vertex %d x", i)
+		assert.InDelta(t, e[1], vertices[base+1], 1e-5, "vertex %d y", i)
+		assert.InDelta(t, e[2], vertices[base+2], 1e-5, "vertex %d distance", i)
+	}
+}
+
+func TestBuildAALineQuad_EdgeDistanceMatchesGeometry(t *testing.T) {
+	vertices := BuildAALineQuad(3, 4, 20, -7, 5, 1)
+
+	// 各頂点の距離属性は中心線までの実際の距離と一致する
+	for i := 0; i < 4; i++ {
+		base := i * AALineVertexStride
+		x, y := float64(vertices[base]), float64(vertices[base+1])
+		// 直線 (3,4)→(20,-7) からの距離
+		dx, dy := 17.0, -11.0
+		distance := math.Abs(dy*(x-3)-dx*(y-4)) / math.Hypot(dx, dy)
+		assert.InDelta(t, distance, math.Abs(float64(vertices[base+2])), 1e-4)
+	}
+}
+
+func TestBuildAALineQuad_ZeroLength(t *testing.T) {
+	assert.Nil(t, BuildAALineQuad(5, 5, 5, 5, 2, 1))
+}
+
+func TestAALineCoverage(t *testing.T) {
+	assert.InDelta(t, 1.0, AALineCoverage(0, 4, 1), 1e-6, "中心線上")
+	assert.InDelta(t, 0.5, AALineCoverage(2, 4, 1), 1e-6, "本来の縁")
+	assert.InDelta(t, 0.5, AALineCoverage(-2, 4, 1), 1e-6, "反対側の縁")
+	assert.InDelta(t, 0.0, AALineCoverage(2.5, 4, 1), 1e-6, "四角形の縁")
+}
@@ -0,0 +1,107 @@
+package renderer
+
+// batchFlushFunc はまとめた頂点データを1回の描画呼び出しで描画する関数
+type batchFlushFunc func(vertices []float32, indices []uint32, color Color, primitiveType PrimitiveType)
+
+// primitiveBatch は同じ種類・同じ色のプリミティブの頂点とインデックスを共有バッファにまとめる
+// 種類または色が異なるプリミティブが追加されると、それまでの内容を描画してから新しいバッチを開始する
+type primitiveBatch struct {
+	vertices      []float32
+	indices       []uint32
+	color         Color
+	primitiveType PrimitiveType
+	flushFunc     batchFlushFunc
+	flushCount    int
+}
+
+// newPrimitiveBatch は新しいprimitiveBatchを作成する
+func newPrimitiveBatch(flushFunc batchFlushFunc) *primitiveBatch {
+	return &primitiveBatch{
+		flushFunc: flushFunc,
+	}
+}
+
+// Add はプリミティブの頂点データをバッチに追加する
+// インデックスは追加済みの頂点数だけずらして共有バッファ内の位置を指すようにする
+func (b *primitiveBatch) Add(vertices []float32, indices []uint32, color Color, primitiveType PrimitiveType) {
+	if len(vertices) == 0 || len(indices) == 0 {
+		return
+	}
+
+	if !b.IsEmpty() && (b.primitiveType != primitiveType || b.color != color) {
+		b.Flush()
+	}
+
+	b.color = color
+	b.primitiveType = primitiveType
+
+	baseVertex := uint32(len(b.vertices) / VertexPositionSize)
+	b.vertices = append(b.vertices, vertices...)
+	for _, index := range indices {
+		b.indices = append(b.indices, baseVertex+index)
+	}
+}
+
+// Flush はまとめた頂点データを描画してバッチを空にする
+func (b *primitiveBatch) Flush() {
+	if b.IsEmpty() {
+		return
+	}
+
+	b.flushFunc(b.vertices, b.indices, b.color, b.primitiveType)
+	b.flushCount++
+
+	b.vertices = b.vertices[:0]
+	b.indices = b.indices[:0]
+}
+
+// IsEmpty はバッチに描画待ちの頂点がないかを判定する
+func (b *primitiveBatch) IsEmpty() bool {
+	return len(b.indices) == 0
+}
+
+// FlushCount はこれまでに描画呼び出しを行った回数を取得する
+func (b *primitiveBatch) FlushCount() int {
+	return b.flushCount
+}
+
+// isBatchable はプリミティブをバッチにまとめられるかを判定する
+// インデックスを持たないプリミティブや頂点カラーを持つプリミティブは個別に描画する
+func isBatchable(indices []uint32, vertexColors []Color) bool {
+	return len(indices) > 0 && vertexColors == nil
+}
+
+// BeginBatch は描画のバッチ処理を開始する
+// EndBatch までの DrawPrimitive は同じ種類・同じ色が続く間1回の描画呼び出しにまとめられる
+func (r *OpenGLRenderer) BeginBatch() {
+	r.flushBatch()
+	r.batch = newPrimitiveBatch(func(vertices []float32, indices []uint32, color Color, primitiveType PrimitiveType) {
+		r.drawVertices(vertices, indices, color, primitiveType, nil, NewColor(1.0, 1.0, 1.0, 1.0))
+	})
+}
+
+// EndBatch はまとめた描画を実行してバッチ処理を終了する
+func (r *OpenGLRenderer) EndBatch() {
+	r.flushBatch()
+	r.batch = nil
+}
+
+// IsBatching はバッチ処理中かを判定する
+func (r *OpenGLRenderer) IsBatching() bool {
+	return r.batch != nil
+}
+
+// flushState は描画状態を変更する前に、変更前の状態で描画すべき内容をすべて描画する
+// レイヤー順の描画中のプリミティブを描画してから、バッチの内容を描画する
+func (r *OpenGLRenderer) flushState() {
+	r.flushScene()
+	r.flushBatch()
+}
+
+// flushBatch はバッチ処理中であれば描画待ちの内容を描画する
+// バッチを経由しない描画の前に呼び出し、描画順序を保つ
+func (r *OpenGLRenderer) flushBatch() {
+	if r.batch != nil {
+		r.batch.Flush()
+	}
+}
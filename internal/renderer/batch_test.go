@@ -0,0 +1,170 @@
+package renderer
+
+import (
+	"testing"
+
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+	"github.com/stretchr/testify/assert"
+)
+
+// flushRecorder はバッチの描画呼び出しを記録する
+type flushRecorder struct {
+	calls []flushCall
+}
+
+type flushCall struct {
+	vertices      []float32
+	indices       []uint32
+	color         Color
+	primitiveType PrimitiveType
+}
+
+func (f *flushRecorder) flush(vertices []float32, indices []uint32, color Color, primitiveType PrimitiveType) {
+	f.calls = append(f.calls, flushCall{
+		vertices:      append([]float32(nil), vertices...),
+		indices:       append([]uint32(nil), indices...),
+		color:         color,
+		primitiveType: primitiveType,
+	})
+}
+
+func addPrimitive(batch *primitiveBatch, p Primitive) {
+	batch.Add(p.GetVertices(), p.GetIndices(), p.GetColor(), p.GetType())
+}
+
+func TestPrimitiveBatch_SameTypeAndColorFlushOnce(t *testing.T) {
+	// Arrange
+	recorder := &flushRecorder{}
+	batch := newPrimitiveBatch(recorder.flush)
+	color := NewColorRGB(1, 0, 0)
+
+	// Act
+	for i := 0; i < 10; i++ {
+		addPrimitive(batch, NewRectangle(float32(i*20), 0, 10, 10, color))
+	}
+	batch.Flush()
+
+	// Assert
+	assert.Equal(t, 1, batch.FlushCount())
+	assert.Len(t, recorder.calls, 1)
+	assert.Len(t, recorder.calls[0].vertices, 10*4*3)
+	assert.Len(t, recorder.calls[0].indices, 10*6)
+	assert.Equal(t, color, recorder.calls[0].color)
+	assert.True(t, batch.IsEmpty())
+}
+
+func TestPrimitiveBatch_OffsetsIndices(t *testing.T) {
+	// Arrange
+	recorder := &flushRecorder{}
+	batch := newPrimitiveBatch(recorder.flush)
+	color := NewColorRGB(1, 1, 1)
+
+	// Act
+	addPrimitive(batch, NewRectangle(0, 0, 10, 10, color))
+	addPrimitive(batch, NewRectangle(20, 0, 10, 10, color))
+	batch.Flush()
+
+	// Assert
+	expected := []uint32{
+		0, 1, 2, 2, 3, 0,
+		4, 5, 6, 6, 7, 4, // 2つ目の矩形は頂点4から始まる
+	}
+	assert.Equal(t, expected, recorder.calls[0].indices)
+}
+
+func TestPrimitiveBatch_FlushesOnTypeOrColorChange(t *testing.T) {
+	// Arrange
+	recorder := &flushRecorder{}
+	batch := newPrimitiveBatch(recorder.flush)
+	red := NewColorRGB(1, 0, 0)
+	blue := NewColorRGB(0, 0, 1)
+
+	// Act
+	addPrimitive(batch, NewRectangle(0, 0, 10, 10, red))
+	addPrimitive(batch, NewRectangle(20, 0, 10, 10, red))
+	addPrimitive(batch, NewCircle(50, 50, 5, red))   // 種類が変わる
+	addPrimitive(batch, NewCircle(80, 50, 5, blue))  // 色が変わる
+	addPrimitive(batch, NewCircle(110, 50, 5, blue)) // 同じ種類・色
+	batch.Flush()
+
+	// Assert
+	assert.Equal(t, 3, batch.FlushCount())
+	assert.Equal(t, PrimitiveTypeRectangle, recorder.calls[0].primitiveType)
+	assert.Equal(t, PrimitiveTypeCircle, recorder.calls[1].primitiveType)
+	assert.Equal(t, red, recorder.calls[1].color)
+	assert.Equal(t, blue, recorder.calls[2].color)
+}
+
+func TestPrimitiveBatch_FlushEmptyDoesNothing(t *testing.T) {
+	// Arrange
+	recorder := &flushRecorder{}
+	batch := newPrimitiveBatch(recorder.flush)
+
+	// Act
+	batch.Flush()
+	batch.Add([]float32{}, []uint32{}, NewColorRGB(1, 1, 1), PrimitiveTypeRectangle)
+	batch.Flush()
+
+	// Assert
+	assert.Equal(t, 0, batch.FlushCount())
+	assert.Empty(t, recorder.calls)
+}
+
+func TestIsBatchable(t *testing.T) {
+	rect := NewRectangle(0, 0, 10, 10, NewColorRGB(1, 1, 1))
+	point := NewPoint(0, 0, NewColorRGB(1, 1, 1))
+
+	assert.True(t, isBatchable(rect.GetIndices(), nil))
+	assert.False(t, isBatchable(point.GetIndices(), nil), "インデックスなしは個別に描画する")
+	assert.False(t, isBatchable(rect.GetIndices(), []Color{}), "頂点カラーありは個別に描画する")
+}
+
+func TestOpenGLRenderer_BeginEndBatch(t *testing.T) {
+	// Arrange
+	renderer := &OpenGLRenderer{}
+
+	// Act & Assert
+	assert.False(t, renderer.IsBatching())
+	renderer.BeginBatch()
+	assert.True(t, renderer.IsBatching())
+	renderer.EndBatch()
+	assert.False(t, renderer.IsBatching())
+}
+
+func TestOpenGLRenderer_StateSettersFlushBatch(t *testing.T) {
+	camera := mathlib.NewCamera2D()
+	setters := map[string]func(r *OpenGLRenderer){
+		"SetCamera":            func(r *OpenGLRenderer) { r.SetCamera(nil) },
+		"SetBlendMode":         func(r *OpenGLRenderer) { r.SetBlendMode(BlendModeAdditive) },
+		"EnableBlending":       func(r *OpenGLRenderer) { r.EnableBlending(false) },
+		"SetVirtualResolution": func(r *OpenGLRenderer) { r.SetVirtualResolution(320, 180) },
+		"SetPolygonMode":       func(r *OpenGLRenderer) { r.SetPolygonMode(PolygonModeLine) },
+	}
+
+	for name, setter := range setters {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			renderer := &OpenGLRenderer{camera: &camera, blend: blendState{enabled: true}}
+			var flushedCameras []*mathlib.Camera2D
+			var flushedBlend []blendState
+			renderer.batch = newPrimitiveBatch(func(vertices []float32, indices []uint32, color Color, primitiveType PrimitiveType) {
+				flushedCameras = append(flushedCameras, renderer.GetCamera())
+				flushedBlend = append(flushedBlend, renderer.blend)
+			})
+			white := NewColorRGB(1, 1, 1)
+
+			// Act
+			// 同じ種類・同じ色のプリミティブを状態変更の前後で描画する
+			addPrimitive(renderer.batch, NewRectangle(0, 0, 10, 10, white))
+			setter(renderer)
+			addPrimitive(renderer.batch, NewRectangle(20, 0, 10, 10, white))
+			renderer.EndBatch()
+
+			// Assert
+			// 状態変更前のプリミティブは変更前の状態で描画される
+			assert.Len(t, flushedCameras, 2)
+			assert.Equal(t, &camera, flushedCameras[0])
+			assert.Equal(t, blendState{enabled: true}, flushedBlend[0])
+		})
+	}
+}
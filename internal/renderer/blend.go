@@ -0,0 +1,93 @@
+package renderer
+
+import (
+	"github.com/go-gl/gl/v4.1-core/gl"
+)
+
+// BlendMode は描画色と既存のフレームバッファの色の合成方法を表す
+type BlendMode int
+
+const (
+	BlendModeAlpha    BlendMode = iota // アルファ値による半透明合成
+	BlendModeAdditive                  // 加算合成（発光・パーティクル向け）
+	BlendModeMultiply                  // 乗算合成（影・色付け向け）
+)
+
+// String はブレンドモードの名前を返す
+func (m BlendMode) String() string {
+	switch m {
+	case BlendModeAlpha:
+		return "Alpha"
+	case BlendModeAdditive:
+		return "Additive"
+	case BlendModeMultiply:
+		return "Multiply"
+	default:
+		return "Unknown"
+	}
+}
+
+// blendFactors はブレンドモードに対応する gl.BlendFunc の係数を返す
+// 未知のモードはアルファ合成として扱う
+func blendFactors(mode BlendMode) (uint32, uint32) {
+	switch mode {
+	case BlendModeAdditive:
+		return gl.SRC_ALPHA, gl.ONE
+	case BlendModeMultiply:
+		return gl.DST_COLOR, gl.ONE_MINUS_SRC_ALPHA
+	default:
+		return gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA
+	}
+}
+
+// blendState はレンダラーのブレンド設定を保持する
+// GL の状態はヘッドレス環境で確認できないため、設定値をここで管理してから GL に反映する
+type blendState struct {
+	enabled bool
+	mode    BlendMode
+}
+
+// factors は現在のモードに対応する gl.BlendFunc の係数を返す
+func (s blendState) factors() (uint32, uint32) {
+	return blendFactors(s.mode)
+}
+
+// EnableBlending はブレンドの有効・無効を切り替える
+func (r *OpenGLRenderer) EnableBlending(enabled bool) {
+	r.flushState()
+	r.blend.enabled = enabled
+	r.applyBlendState()
+}
+
+// IsBlendingEnabled はブレンドが有効かを取得する
+func (r *OpenGLRenderer) IsBlendingEnabled() bool {
+	return r.blend.enabled
+}
+
+// SetBlendMode はブレンドモードを設定する
+func (r *OpenGLRenderer) SetBlendMode(mode BlendMode) {
+	r.flushState()
+	r.blend.mode = mode
+	r.applyBlendState()
+}
+
+// GetBlendMode は現在のブレンドモードを取得する
+func (r *OpenGLRenderer) GetBlendMode() BlendMode {
+	return r.blend.mode
+}
+
+// applyBlendState は保持しているブレンド設定を GL に反映する
+// OpenGLコンテキストを持たない場合（ウィンドウなし）は設定値の更新のみ行う
+func (r *OpenGLRenderer) applyBlendState() {
+	if r.window == nil {
+		return
+	}
+
+	if !r.blend.enabled {
+		gl.Disable(gl.BLEND)
+		return
+	}
+
+	gl.Enable(gl.BLEND)
+	gl.BlendFunc(r.blend.factors())
+}
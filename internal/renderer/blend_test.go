@@ -0,0 +1,57 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/v4.1-core/gl"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlendMode_String(t *testing.T) {
+	assert.Equal(t, "Alpha", BlendModeAlpha.String())
+	assert.Equal(t, "Additive", BlendModeAdditive.String())
+	assert.Equal(t, "Multiply", BlendModeMultiply.String())
+	assert.Equal(t, "Unknown", BlendMode(99).String())
+}
+
+func TestBlendFactors(t *testing.T) {
+	tests := []struct {
+		mode     BlendMode
+		src, dst uint32
+	}{
+		{BlendModeAlpha, gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA},
+		{BlendModeAdditive, gl.SRC_ALPHA, gl.ONE},
+		{BlendModeMultiply, gl.DST_COLOR, gl.ONE_MINUS_SRC_ALPHA},
+		{BlendMode(99), gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			// Act
+			src, dst := blendState{enabled: true, mode: tt.mode}.factors()
+
+			// Assert
+			assert.Equal(t, tt.src, src)
+			assert.Equal(t, tt.dst, dst)
+		})
+	}
+}
+
+func TestOpenGLRenderer_BlendState(t *testing.T) {
+	// Arrange
+	// ウィンドウなしのレンダラーはGLを呼ばずに設定値だけを更新する
+	renderer := &OpenGLRenderer{}
+
+	// Act & Assert
+	assert.False(t, renderer.IsBlendingEnabled())
+	assert.Equal(t, BlendModeAlpha, renderer.GetBlendMode())
+
+	renderer.EnableBlending(true)
+	renderer.SetBlendMode(BlendModeAdditive)
+	assert.True(t, renderer.IsBlendingEnabled())
+	assert.Equal(t, BlendModeAdditive, renderer.GetBlendMode())
+
+	renderer.EnableBlending(false)
+	assert.False(t, renderer.IsBlendingEnabled())
+	assert.Equal(t, BlendModeAdditive, renderer.GetBlendMode(), "無効化してもモードは保持する")
+}
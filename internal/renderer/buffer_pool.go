@@ -1,24 +1,43 @@
 package renderer
 
 import (
+	"unsafe"
+
 	"github.com/go-gl/gl/v4.1-core/gl"
 )
 
 // BufferPool manages reusable OpenGL buffers
 type BufferPool struct {
+	backend OpenGLBackend
 	vaoPool chan uint32
 	vboPool chan uint32
 	eboPool chan uint32
 	maxSize int
+
+	// capacities tracks the allocated data store size in bytes of each VBO/EBO
+	capacities map[uint32]int
+
+	// counters reported by Stats
+	created   int
+	reused    int
+	discarded int
 }
 
 // NewBufferPool creates a new buffer pool
 func NewBufferPool(maxSize int) *BufferPool {
+	return NewBufferPoolWithBackend(NewRealOpenGLBackend(), maxSize)
+}
+
+// NewBufferPoolWithBackend creates a new buffer pool that allocates buffers through backend
+func NewBufferPoolWithBackend(backend OpenGLBackend, maxSize int) *BufferPool {
 	return &BufferPool{
+		backend: backend,
 		vaoPool: make(chan uint32, maxSize),
 		vboPool: make(chan uint32, maxSize),
 		eboPool: make(chan uint32, maxSize),
 		maxSize: maxSize,
+
+		capacities: make(map[uint32]int),
 	}
 }
 
@@ -26,35 +45,33 @@ func NewBufferPool(maxSize int) *BufferPool {
 func (bp *BufferPool) GetVAO() uint32 {
 	select {
 	case vao := <-bp.vaoPool:
+		bp.reused++
 		return vao
 	default:
-		var vao uint32
-		gl.GenVertexArrays(1, &vao)
-		return vao
+		bp.created++
+		return bp.backend.GenVertexArray()
 	}
 }
 
 // GetVBO gets a VBO from the pool or creates a new one
 func (bp *BufferPool) GetVBO() uint32 {
-	select {
-	case vbo := <-bp.vboPool:
-		return vbo
-	default:
-		var vbo uint32
-		gl.GenBuffers(1, &vbo)
-		return vbo
-	}
+	return bp.getBuffer(bp.vboPool)
 }
 
 // GetEBO gets an EBO from the pool or creates a new one
 func (bp *BufferPool) GetEBO() uint32 {
+	return bp.getBuffer(bp.eboPool)
+}
+
+// getBuffer takes a buffer object from pool or creates a new one
+func (bp *BufferPool) getBuffer(pool chan uint32) uint32 {
 	select {
-	case ebo := <-bp.eboPool:
-		return ebo
+	case buffer := <-pool:
+		bp.reused++
+		return buffer
 	default:
-		var ebo uint32
-		gl.GenBuffers(1, &ebo)
-		return ebo
+		bp.created++
+		return bp.backend.GenBuffer()
 	}
 }
 
@@ -64,61 +81,157 @@ func (bp *BufferPool) ReturnVAO(vao uint32) {
 	case bp.vaoPool <- vao:
 	default:
 		// Pool is full, delete the buffer
-		gl.DeleteVertexArrays(1, &vao)
+		bp.discarded++
+		bp.backend.DeleteVertexArray(vao)
 	}
 }
 
 // ReturnVBO returns a VBO to the pool
 func (bp *BufferPool) ReturnVBO(vbo uint32) {
-	select {
-	case bp.vboPool <- vbo:
-	default:
-		// Pool is full, delete the buffer
-		gl.DeleteBuffers(1, &vbo)
-	}
+	bp.returnBuffer(bp.vboPool, vbo)
 }
 
 // ReturnEBO returns an EBO to the pool
 func (bp *BufferPool) ReturnEBO(ebo uint32) {
+	bp.returnBuffer(bp.eboPool, ebo)
+}
+
+// returnBuffer puts a buffer object back into pool, deleting it when the pool is full
+func (bp *BufferPool) returnBuffer(pool chan uint32, buffer uint32) {
 	select {
-	case bp.eboPool <- ebo:
+	case pool <- buffer:
 	default:
 		// Pool is full, delete the buffer
-		gl.DeleteBuffers(1, &ebo)
+		bp.discarded++
+		bp.deleteBuffer(buffer)
 	}
 }
 
-// Destroy cleans up all buffers in the pool
-func (bp *BufferPool) Destroy() {
-	// Clean VAO pool
-	for {
-		select {
-		case vao := <-bp.vaoPool:
-			gl.DeleteVertexArrays(1, &vao)
-		default:
-			goto cleanVBO
-		}
+// deleteBuffer deletes a buffer object and drops its tracked capacity
+func (bp *BufferPool) deleteBuffer(buffer uint32) {
+	bp.forget(buffer)
+	bp.backend.DeleteBuffer(buffer)
+}
+
+// Stats returns how many objects were newly created, reused from the pool,
+// and deleted because the pool was full (counted across VAOs, VBOs and EBOs)
+func (bp *BufferPool) Stats() (created, reused, discarded int) {
+	return bp.created, bp.reused, bp.discarded
+}
+
+// MaxSize returns the number of objects of each kind the pool keeps
+func (bp *BufferPool) MaxSize() int {
+	return bp.maxSize
+}
+
+// Resize changes how many objects of each kind the pool keeps.
+// When shrinking, pooled objects that no longer fit are deleted and counted as discarded.
+func (bp *BufferPool) Resize(newMax int) {
+	if newMax < 0 {
+		newMax = 0
 	}
 
-cleanVBO:
-	// Clean VBO pool
+	bp.vaoPool = bp.resizePool(bp.vaoPool, newMax, bp.backend.DeleteVertexArray)
+	bp.vboPool = bp.resizePool(bp.vboPool, newMax, bp.deleteBuffer)
+	bp.eboPool = bp.resizePool(bp.eboPool, newMax, bp.deleteBuffer)
+	bp.maxSize = newMax
+}
+
+// resizePool moves pooled objects into a channel with the new capacity
+func (bp *BufferPool) resizePool(pool chan uint32, newMax int, deleteFunc func(uint32)) chan uint32 {
+	resized := make(chan uint32, newMax)
 	for {
 		select {
-		case vbo := <-bp.vboPool:
-			gl.DeleteBuffers(1, &vbo)
+		case id := <-pool:
+			select {
+			case resized <- id:
+			default:
+				bp.discarded++
+				deleteFunc(id)
+			}
 		default:
-			goto cleanEBO
+			return resized
 		}
 	}
+}
+
+// Destroy cleans up all buffers in the pool
+func (bp *BufferPool) Destroy() {
+	drainPool(bp.vaoPool, bp.backend.DeleteVertexArray)
+	drainPool(bp.vboPool, bp.deleteBuffer)
+	drainPool(bp.eboPool, bp.deleteBuffer)
+}
 
-cleanEBO:
-	// Clean EBO pool
+// drainPool deletes every object left in pool
+func drainPool(pool chan uint32, deleteFunc func(uint32)) {
 	for {
 		select {
-		case ebo := <-bp.eboPool:
-			gl.DeleteBuffers(1, &ebo)
+		case id := <-pool:
+			deleteFunc(id)
 		default:
 			return
 		}
 	}
-}
\ No newline at end of file
+}
+
+// growCapacity returns the data store size needed to hold size bytes.
+// The current capacity is kept when it is large enough; otherwise it at least doubles
+// so that slowly growing geometry does not reallocate every frame.
+func growCapacity(capacity, size int) int {
+	if size <= capacity {
+		return capacity
+	}
+	if doubled := capacity * 2; doubled > size {
+		return doubled
+	}
+	return size
+}
+
+// reserve records that buffer must hold size bytes.
+// It returns the new capacity and whether the data store has to be (re)allocated.
+func (bp *BufferPool) reserve(buffer uint32, size int) (int, bool) {
+	capacity, exists := bp.capacities[buffer]
+	newCapacity := growCapacity(capacity, size)
+	if exists && newCapacity == capacity {
+		return capacity, false
+	}
+
+	bp.capacities[buffer] = newCapacity
+	return newCapacity, true
+}
+
+// forget drops the tracked capacity of a buffer that is about to be deleted
+func (bp *BufferPool) forget(buffer uint32) {
+	delete(bp.capacities, buffer)
+}
+
+// Capacity returns the tracked data store size in bytes of a pooled buffer
+func (bp *BufferPool) Capacity(buffer uint32) int {
+	return bp.capacities[buffer]
+}
+
+// UploadFloat32 uploads vertex data to the currently bound buffer.
+// The data store is allocated with GL_DYNAMIC_DRAW only when it has to grow;
+// otherwise the existing allocation is reused via BufferSubData.
+// Every upload to a pooled VBO/EBO must go through UploadFloat32/UploadUint32:
+// a raw gl.BufferData would shrink the data store behind the tracked capacity.
+func (bp *BufferPool) UploadFloat32(target, buffer uint32, data []float32) {
+	bp.upload(target, buffer, len(data)*FloatSizeBytes, gl.Ptr(data))
+}
+
+// UploadUint32 uploads index data to the currently bound buffer (see UploadFloat32)
+func (bp *BufferPool) UploadUint32(target, buffer uint32, data []uint32) {
+	bp.upload(target, buffer, len(data)*4, gl.Ptr(data))
+}
+
+// upload writes size bytes to the bound buffer, reallocating only when it grows
+func (bp *BufferPool) upload(target, buffer uint32, size int, data unsafe.Pointer) {
+	if size == 0 {
+		return
+	}
+
+	if capacity, grow := bp.reserve(buffer, size); grow {
+		gl.BufferData(target, capacity, nil, gl.DYNAMIC_DRAW)
+	}
+	gl.BufferSubData(target, 0, size, data)
+}
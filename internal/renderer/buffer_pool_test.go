@@ -0,0 +1,142 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrowCapacity(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		size     int
+		expected int
+	}{
+		{"fits in current capacity", 256, 128, 256},
+		{"exactly fits", 256, 256, 256},
+		{"grows by doubling", 256, 300, 512},
+		{"grows to size when doubling is not enough", 256, 1000, 1000},
+		{"first allocation uses size", 0, 96, 96},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, growCapacity(tt.capacity, tt.size))
+		})
+	}
+}
+
+func TestBufferPool_Reserve(t *testing.T) {
+	// Arrange
+	pool := NewBufferPool(4)
+
+	// Act & Assert
+	capacity, grow := pool.reserve(1, 120)
+	assert.True(t, grow, "first upload allocates the data store")
+	assert.Equal(t, 120, capacity)
+
+	capacity, grow = pool.reserve(1, 60)
+	assert.False(t, grow, "smaller data reuses the allocation")
+	assert.Equal(t, 120, capacity)
+
+	capacity, grow = pool.reserve(1, 200)
+	assert.True(t, grow)
+	assert.Equal(t, 240, capacity)
+	assert.Equal(t, 240, pool.Capacity(1))
+
+	_, grow = pool.reserve(2, 60)
+	assert.True(t, grow, "capacity is tracked per buffer")
+}
+
+func TestBufferPool_Forget(t *testing.T) {
+	// Arrange
+	pool := NewBufferPool(4)
+	pool.reserve(7, 64)
+
+	// Act
+	pool.forget(7)
+
+	// Assert
+	assert.Equal(t, 0, pool.Capacity(7))
+	_, grow := pool.reserve(7, 64)
+	assert.True(t, grow, "a recreated buffer with the same ID is allocated again")
+}
+
+func TestBufferPool_Stats(t *testing.T) {
+	// Arrange
+	backend := NewMockOpenGLBackend()
+	backend.On("GenBuffer").Return(uint32(1)).Once()
+	backend.On("GenBuffer").Return(uint32(2)).Once()
+	backend.On("GenBuffer").Return(uint32(3)).Once()
+	pool := NewBufferPoolWithBackend(backend, 3)
+
+	// Act
+	// Three gets from an empty pool create new buffers
+	first := pool.GetVBO()
+	second := pool.GetVBO()
+	third := pool.GetVBO()
+	pool.ReturnVBO(first)
+	pool.ReturnVBO(second)
+	pool.ReturnVBO(third)
+	pool.GetVBO()
+	pool.GetVBO()
+
+	// Assert
+	created, reused, discarded := pool.Stats()
+	assert.Equal(t, 3, created)
+	assert.Equal(t, 2, reused)
+	assert.Equal(t, 0, discarded)
+	backend.AssertExpectations(t)
+}
+
+func TestBufferPool_Stats_DiscardWhenFull(t *testing.T) {
+	// Arrange
+	backend := NewMockOpenGLBackend()
+	backend.On("GenVertexArray").Return(uint32(10)).Once()
+	backend.On("GenVertexArray").Return(uint32(11)).Once()
+	backend.On("DeleteVertexArray", uint32(11)).Return()
+	pool := NewBufferPoolWithBackend(backend, 1)
+
+	// Act
+	first := pool.GetVAO()
+	second := pool.GetVAO()
+	pool.ReturnVAO(first)
+	pool.ReturnVAO(second)
+
+	// Assert
+	created, reused, discarded := pool.Stats()
+	assert.Equal(t, 2, created)
+	assert.Equal(t, 0, reused)
+	assert.Equal(t, 1, discarded)
+	backend.AssertExpectations(t)
+}
+
+func TestBufferPool_Resize(t *testing.T) {
+	// Arrange
+	backend := NewMockOpenGLBackend()
+	backend.On("DeleteBuffer", uint32(3)).Return()
+	pool := NewBufferPoolWithBackend(backend, 3)
+	pool.ReturnEBO(1)
+	pool.ReturnEBO(2)
+	pool.ReturnEBO(3)
+
+	// Act
+	pool.Resize(2)
+
+	// Assert
+	assert.Equal(t, 2, pool.MaxSize())
+	_, _, discarded := pool.Stats()
+	assert.Equal(t, 1, discarded, "buffers that no longer fit are deleted")
+	assert.Equal(t, uint32(1), pool.GetEBO())
+	assert.Equal(t, uint32(2), pool.GetEBO())
+	backend.AssertExpectations(t)
+
+	// After growing, the pool keeps more buffers than before
+	pool.Resize(4)
+	for id := uint32(20); id < 24; id++ {
+		pool.ReturnVBO(id)
+	}
+	_, _, discarded = pool.Stats()
+	assert.Equal(t, 1, discarded)
+}
@@ -0,0 +1,129 @@
+package renderer
+
+import (
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+	"github.com/ganyariya/tinyengine/pkg/tinyengine"
+)
+
+// canvasStyle は Save/Restore で保存される描画スタイル
+type canvasStyle struct {
+	fill   Color
+	stroke Color
+}
+
+// Canvas はレンダラーを包む2Dキャンバス風の状態付き描画コンテキスト
+// 塗り色・線色と変換スタックを保持し、図形は現在の変換を適用して描画される
+type Canvas struct {
+	renderer   tinyengine.Renderer
+	style      canvasStyle
+	savedStyle []canvasStyle
+	transforms *mathlib.MatrixStack
+}
+
+// NewCanvas は新しいCanvasを作成する（塗り・線ともに白）
+func NewCanvas(renderer tinyengine.Renderer) *Canvas {
+	white := NewColorRGB(1.0, 1.0, 1.0)
+	return &Canvas{
+		renderer:   renderer,
+		style:      canvasStyle{fill: white, stroke: white},
+		savedStyle: make([]canvasStyle, 0),
+		transforms: mathlib.NewMatrixStack(),
+	}
+}
+
+// SetFill は塗り色を設定する（Rect, Circle で使用）
+func (c *Canvas) SetFill(color Color) {
+	c.style.fill = color
+}
+
+// SetStroke は線色を設定する（Line で使用）
+func (c *Canvas) SetStroke(color Color) {
+	c.style.stroke = color
+}
+
+// GetFill は塗り色を取得する
+func (c *Canvas) GetFill() Color {
+	return c.style.fill
+}
+
+// GetStroke は線色を取得する
+func (c *Canvas) GetStroke() Color {
+	return c.style.stroke
+}
+
+// Save は現在の変換とスタイルを保存する
+func (c *Canvas) Save() {
+	c.transforms.Push()
+	c.savedStyle = append(c.savedStyle, c.style)
+}
+
+// Restore は最後に保存した変換とスタイルを復元する
+// 保存されていない場合は何もせず false を返す
+func (c *Canvas) Restore() bool {
+	if !c.transforms.Pop() {
+		return false
+	}
+	last := len(c.savedStyle) - 1
+	c.style = c.savedStyle[last]
+	c.savedStyle = c.savedStyle[:last]
+	return true
+}
+
+// Translate は以降の描画を平行移動する
+func (c *Canvas) Translate(dx, dy float64) {
+	c.transforms.Translate(dx, dy)
+}
+
+// Rotate は以降の描画を回転する（ラジアン）
+func (c *Canvas) Rotate(angle float64) {
+	c.transforms.Rotate(angle)
+}
+
+// Scale は以降の描画を拡大縮小する
+func (c *Canvas) Scale(sx, sy float64) {
+	c.transforms.Scale(sx, sy)
+}
+
+// GetTransform は現在の変換行列を取得する
+func (c *Canvas) GetTransform() mathlib.Matrix3x3 {
+	return c.transforms.Top()
+}
+
+// Rect は塗り色で矩形を描画する
+func (c *Canvas) Rect(x, y, width, height float32) {
+	c.draw(NewRectangle(x, y, width, height, c.style.fill))
+}
+
+// Circle は塗り色で円を描画する
+func (c *Canvas) Circle(x, y, radius float32) {
+	c.draw(NewCircle(x, y, radius, c.style.fill))
+}
+
+// Line は線色で線を描画する
+func (c *Canvas) Line(x1, y1, x2, y2 float32) {
+	c.draw(NewLine(x1, y1, x2, y2, c.style.stroke))
+}
+
+// draw は現在の変換を適用してプリミティブを描画する
+func (c *Canvas) draw(primitive Primitive) {
+	transform := c.transforms.Top()
+	if transform.IsIdentity() {
+		c.renderer.DrawPrimitive(primitive)
+		return
+	}
+	c.renderer.DrawPrimitive(&transformedPrimitive{
+		Primitive: primitive,
+		vertices:  transform.TransformVertices(primitive.GetVertices()),
+	})
+}
+
+// transformedPrimitive は変換済みの頂点データを返すプリミティブのラッパー
+type transformedPrimitive struct {
+	Primitive
+	vertices []float32
+}
+
+// GetVertices は変換済みの頂点データを取得する
+func (t *transformedPrimitive) GetVertices() []float32 {
+	return t.vertices
+}
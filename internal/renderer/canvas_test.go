@@ -0,0 +1,77 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// recordDrawnPrimitives はMockRendererに描画されたプリミティブを記録する
+func recordDrawnPrimitives(mockRenderer *MockRenderer) *[]Primitive {
+	drawn := make([]Primitive, 0)
+	mockRenderer.On("DrawPrimitive", mock.Anything).Run(func(args mock.Arguments) {
+		drawn = append(drawn, args.Get(0).(Primitive))
+	}).Return()
+	return &drawn
+}
+
+func TestCanvas_TranslateThenRect(t *testing.T) {
+	// Arrange
+	mockRenderer := new(MockRenderer)
+	drawn := recordDrawnPrimitives(mockRenderer)
+	canvas := NewCanvas(mockRenderer)
+	fill := NewColorRGB(1, 0, 0)
+	canvas.SetFill(fill)
+
+	// Act
+	canvas.Translate(100, 50)
+	canvas.Rect(0, 0, 10, 20)
+
+	// Assert
+	require.Len(t, *drawn, 1)
+	primitive := (*drawn)[0]
+	assert.Equal(t, fill, primitive.GetColor())
+	assert.Equal(t, []float32{
+		100, 70, 0,
+		110, 70, 0,
+		110, 50, 0,
+		100, 50, 0,
+	}, primitive.GetVertices())
+}
+
+func TestCanvas_SaveRestore(t *testing.T) {
+	// Arrange
+	mockRenderer := new(MockRenderer)
+	drawn := recordDrawnPrimitives(mockRenderer)
+	canvas := NewCanvas(mockRenderer)
+	canvas.Translate(5, 5)
+
+	// Act
+	canvas.Save()
+	canvas.Translate(100, 0)
+	canvas.SetStroke(NewColorRGB(0, 1, 0))
+	canvas.Line(0, 0, 1, 0)
+	assert.True(t, canvas.Restore())
+	canvas.Line(0, 0, 1, 0)
+
+	// Assert
+	require.Len(t, *drawn, 2)
+	assert.Equal(t, []float32{105, 5, 0, 106, 5, 0}, (*drawn)[0].GetVertices())
+	assert.Equal(t, []float32{5, 5, 0, 6, 5, 0}, (*drawn)[1].GetVertices())
+	assert.Equal(t, NewColorRGB(1, 1, 1), (*drawn)[1].GetColor(), "線色も復元される")
+	assert.False(t, canvas.Restore(), "保存がない場合は復元しない")
+}
+
+func TestCanvas_IdentityDrawsOriginalPrimitive(t *testing.T) {
+	mockRenderer := new(MockRenderer)
+	drawn := recordDrawnPrimitives(mockRenderer)
+	canvas := NewCanvas(mockRenderer)
+
+	canvas.Circle(10, 10, 5)
+
+	require.Len(t, *drawn, 1)
+	_, isCircle := (*drawn)[0].(*Circle)
+	assert.True(t, isCircle)
+}
@@ -0,0 +1,215 @@
+package renderer
+
+import (
+	"github.com/go-gl/gl/v4.1-core/gl"
+)
+
+// 円バッチ描画の定数
+const (
+	CircleSDFShaderName  = "circle_sdf"
+	CircleInstanceStride = 7   // インスタンスあたりのfloat数: centerX, centerY, radius, R, G, B, A
+	CircleSDFSmoothing   = 1.0 // アンチエイリアスの幅（ピクセル）
+
+	circleQuadVertexCount = 6
+)
+
+// circleQuadVertices は全インスタンスで共有する単位四角形（2三角形）
+var circleQuadVertices = []float32{
+	-1, -1, 1, -1, 1, 1,
+	-1, -1, 1, 1, -1, 1,
+}
+
+// 円SDFシェーダーソースコード
+// 各インスタンスは1枚の四角形として描画され、フラグメントシェーダーで半径外を破棄する
+const (
+	CircleSDFVertexShaderSource = `#version 410 core
+layout (location = 0) in vec2 aCorner;
+layout (location = 1) in vec3 aCircle;   // centerX, centerY, radius
+layout (location = 2) in vec4 aColor;
+
+uniform mat4 u_transform;
+uniform float u_smoothing;
+
+out vec2 vLocal;
+out float vRadius;
+out vec4 vColor;
+
+void main()
+{
+    float extent = aCircle.z + u_smoothing;
+    vLocal = aCorner * extent;
+    vRadius = aCircle.z;
+    vColor = aColor;
+    gl_Position = u_transform * vec4(aCircle.xy + vLocal, 0.0, 1.0);
+}`
+
+	CircleSDFFragmentShaderSource = `#version 410 core
+in vec2 vLocal;
+in float vRadius;
+in vec4 vColor;
+
+uniform float u_smoothing;
+
+out vec4 FragColor;
+
+void main()
+{
+    float coverage = 1.0 - smoothstep(-u_smoothing, u_smoothing, length(vLocal) - vRadius);
+    if (coverage <= 0.0) {
+        discard;
+    }
+    FragColor = vec4(vColor.rgb, vColor.a * coverage);
+}`
+)
+
+// CircleInstance はバッチ内の1つの円
+type CircleInstance struct {
+	CenterX float32
+	CenterY float32
+	Radius  float32
+	Color   Color
+}
+
+// CircleBatch は大量の円を1回のインスタンス描画でまとめて描画するためのバッチ
+type CircleBatch struct {
+	instances []CircleInstance
+}
+
+// NewCircleBatch は新しいCircleBatchを作成する
+func NewCircleBatch() *CircleBatch {
+	return &CircleBatch{
+		instances: make([]CircleInstance, 0),
+	}
+}
+
+// Add は円をバッチに追加する（半径が0以下の円は無視する）
+func (b *CircleBatch) Add(centerX, centerY, radius float32, color Color) {
+	if radius <= 0 {
+		return
+	}
+	b.instances = append(b.instances, CircleInstance{
+		CenterX: centerX,
+		CenterY: centerY,
+		Radius:  radius,
+		Color:   color,
+	})
+}
+
+// Len はバッチ内の円の数を取得する
+func (b *CircleBatch) Len() int {
+	return len(b.instances)
+}
+
+// Clear はバッチを空にする（確保済みの容量は再利用される）
+func (b *CircleBatch) Clear() {
+	b.instances = b.instances[:0]
+}
+
+// InstanceData はインスタンス属性バッファに転送するデータを作成する
+// 各インスタンスは CircleInstanceStride 個のfloatで詰められる
+func (b *CircleBatch) InstanceData() []float32 {
+	data := make([]float32, 0, len(b.instances)*CircleInstanceStride)
+	for _, c := range b.instances {
+		data = append(data, c.CenterX, c.CenterY, c.Radius, c.Color.R, c.Color.G, c.Color.B, c.Color.A)
+	}
+	return data
+}
+
+// CircleCoverage は中心からの距離における円の被覆率（0〜1）を計算する
+// フラグメントシェーダーと同じ計算で、縁ではちょうど0.5になる
+func CircleCoverage(distance, radius, smoothing float32) float32 {
+	return 1.0 - smoothstep(-smoothing, smoothing, distance-radius)
+}
+
+// smoothstep はGLSLのsmoothstepと同じエルミート補間を行う
+func smoothstep(edge0, edge1, x float32) float32 {
+	if edge0 == edge1 {
+		if x < edge0 {
+			return 0
+		}
+		return 1
+	}
+
+	t := (x - edge0) / (edge1 - edge0)
+	if t < 0 {
+		t = 0
+	} else if t > 1 {
+		t = 1
+	}
+	return t * t * (3 - 2*t)
+}
+
+// DrawCircleBatch はバッチ内の全ての円をインスタンス描画する
+func (r *OpenGLRenderer) DrawCircleBatch(batch *CircleBatch) {
+	if r.shaderManager == nil || batch == nil || batch.Len() == 0 {
+		return
+	}
+
+	shader := r.shaderManager.GetShader(CircleSDFShaderName)
+	if shader == nil {
+		return
+	}
+	r.flushState()
+
+	previousShader := r.shaderManager.GetCurrentShader()
+	defer func() {
+		if previousShader != "" {
+			r.shaderManager.UseShader(previousShader)
+		}
+	}()
+
+	instanceData := batch.InstanceData()
+
+	vao := r.bufferPool.GetVAO()
+	quadVBO := r.bufferPool.GetVBO()
+	instanceVBO := r.bufferPool.GetVBO()
+
+	defer func() {
+		gl.VertexAttribDivisor(1, 0)
+		gl.VertexAttribDivisor(2, 0)
+		gl.BindVertexArray(0)
+		r.bufferPool.ReturnVAO(vao)
+		r.bufferPool.ReturnVBO(quadVBO)
+		r.bufferPool.ReturnVBO(instanceVBO)
+	}()
+
+	gl.BindVertexArray(vao)
+
+	// 共有の四角形頂点
+	gl.BindBuffer(gl.ARRAY_BUFFER, quadVBO)
+	r.bufferPool.UploadFloat32(gl.ARRAY_BUFFER, quadVBO, circleQuadVertices)
+	gl.VertexAttribPointer(0, 2, gl.FLOAT, false, 2*FloatSizeBytes, gl.PtrOffset(0))
+	gl.EnableVertexAttribArray(0)
+
+	// インスタンスごとの属性（中心・半径・色）
+	stride := int32(CircleInstanceStride * FloatSizeBytes)
+	gl.BindBuffer(gl.ARRAY_BUFFER, instanceVBO)
+	r.bufferPool.UploadFloat32(gl.ARRAY_BUFFER, instanceVBO, instanceData)
+	gl.VertexAttribPointer(1, 3, gl.FLOAT, false, stride, gl.PtrOffset(0))
+	gl.EnableVertexAttribArray(1)
+	gl.VertexAttribDivisor(1, 1)
+	gl.VertexAttribPointer(2, 4, gl.FLOAT, false, stride, gl.PtrOffset(3*FloatSizeBytes))
+	gl.EnableVertexAttribArray(2)
+	gl.VertexAttribDivisor(2, 1)
+
+	r.shaderManager.UseShader(CircleSDFShaderName)
+
+	r.applyViewport()
+	fbWidth, fbHeight := r.surfaceSize()
+	transformMatrix := r.projectionMatrix(fbWidth, fbHeight)
+	if loc := shader.GetUniformLocation("u_transform"); loc != -1 {
+		gl.UniformMatrix4fv(loc, 1, false, &transformMatrix[0])
+	}
+	if loc := shader.GetUniformLocation("u_smoothing"); loc != -1 {
+		gl.Uniform1f(loc, CircleSDFSmoothing)
+	}
+
+	// アンチエイリアスの縁をアルファブレンドする（元のブレンド状態は復元する）
+	if !gl.IsEnabled(gl.BLEND) {
+		gl.Enable(gl.BLEND)
+		defer gl.Disable(gl.BLEND)
+	}
+	gl.BlendFunc(gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA)
+	defer r.applyBlendState()
+	gl.DrawArraysInstanced(gl.TRIANGLES, 0, circleQuadVertexCount, int32(batch.Len()))
+}
@@ -0,0 +1,74 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircleBatch_InstanceData(t *testing.T) {
+	// Arrange
+	batch := NewCircleBatch()
+	batch.Add(10, 20, 5, Color{R: 1, G: 0, B: 0, A: 1})
+	batch.Add(30, 40, 2.5, Color{R: 0, G: 0.5, B: 1, A: 0.25})
+
+	// Act
+	data := batch.InstanceData()
+
+	// Assert
+	assert.Len(t, data, 2*CircleInstanceStride)
+	assert.Equal(t, []float32{10, 20, 5, 1, 0, 0, 1}, data[:CircleInstanceStride])
+	assert.Equal(t, []float32{30, 40, 2.5, 0, 0.5, 1, 0.25}, data[CircleInstanceStride:])
+}
+
+func TestCircleBatch_AddIgnoresNonPositiveRadius(t *testing.T) {
+	batch := NewCircleBatch()
+
+	batch.Add(0, 0, 0, NewColorRGB(1, 1, 1))
+	batch.Add(0, 0, -1, NewColorRGB(1, 1, 1))
+
+	assert.Equal(t, 0, batch.Len())
+}
+
+func TestCircleBatch_Clear(t *testing.T) {
+	batch := NewCircleBatch()
+	batch.Add(0, 0, 1, NewColorRGB(1, 1, 1))
+
+	batch.Clear()
+
+	assert.Equal(t, 0, batch.Len())
+	assert.Empty(t, batch.InstanceData())
+}
+
+func TestCircleCoverage(t *testing.T) {
+	tests := []struct {
+		name     string
+		distance float32
+		expected float32
+	}{
+		{"中心", 0, 1},
+		{"縁の内側（幅の外）", 8.5, 1},
+		{"縁", 10, 0.5},
+		{"外側", 12, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, CircleCoverage(tt.distance, 10, CircleSDFSmoothing), 1e-6)
+		})
+	}
+}
+
+func TestCircleCoverage_Monotonic(t *testing.T) {
+	previous := CircleCoverage(9, 10, CircleSDFSmoothing)
+	for d := float32(9.25); d <= 11; d += 0.25 {
+		current := CircleCoverage(d, 10, CircleSDFSmoothing)
+		assert.LessOrEqual(t, current, previous)
+		previous = current
+	}
+}
+
+func TestSmoothstep_ZeroWidth(t *testing.T) {
+	assert.Equal(t, float32(0), smoothstep(1, 1, 0.5))
+	assert.Equal(t, float32(1), smoothstep(1, 1, 1))
+}
@@ -0,0 +1,97 @@
+package renderer
+
+import (
+	"math"
+
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+	"github.com/go-gl/gl/v4.1-core/gl"
+)
+
+// clipStack は入れ子になったクリップ矩形を管理する
+// 各要素は親のクリップ矩形との共通部分で、先頭が現在有効なクリップ矩形になる
+type clipStack struct {
+	rects []mathlib.Rect
+}
+
+// push は親のクリップ矩形との共通部分を積む
+// 共通部分がない場合は大きさ0の矩形を積み、何も描画されないようにする
+func (s *clipStack) push(rect mathlib.Rect) mathlib.Rect {
+	clip := rect
+	if top, ok := s.current(); ok {
+		intersection, overlaps := top.Intersection(rect)
+		if !overlaps {
+			intersection = mathlib.Rect{Min: top.Min, Max: top.Min}
+		}
+		clip = intersection
+	}
+	s.rects = append(s.rects, clip)
+	return clip
+}
+
+// pop は先頭のクリップ矩形を取り除く（空の場合は何もしない）
+func (s *clipStack) pop() {
+	if len(s.rects) > 0 {
+		s.rects = s.rects[:len(s.rects)-1]
+	}
+}
+
+// current は現在有効なクリップ矩形を取得する
+func (s *clipStack) current() (mathlib.Rect, bool) {
+	if len(s.rects) == 0 {
+		return mathlib.Rect{}, false
+	}
+	return s.rects[len(s.rects)-1], true
+}
+
+// depth は積まれているクリップ矩形の数を取得する
+func (s *clipStack) depth() int {
+	return len(s.rects)
+}
+
+// scissorBox は左上原点のピクセル矩形を gl.Scissor の左下原点の x, y, 幅, 高さに変換する
+func scissorBox(rect mathlib.Rect, surfaceHeight int) (int32, int32, int32, int32) {
+	x := int32(math.Floor(rect.Min.X))
+	width := int32(math.Ceil(rect.Max.X)) - x
+	top := int32(math.Floor(rect.Min.Y))
+	height := int32(math.Ceil(rect.Max.Y)) - top
+	y := int32(surfaceHeight) - top - height
+	return x, y, width, height
+}
+
+// PushClipRect は以降の描画を矩形内に制限する
+// 矩形は描画先の左上原点のピクセル座標で指定し、入れ子にした場合は親の矩形との共通部分に制限される
+func (r *OpenGLRenderer) PushClipRect(rect mathlib.Rect) {
+	r.flushState()
+	r.clips.push(rect)
+	r.applyClip()
+}
+
+// PopClipRect は最後に追加したクリップ矩形を取り除き、親の矩形（なければ制限なし）に戻す
+func (r *OpenGLRenderer) PopClipRect() {
+	r.flushState()
+	r.clips.pop()
+	r.applyClip()
+}
+
+// GetClipRect は現在有効なクリップ矩形を取得する（制限がない場合は false）
+func (r *OpenGLRenderer) GetClipRect() (mathlib.Rect, bool) {
+	return r.clips.current()
+}
+
+// applyClip は現在のクリップ矩形を GL のシザーテストに反映する
+// OpenGLコンテキストを持たない場合（ウィンドウなし）は何もしない
+func (r *OpenGLRenderer) applyClip() {
+	if r.window == nil {
+		return
+	}
+
+	clip, ok := r.clips.current()
+	if !ok {
+		gl.Disable(gl.SCISSOR_TEST)
+		return
+	}
+
+	_, surfaceHeight := r.surfaceSize()
+	gl.Enable(gl.SCISSOR_TEST)
+	gl.Scissor(scissorBox(clip, surfaceHeight))
+}
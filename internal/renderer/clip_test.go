@@ -0,0 +1,103 @@
+package renderer
+
+import (
+	"testing"
+
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClipStack_NestedIntersection(t *testing.T) {
+	// Arrange
+	var stack clipStack
+
+	// Act
+	parent := stack.push(mathlib.NewRect(0, 0, 100, 100))
+	child := stack.push(mathlib.NewRect(50, 20, 100, 30))
+
+	// Assert
+	assert.Equal(t, mathlib.NewRect(0, 0, 100, 100), parent)
+	// 子は親からはみ出した部分が切り取られる
+	assert.Equal(t, mathlib.NewRect(50, 20, 50, 30), child)
+	current, ok := stack.current()
+	assert.True(t, ok)
+	assert.Equal(t, child, current)
+	assert.Equal(t, 2, stack.depth())
+}
+
+func TestClipStack_PopRestoresParent(t *testing.T) {
+	// Arrange
+	var stack clipStack
+	parent := stack.push(mathlib.NewRect(10, 10, 80, 80))
+	stack.push(mathlib.NewRect(20, 20, 10, 10))
+
+	// Act
+	stack.pop()
+	current, ok := stack.current()
+	stack.pop()
+	_, okAfterAllPopped := stack.current()
+	stack.pop() // 空でもパニックしない
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, parent, current)
+	assert.False(t, okAfterAllPopped)
+	assert.Equal(t, 0, stack.depth())
+}
+
+func TestClipStack_DisjointChildClipsEverything(t *testing.T) {
+	// Arrange
+	var stack clipStack
+	stack.push(mathlib.NewRect(0, 0, 50, 50))
+
+	// Act
+	child := stack.push(mathlib.NewRect(100, 100, 20, 20))
+
+	// Assert
+	assert.Equal(t, 0.0, child.Width())
+	assert.Equal(t, 0.0, child.Height())
+}
+
+func TestScissorBox_FlipsToBottomLeftOrigin(t *testing.T) {
+	// Act
+	// 高さ600の描画先で、上から100pxの位置にある高さ50の矩形
+	x, y, width, height := scissorBox(mathlib.NewRect(10, 100, 200, 50), 600)
+
+	// Assert
+	assert.Equal(t, int32(10), x)
+	assert.Equal(t, int32(450), y, "下端からの距離 600 - (100 + 50)")
+	assert.Equal(t, int32(200), width)
+	assert.Equal(t, int32(50), height)
+}
+
+func TestScissorBox_RoundsOutward(t *testing.T) {
+	// Act
+	x, y, width, height := scissorBox(mathlib.NewRect(10.5, 20.5, 9, 9), 100)
+
+	// Assert
+	// 部分的に覆われたピクセルも含める
+	assert.Equal(t, int32(10), x)
+	assert.Equal(t, int32(10), width)
+	assert.Equal(t, int32(10), height)
+	assert.Equal(t, int32(70), y)
+}
+
+func TestOpenGLRenderer_ClipRect(t *testing.T) {
+	// Arrange
+	renderer := &OpenGLRenderer{width: 800, height: 600}
+
+	// Act & Assert
+	_, ok := renderer.GetClipRect()
+	assert.False(t, ok)
+
+	renderer.PushClipRect(mathlib.NewRect(0, 0, 400, 300))
+	renderer.PushClipRect(mathlib.NewRect(200, 100, 400, 400))
+	clip, ok := renderer.GetClipRect()
+	assert.True(t, ok)
+	assert.Equal(t, mathlib.NewRect(200, 100, 200, 200), clip)
+
+	renderer.PopClipRect()
+	renderer.PopClipRect()
+	_, ok = renderer.GetClipRect()
+	assert.False(t, ok)
+}
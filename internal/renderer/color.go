@@ -0,0 +1,91 @@
+package renderer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseHexColor は "#RRGGBB" または "#RRGGBBAA" 形式の文字列からColorを作成する
+// 先頭の "#" は省略でき、アルファ値を省略した場合は1.0になる
+func ParseHexColor(hex string) (Color, error) {
+	digits := strings.TrimPrefix(hex, "#")
+	if len(digits) != 6 && len(digits) != 8 {
+		return Color{}, fmt.Errorf("invalid hex color %q: expected #RRGGBB or #RRGGBBAA", hex)
+	}
+
+	value, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil {
+		return Color{}, fmt.Errorf("invalid hex color %q: %w", hex, err)
+	}
+	if len(digits) == 6 {
+		value = value<<8 | 0xFF
+	}
+
+	return Color{
+		R: float32((value>>24)&0xFF) / 255.0,
+		G: float32((value>>16)&0xFF) / 255.0,
+		B: float32((value>>8)&0xFF) / 255.0,
+		A: float32(value&0xFF) / 255.0,
+	}, nil
+}
+
+// Hex は色を "#RRGGBBAA" 形式の文字列に変換する
+func (c Color) Hex() string {
+	return fmt.Sprintf("#%02X%02X%02X%02X", colorByte(c.R), colorByte(c.G), colorByte(c.B), colorByte(c.A))
+}
+
+// colorByte は0〜1の成分を0〜255に変換する（範囲外はクランプする）
+func colorByte(component float32) uint8 {
+	if component <= 0 {
+		return 0
+	}
+	if component >= 1 {
+		return 255
+	}
+	return uint8(math.Round(float64(component) * 255.0))
+}
+
+// colorObject はJSONのオブジェクト形式の色
+type colorObject struct {
+	R float32  `json:"r"`
+	G float32  `json:"g"`
+	B float32  `json:"b"`
+	A *float32 `json:"a"` // 省略時は1.0
+}
+
+// MarshalJSON は色を "#RRGGBBAA" 形式の文字列として出力する
+func (c Color) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Hex())
+}
+
+// UnmarshalJSON は16進文字列形式とオブジェクト形式（{"r":..,"g":..,"b":..,"a":..}）の両方を読み込む
+func (c *Color) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '"' {
+		var hex string
+		if err := json.Unmarshal(trimmed, &hex); err != nil {
+			return err
+		}
+		parsed, err := ParseHexColor(hex)
+		if err != nil {
+			return err
+		}
+		*c = parsed
+		return nil
+	}
+
+	var object colorObject
+	if err := json.Unmarshal(trimmed, &object); err != nil {
+		return fmt.Errorf("invalid color JSON: %w", err)
+	}
+	alpha := float32(DefaultAlpha)
+	if object.A != nil {
+		alpha = *object.A
+	}
+	*c = NewColor(object.R, object.G, object.B, alpha)
+	return nil
+}
@@ -0,0 +1,87 @@
+package renderer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHexColor(t *testing.T) {
+	color, err := ParseHexColor("#FF800040")
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0, color.R, 1e-6)
+	assert.InDelta(t, 128.0/255.0, color.G, 1e-6)
+	assert.InDelta(t, 0.0, color.B, 1e-6)
+	assert.InDelta(t, 64.0/255.0, color.A, 1e-6)
+
+	// アルファ省略・"#"省略
+	opaque, err := ParseHexColor("00ff00")
+	require.NoError(t, err)
+	assert.Equal(t, NewColor(0, 1, 0, 1), opaque)
+}
+
+func TestParseHexColor_Invalid(t *testing.T) {
+	for _, input := range []string{"", "#FFF", "#GG0000", "#FF00000", "#FF0000FF00"} {
+		_, err := ParseHexColor(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestColor_MarshalJSON_EmitsHex(t *testing.T) {
+	data, err := json.Marshal(NewColor(1, 0, 0.5, 1))
+
+	require.NoError(t, err)
+	assert.Equal(t, `"#FF0080FF"`, string(data))
+}
+
+func TestColor_UnmarshalJSON_HexRoundTrip(t *testing.T) {
+	var color Color
+	require.NoError(t, json.Unmarshal([]byte(`"#336699CC"`), &color))
+
+	data, err := json.Marshal(color)
+	require.NoError(t, err)
+	assert.Equal(t, `"#336699CC"`, string(data))
+}
+
+func TestColor_UnmarshalJSON_ObjectRoundTrip(t *testing.T) {
+	// Arrange
+	var color Color
+
+	// Act
+	err := json.Unmarshal([]byte(`{"r": 0.2, "g": 0.4, "b": 0.6, "a": 0.8}`), &color)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, NewColor(0.2, 0.4, 0.6, 0.8), color)
+
+	var decoded Color
+	data, err := json.Marshal(color)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, color.Hex(), decoded.Hex())
+}
+
+func TestColor_UnmarshalJSON_ObjectDefaultsAlpha(t *testing.T) {
+	var color Color
+	require.NoError(t, json.Unmarshal([]byte(`{"r": 1, "g": 1, "b": 1}`), &color))
+
+	assert.Equal(t, float32(1.0), color.A)
+}
+
+func TestColor_UnmarshalJSON_InSceneStruct(t *testing.T) {
+	var config struct {
+		Background Color `json:"background"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(`{"background": "#000000"}`), &config))
+	assert.Equal(t, NewColor(0, 0, 0, 1), config.Background)
+}
+
+func TestColor_UnmarshalJSON_Invalid(t *testing.T) {
+	for _, input := range []string{`"#XYZ"`, `{"r": "red"}`, `[1, 0, 0]`, `{"r": 1`, `42`} {
+		var color Color
+		assert.Error(t, json.Unmarshal([]byte(input), &color), input)
+	}
+}
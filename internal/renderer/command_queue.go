@@ -1,6 +1,8 @@
 package renderer
 
 import (
+	"sort"
+
 	"github.com/ganyariya/tinyengine/pkg/tinyengine"
 )
 
@@ -12,9 +14,23 @@ const (
 	ClearCommand CommandType = iota
 	// RectangleCommand は矩形描画コマンド
 	RectangleCommand
+	// RectangleColorCommand は色付き矩形描画コマンド
+	RectangleColorCommand
+	// CircleCommand は円描画コマンド
+	CircleCommand
+	// LineCommand は線描画コマンド
+	LineCommand
+	// PrimitiveCommand は任意のプリミティブ描画コマンド
+	PrimitiveCommand
 )
 
 // RenderCommand は描画コマンドを表す
+// Params はコマンドの種類ごとに次のキーを持つ（色は "color" に Color として格納する）
+//   - RectangleCommand: x, y, width, height
+//   - RectangleColorCommand: x, y, width, height, color
+//   - CircleCommand: x, y, radius, color
+//   - LineCommand: x1, y1, x2, y2, color
+//   - PrimitiveCommand: primitive
 type RenderCommand struct {
 	Type   CommandType
 	Params map[string]interface{}
@@ -55,6 +71,61 @@ func (q *CommandQueue) AddRectangleCommand(x, y, width, height float32) {
 	q.commands = append(q.commands, command)
 }
 
+// AddRectangleColorCommand は色付き矩形描画コマンドを追加する
+func (q *CommandQueue) AddRectangleColorCommand(x, y, width, height float32, color Color) {
+	command := RenderCommand{
+		Type: RectangleColorCommand,
+		Params: map[string]interface{}{
+			"x":      x,
+			"y":      y,
+			"width":  width,
+			"height": height,
+			"color":  color,
+		},
+	}
+	q.commands = append(q.commands, command)
+}
+
+// AddCircleCommand は円描画コマンドを追加する
+func (q *CommandQueue) AddCircleCommand(x, y, radius float32, color Color) {
+	command := RenderCommand{
+		Type: CircleCommand,
+		Params: map[string]interface{}{
+			"x":      x,
+			"y":      y,
+			"radius": radius,
+			"color":  color,
+		},
+	}
+	q.commands = append(q.commands, command)
+}
+
+// AddLineCommand は線描画コマンドを追加する
+func (q *CommandQueue) AddLineCommand(x1, y1, x2, y2 float32, color Color) {
+	command := RenderCommand{
+		Type: LineCommand,
+		Params: map[string]interface{}{
+			"x1":    x1,
+			"y1":    y1,
+			"x2":    x2,
+			"y2":    y2,
+			"color": color,
+		},
+	}
+	q.commands = append(q.commands, command)
+}
+
+// AddPrimitiveCommand はプリミティブ描画コマンドを追加する
+func (q *CommandQueue) AddPrimitiveCommand(primitive Primitive) {
+	command := RenderCommand{
+		Type: PrimitiveCommand,
+		Params: map[string]interface{}{
+			"primitive": primitive,
+		},
+	}
+	q.commands = append(q.commands, command)
+}
+
 // Execute はキューに蓄積されたコマンドを実行する
 func (q *CommandQueue) Execute(renderer tinyengine.Renderer) {
 	for _, command := range q.commands {
@@ -67,10 +138,41 @@ func (q *CommandQueue) Execute(renderer tinyengine.Renderer) {
 			width := command.Params["width"].(float32)
 			height := command.Params["height"].(float32)
 			renderer.DrawRectangle(x, y, width, height)
+		case RectangleColorCommand:
+			x := command.Params["x"].(float32)
+			y := command.Params["y"].(float32)
+			width := command.Params["width"].(float32)
+			height := command.Params["height"].(float32)
+			color := command.Params["color"].(Color)
+			renderer.DrawRectangleColor(x, y, width, height, color.R, color.G, color.B, color.A)
+		case CircleCommand:
+			x := command.Params["x"].(float32)
+			y := command.Params["y"].(float32)
+			radius := command.Params["radius"].(float32)
+			color := command.Params["color"].(Color)
+			renderer.DrawCircle(x, y, radius, color.R, color.G, color.B, color.A)
+		case LineCommand:
+			x1 := command.Params["x1"].(float32)
+			y1 := command.Params["y1"].(float32)
+			x2 := command.Params["x2"].(float32)
+			y2 := command.Params["y2"].(float32)
+			color := command.Params["color"].(Color)
+			renderer.DrawLine(x1, y1, x2, y2, color.R, color.G, color.B, color.A)
+		case PrimitiveCommand:
+			renderer.DrawPrimitive(command.Params["primitive"])
 		}
 	}
 }
 
+// SortByType は同じ種類のコマンドが隣り合うようにキューを並べ替える
+// シェーダーやバッファの切り替えを減らすために使う。ClearCommand は常に先頭に来て、
+// 同じ種類のコマンド同士の実行順序は保たれる（安定ソート）
+func (q *CommandQueue) SortByType() {
+	sort.SliceStable(q.commands, func(i, j int) bool {
+		return q.commands[i].Type < q.commands[j].Type
+	})
+}
+
 // Clear はキューをクリアする
 func (q *CommandQueue) Clear() {
 	q.commands = q.commands[:0]
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNewCommandQueue(t *testing.T) {
@@ -58,6 +59,111 @@ func TestCommandQueue_Execute(t *testing.T) {
 	mockRenderer.AssertExpectations(t)
 }
 
+func TestCommandQueue_Execute_RectangleColor(t *testing.T) {
+	// Arrange
+	queue := NewCommandQueue()
+	mockRenderer := new(MockRenderer)
+	mockRenderer.On("DrawRectangleColor", float32(10), float32(20), float32(100), float32(50),
+		float32(1), float32(0.5), float32(0), float32(1)).Return()
+	queue.AddRectangleColorCommand(10, 20, 100, 50, NewColor(1, 0.5, 0, 1))
+
+	// Act
+	queue.Execute(mockRenderer)
+
+	// Assert
+	mockRenderer.AssertExpectations(t)
+}
+
+func TestCommandQueue_Execute_Circle(t *testing.T) {
+	// Arrange
+	queue := NewCommandQueue()
+	mockRenderer := new(MockRenderer)
+	mockRenderer.On("DrawCircle", float32(50), float32(60), float32(25),
+		float32(0), float32(1), float32(0), float32(0.5)).Return()
+	queue.AddCircleCommand(50, 60, 25, NewColor(0, 1, 0, 0.5))
+
+	// Act
+	queue.Execute(mockRenderer)
+
+	// Assert
+	mockRenderer.AssertExpectations(t)
+}
+
+func TestCommandQueue_Execute_Line(t *testing.T) {
+	// Arrange
+	queue := NewCommandQueue()
+	mockRenderer := new(MockRenderer)
+	mockRenderer.On("DrawLine", float32(0), float32(0), float32(100), float32(100),
+		float32(0), float32(0), float32(1), float32(1)).Return()
+	queue.AddLineCommand(0, 0, 100, 100, NewColor(0, 0, 1, 1))
+
+	// Act
+	queue.Execute(mockRenderer)
+
+	// Assert
+	mockRenderer.AssertExpectations(t)
+}
+
+func TestCommandQueue_Execute_Primitive(t *testing.T) {
+	// Arrange
+	queue := NewCommandQueue()
+	mockRenderer := new(MockRenderer)
+	triangle := NewTriangle(0, 0, 10, 0, 5, 10, NewColor(1, 1, 1, 1))
+	mockRenderer.On("DrawPrimitive", triangle).Return()
+	queue.AddPrimitiveCommand(triangle)
+
+	// Act
+	queue.Execute(mockRenderer)
+
+	// Assert
+	mockRenderer.AssertExpectations(t)
+}
+
+func TestCommandQueue_Execute_PreservesOrder(t *testing.T) {
+	// Arrange
+	queue := NewCommandQueue()
+	mockRenderer := new(MockRenderer)
+	white := NewColor(1, 1, 1, 1)
+	var calls []string
+	mockRenderer.On("Clear").Run(func(mock.Arguments) { calls = append(calls, "Clear") }).Return()
+	mockRenderer.On("DrawCircle", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) { calls = append(calls, "DrawCircle") }).Return()
+	mockRenderer.On("DrawLine", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) { calls = append(calls, "DrawLine") }).Return()
+	queue.AddClearCommand()
+	queue.AddLineCommand(0, 0, 1, 1, white)
+	queue.AddCircleCommand(0, 0, 1, white)
+
+	// Act
+	queue.Execute(mockRenderer)
+
+	// Assert
+	assert.Equal(t, []string{"Clear", "DrawLine", "DrawCircle"}, calls)
+}
+
+func TestCommandQueue_SortByType(t *testing.T) {
+	// Arrange
+	queue := NewCommandQueue()
+	white := NewColor(1, 1, 1, 1)
+	queue.AddRectangleCommand(1, 1, 10, 10)
+	queue.AddCircleCommand(5, 5, 3, white)
+	queue.AddClearCommand()
+	queue.AddRectangleCommand(2, 2, 20, 20)
+
+	// Act
+	queue.SortByType()
+
+	// Assert
+	commands := queue.GetCommands()
+	assert.Len(t, commands, 4)
+	assert.Equal(t, ClearCommand, commands[0].Type, "クリアは先頭")
+	assert.Equal(t, RectangleCommand, commands[1].Type)
+	assert.Equal(t, float32(1), commands[1].Params["x"], "同じ種類の中では元の順序を保つ")
+	assert.Equal(t, RectangleCommand, commands[2].Type)
+	assert.Equal(t, float32(2), commands[2].Params["x"])
+	assert.Equal(t, CircleCommand, commands[3].Type)
+}
+
 func TestCommandQueue_Clear(t *testing.T) {
 	// Arrange
 	queue := NewCommandQueue()
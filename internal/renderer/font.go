@@ -0,0 +1,137 @@
+package renderer
+
+import (
+	"strings"
+)
+
+// Glyph は1文字分のグリフ情報
+type Glyph struct {
+	Advance float64        // 次の文字までの水平方向の送り幅（ピクセル）
+	Height  float64        // グリフの高さ（ピクセル、0の場合はフォントの行の高さを使用）
+	Region  *TextureRegion // フォントアトラス内のグリフの画像（nilの場合は描画しない）
+}
+
+// Font はグリフの送り幅情報を保持するフォント
+// テキストの計測・折り返しはグリフ情報のみで計算し、OpenGLを必要としない
+// グリフに画像（TextureRegion）を登録すると TextSprites でテキストを描画できる
+type Font struct {
+	glyphs         map[rune]Glyph
+	lineHeight     float64
+	defaultAdvance float64
+}
+
+// NewFont は新しいFontを作成する
+// 未登録の文字の送り幅には defaultAdvance が使用される
+func NewFont(lineHeight, defaultAdvance float64) *Font {
+	return &Font{
+		glyphs:         make(map[rune]Glyph),
+		lineHeight:     lineHeight,
+		defaultAdvance: defaultAdvance,
+	}
+}
+
+// SetGlyph はグリフ情報を登録する
+func (f *Font) SetGlyph(r rune, glyph Glyph) {
+	f.glyphs[r] = glyph
+}
+
+// GetGlyph はグリフ情報を取得する（未登録の場合はデフォルト値）
+func (f *Font) GetGlyph(r rune) Glyph {
+	if glyph, exists := f.glyphs[r]; exists {
+		return glyph
+	}
+	return Glyph{Advance: f.defaultAdvance}
+}
+
+// LineHeight はフォントの行の高さを取得する
+func (f *Font) LineHeight() float64 {
+	return f.lineHeight
+}
+
+// measureLine は1行分の幅と高さを計算する
+func (f *Font) measureLine(line string) (float64, float64) {
+	width := 0.0
+	height := f.lineHeight
+	for _, r := range line {
+		glyph := f.GetGlyph(r)
+		width += glyph.Advance
+		if glyph.Height > height {
+			height = glyph.Height
+		}
+	}
+	return width, height
+}
+
+// Measure はテキストの描画サイズを計算する
+// 複数行の場合、幅は最も長い行の幅、高さは最も高い行の高さ × 行数になる
+func (f *Font) Measure(text string) (width, height float64) {
+	lines := strings.Split(text, "\n")
+	tallest := 0.0
+	for _, line := range lines {
+		lineWidth, lineHeight := f.measureLine(line)
+		if lineWidth > width {
+			width = lineWidth
+		}
+		if lineHeight > tallest {
+			tallest = lineHeight
+		}
+	}
+	return width, tallest * float64(len(lines))
+}
+
+// WrapText はテキストを指定幅に収まるように単語単位で折り返す
+// 既存の改行は保持され、1単語が指定幅を超える場合はその単語だけで1行になる
+func (f *Font) WrapText(text string, maxWidth float64) []string {
+	result := make([]string, 0)
+	spaceAdvance := f.GetGlyph(' ').Advance
+
+	for _, paragraph := range strings.Split(text, "\n") {
+		words := strings.Fields(paragraph)
+		if len(words) == 0 {
+			result = append(result, "")
+			continue
+		}
+
+		current := words[0]
+		currentWidth, _ := f.measureLine(current)
+		for _, word := range words[1:] {
+			wordWidth, _ := f.measureLine(word)
+			if currentWidth+spaceAdvance+wordWidth > maxWidth {
+				result = append(result, current)
+				current = word
+				currentWidth = wordWidth
+				continue
+			}
+			current += " " + word
+			currentWidth += spaceAdvance + wordWidth
+		}
+		result = append(result, current)
+	}
+
+	return result
+}
+
+// TextSprites はテキストを描画するスプライトを左上 (x, y) から並べて作成する
+// 改行ごとに行の高さだけ下に移動し、画像を持たないグリフ（空白など）は送り幅だけ進める
+func (f *Font) TextSprites(text string, x, y float64, color Color) []*Sprite {
+	sprites := make([]*Sprite, 0, len(text))
+	penY := y
+	for _, line := range strings.Split(text, "\n") {
+		penX := x
+		for _, r := range line {
+			glyph := f.GetGlyph(r)
+			if glyph.Region != nil {
+				height := glyph.Height
+				if height == 0 {
+					height = f.lineHeight
+				}
+				sprite := NewSpriteFromRegion(glyph.Region, float32(penX), float32(penY), float32(glyph.Advance), float32(height))
+				sprite.Color = color
+				sprites = append(sprites, sprite)
+			}
+			penX += glyph.Advance
+		}
+		penY += f.lineHeight
+	}
+	return sprites
+}
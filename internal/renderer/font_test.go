@@ -0,0 +1,106 @@
+package renderer
+
+import (
+	"testing"
+
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+	"github.com/stretchr/testify/assert"
+)
+
+// テスト用の等幅フォント（1文字10px、行の高さ16px）
+func newTestFont() *Font {
+	return NewFont(16, 10)
+}
+
+func TestFont_GetGlyph(t *testing.T) {
+	font := newTestFont()
+	font.SetGlyph('W', Glyph{Advance: 14})
+
+	assert.Equal(t, 14.0, font.GetGlyph('W').Advance)
+	// 未登録の文字はデフォルトの送り幅
+	assert.Equal(t, 10.0, font.GetGlyph('a').Advance)
+}
+
+func TestFont_Measure_SingleLine(t *testing.T) {
+	font := newTestFont()
+	font.SetGlyph('i', Glyph{Advance: 4})
+
+	width, height := font.Measure("hi!")
+
+	assert.Equal(t, 24.0, width)
+	assert.Equal(t, 16.0, height)
+}
+
+func TestFont_Measure_MultiLine(t *testing.T) {
+	font := newTestFont()
+	font.SetGlyph('g', Glyph{Advance: 10, Height: 20})
+
+	width, height := font.Measure("abc\nabcde\ng")
+
+	// 幅は最も長い行、高さは最も高い行 × 行数
+	assert.Equal(t, 50.0, width)
+	assert.Equal(t, 60.0, height)
+}
+
+func TestFont_Measure_Empty(t *testing.T) {
+	font := newTestFont()
+
+	width, height := font.Measure("")
+
+	assert.Equal(t, 0.0, width)
+	assert.Equal(t, 16.0, height)
+}
+
+func TestFont_WrapText(t *testing.T) {
+	font := newTestFont()
+
+	// "hello world" は110pxなので100pxで折り返される
+	lines := font.WrapText("hello world foo", 100)
+
+	assert.Equal(t, []string{"hello", "world foo"}, lines)
+}
+
+func TestFont_WrapText_ExactBoundary(t *testing.T) {
+	font := newTestFont()
+
+	// ちょうど幅に収まる場合は折り返さない
+	lines := font.WrapText("ab cd", 50)
+
+	assert.Equal(t, []string{"ab cd"}, lines)
+}
+
+func TestFont_WrapText_LongWordAndNewlines(t *testing.T) {
+	font := newTestFont()
+
+	lines := font.WrapText("extraordinary\n\nok", 50)
+
+	// 幅を超える単語はそのまま1行になり、空行は保持される
+	assert.Equal(t, []string{"extraordinary", "", "ok"}, lines)
+}
+
+func TestFont_TextSprites(t *testing.T) {
+	// Arrange
+	font := newTestFont()
+	atlas := &Texture{Width: 100, Height: 100}
+	regionA := NewTextureRegion(atlas, mathlib.Rect{Max: mathlib.Vector2{X: 10, Y: 16}})
+	regionB := NewTextureRegion(atlas, mathlib.Rect{Min: mathlib.Vector2{X: 10}, Max: mathlib.Vector2{X: 20, Y: 16}})
+	font.SetGlyph('A', Glyph{Advance: 10, Region: regionA})
+	font.SetGlyph('B', Glyph{Advance: 12, Height: 20, Region: regionB})
+	color := NewColorRGB(1, 0, 0)
+
+	// Act
+	// 空白は画像を持たないため送り幅だけ進む
+	sprites := font.TextSprites("A B\nA", 5, 7, color)
+
+	// Assert
+	assert.Len(t, sprites, 3)
+	assert.Equal(t, [4]float32{5, 7, 10, 16}, [4]float32{sprites[0].X, sprites[0].Y, sprites[0].Width, sprites[0].Height})
+	assert.Equal(t, [4]float32{25, 7, 12, 20}, [4]float32{sprites[1].X, sprites[1].Y, sprites[1].Width, sprites[1].Height})
+	assert.Equal(t, [2]float32{5, 23}, [2]float32{sprites[2].X, sprites[2].Y}, "改行で行の高さだけ下に移動する")
+	assert.Equal(t, regionB.U0, sprites[1].U0)
+	assert.Equal(t, color, sprites[0].Color)
+}
+
+func TestFont_TextSprites_WithoutGlyphImages(t *testing.T) {
+	assert.Empty(t, newTestFont().TextSprites("abc", 0, 0, NewColorRGB(1, 1, 1)))
+}
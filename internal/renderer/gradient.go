@@ -0,0 +1,187 @@
+package renderer
+
+import (
+	"fmt"
+
+	"github.com/go-gl/gl/v4.1-core/gl"
+)
+
+// 頂点カラー描画の定数
+const (
+	GradientShaderName   = "gradient"
+	GradientVertexStride = 7 // 頂点あたりのfloat数: x, y, z, r, g, b, a
+	VertexColorAttrib    = 1
+	VertexColorSize      = 4
+)
+
+// 頂点カラーシェーダーソースコード
+// 頂点ごとの色をフラグメント間で線形補間し、u_color を乗算する（ティント用）
+const (
+	GradientVertexShaderSource = `#version 410 core
+layout (location = 0) in vec3 aPos;
+layout (location = 1) in vec4 aColor;
+
+uniform mat4 u_transform;
+
+out vec4 vColor;
+
+void main()
+{
+    vColor = aColor;
+    gl_Position = u_transform * vec4(aPos, 1.0);
+}`
+
+	GradientFragmentShaderSource = `#version 410 core
+in vec4 vColor;
+
+uniform vec4 u_color;
+
+out vec4 FragColor;
+
+void main()
+{
+    FragColor = vColor * u_color;
+}`
+)
+
+// VertexColoredPrimitive は頂点ごとの色を持つプリミティブ
+// Primitive の任意拡張で、GetVertexColors が nil を返す場合は GetColor の単色で描画される
+type VertexColoredPrimitive interface {
+	Primitive
+
+	// GetVertexColors は GetVertices の頂点順に対応する色を取得する
+	GetVertexColors() []Color
+}
+
+// vertexColorsOf はプリミティブの頂点カラーと乗算するティントを取得する
+// 頂点カラーを持たない場合は nil を返す
+func vertexColorsOf(p Primitive) ([]Color, Color) {
+	tint := NewColor(1.0, 1.0, 1.0, 1.0)
+	if tinted, ok := p.(*tintedPrimitive); ok {
+		p = tinted.Primitive
+		tint = tinted.tint
+	}
+
+	colored, ok := p.(VertexColoredPrimitive)
+	if !ok {
+		return nil, tint
+	}
+	return colored.GetVertexColors(), tint
+}
+
+// InterleaveVertexColors は位置（x, y, z）と色（r, g, b, a）を頂点ごとに交互に並べたデータを作成する
+// 色の数が頂点数と一致しない場合はエラーを返す
+func InterleaveVertexColors(vertices []float32, colors []Color) ([]float32, error) {
+	if len(vertices)%VertexPositionSize != 0 {
+		return nil, fmt.Errorf("vertices must be packed (x, y, z) triples, got %d floats", len(vertices))
+	}
+
+	vertexCount := len(vertices) / VertexPositionSize
+	if len(colors) != vertexCount {
+		return nil, fmt.Errorf("vertex color count %d does not match vertex count %d", len(colors), vertexCount)
+	}
+
+	data := make([]float32, 0, vertexCount*GradientVertexStride)
+	for i, color := range colors {
+		position := vertices[i*VertexPositionSize : (i+1)*VertexPositionSize]
+		data = append(data, position...)
+		data = append(data, color.R, color.G, color.B, color.A)
+	}
+	return data, nil
+}
+
+// RectangleGradient は四隅の色を補間して塗る矩形プリミティブ
+type RectangleGradient struct {
+	Rectangle
+	TopLeft, TopRight       Color // 上辺の色
+	BottomRight, BottomLeft Color // 下辺の色
+}
+
+// NewRectangleGradient は四隅の色を指定して新しいグラデーション矩形を作成する
+// 単色描画にフォールバックする場合の色は四隅の平均になる
+func NewRectangleGradient(x, y, width, height float32, topLeft, topRight, bottomRight, bottomLeft Color) *RectangleGradient {
+	average := NewColor(
+		(topLeft.R+topRight.R+bottomRight.R+bottomLeft.R)/4,
+		(topLeft.G+topRight.G+bottomRight.G+bottomLeft.G)/4,
+		(topLeft.B+topRight.B+bottomRight.B+bottomLeft.B)/4,
+		(topLeft.A+topRight.A+bottomRight.A+bottomLeft.A)/4,
+	)
+
+	return &RectangleGradient{
+		Rectangle:   *NewRectangle(x, y, width, height, average),
+		TopLeft:     topLeft,
+		TopRight:    topRight,
+		BottomRight: bottomRight,
+		BottomLeft:  bottomLeft,
+	}
+}
+
+// GetVertexColors は Rectangle.GetVertices の頂点順（左下、右下、右上、左上）に対応する色を取得する
+func (r *RectangleGradient) GetVertexColors() []Color {
+	return []Color{r.BottomLeft, r.BottomRight, r.TopRight, r.TopLeft}
+}
+
+// drawVertexColored は頂点カラーシェーダーで頂点データを描画する
+// 頂点カラーシェーダーが読み込まれていない場合や色の数が合わない場合は false を返す
+func (r *OpenGLRenderer) drawVertexColored(vertices []float32, indices []uint32, colors []Color, tint Color, primitiveType PrimitiveType) bool {
+	if r.shaderManager == nil || r.bufferPool == nil {
+		return false
+	}
+
+	shader := r.shaderManager.GetShader(GradientShaderName)
+	if shader == nil {
+		return false
+	}
+
+	data, err := InterleaveVertexColors(vertices, colors)
+	if err != nil || len(data) == 0 {
+		return false
+	}
+
+	previousShader := r.shaderManager.GetCurrentShader()
+	defer func() {
+		if previousShader != "" {
+			r.shaderManager.UseShader(previousShader)
+		}
+	}()
+
+	call := drawCallFor(vertices, indices)
+
+	vao := r.bufferPool.GetVAO()
+	vbo := r.bufferPool.GetVBO()
+	defer func() {
+		gl.BindVertexArray(0)
+		r.bufferPool.ReturnVAO(vao)
+		r.bufferPool.ReturnVBO(vbo)
+	}()
+
+	gl.BindVertexArray(vao)
+	gl.BindBuffer(gl.ARRAY_BUFFER, vbo)
+	r.bufferPool.UploadFloat32(gl.ARRAY_BUFFER, vbo, data)
+
+	if call.useElements {
+		ebo := r.bufferPool.GetEBO()
+		defer r.bufferPool.ReturnEBO(ebo)
+
+		gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, ebo)
+		r.bufferPool.UploadUint32(gl.ELEMENT_ARRAY_BUFFER, ebo, indices)
+	}
+
+	stride := int32(GradientVertexStride * FloatSizeBytes)
+	gl.VertexAttribPointer(VertexPositionAttrib, VertexPositionSize, gl.FLOAT, false, stride, gl.PtrOffset(0))
+	gl.EnableVertexAttribArray(VertexPositionAttrib)
+	gl.VertexAttribPointer(VertexColorAttrib, VertexColorSize, gl.FLOAT, false, stride, gl.PtrOffset(VertexPositionSize*FloatSizeBytes))
+	gl.EnableVertexAttribArray(VertexColorAttrib)
+
+	r.shaderManager.UseShader(GradientShaderName)
+	r.applyDrawUniforms(shader, tint)
+	r.applyPolygonMode()
+
+	drawMode := drawModeFor(primitiveType)
+	if call.useElements {
+		gl.DrawElements(drawMode, call.count, gl.UNSIGNED_INT, gl.PtrOffset(0))
+	} else {
+		gl.DrawArrays(drawMode, 0, call.count)
+	}
+	return true
+}
@@ -0,0 +1,102 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ VertexColoredPrimitive = (*RectangleGradient)(nil)
+
+func TestNewRectangleGradient(t *testing.T) {
+	// Arrange
+	red := NewColorRGB(1, 0, 0)
+	green := NewColorRGB(0, 1, 0)
+	blue := NewColorRGB(0, 0, 1)
+	white := NewColorRGB(1, 1, 1)
+
+	// Act
+	rect := NewRectangleGradient(10, 20, 100, 50, red, green, blue, white)
+
+	// Assert
+	assert.Equal(t, NewRectangle(10, 20, 100, 50, rect.GetColor()).GetVertices(), rect.GetVertices())
+	assert.Equal(t, []uint32{0, 1, 2, 2, 3, 0}, rect.GetIndices())
+	assert.Equal(t, PrimitiveTypeRectangle, rect.GetType())
+	assert.Equal(t, NewColor(0.5, 0.5, 0.5, 1), rect.GetColor(), "単色フォールバックは四隅の平均")
+}
+
+func TestRectangleGradient_GetVertexColors(t *testing.T) {
+	// Arrange
+	topLeft := NewColorRGB(1, 0, 0)
+	topRight := NewColorRGB(0, 1, 0)
+	bottomRight := NewColorRGB(0, 0, 1)
+	bottomLeft := NewColorRGB(1, 1, 1)
+	rect := NewRectangleGradient(0, 0, 10, 20, topLeft, topRight, bottomRight, bottomLeft)
+
+	// Act
+	colors := rect.GetVertexColors()
+
+	// Assert
+	// Rectangle.GetVertices の順序: 左下、右下、右上、左上
+	assert.Equal(t, []Color{bottomLeft, bottomRight, topRight, topLeft}, colors)
+}
+
+func TestInterleaveVertexColors(t *testing.T) {
+	// Arrange
+	rect := NewRectangleGradient(0, 0, 10, 20,
+		NewColor(1, 0, 0, 1), NewColor(0, 1, 0, 1), NewColor(0, 0, 1, 1), NewColor(1, 1, 1, 0.5))
+
+	// Act
+	data, err := InterleaveVertexColors(rect.GetVertices(), rect.GetVertexColors())
+
+	// Assert
+	require.NoError(t, err)
+	expected := []float32{
+		0, 20, 0, 1, 1, 1, 0.5, // 左下
+		10, 20, 0, 0, 0, 1, 1, // 右下
+		10, 0, 0, 0, 1, 0, 1, // 右上
+		0, 0, 0, 1, 0, 0, 1, // 左上
+	}
+	assert.Equal(t, expected, data)
+	assert.Len(t, data, 4*GradientVertexStride)
+}
+
+func TestInterleaveVertexColors_CountMismatch(t *testing.T) {
+	// Arrange
+	vertices := []float32{0, 0, 0, 1, 1, 0}
+
+	// Act
+	_, err := InterleaveVertexColors(vertices, []Color{NewColorRGB(1, 1, 1)})
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestInterleaveVertexColors_NotTriples(t *testing.T) {
+	// Act
+	_, err := InterleaveVertexColors([]float32{0, 0}, []Color{NewColorRGB(1, 1, 1)})
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestVertexColorsOf(t *testing.T) {
+	// Arrange
+	white := NewColor(1, 1, 1, 1)
+	gradient := NewRectangleGradient(0, 0, 10, 10, white, white, white, NewColorRGB(1, 0, 0))
+	tint := NewColor(0.5, 0.5, 0.5, 1)
+
+	// Act
+	flatColors, flatTint := vertexColorsOf(NewRectangle(0, 0, 10, 10, white))
+	colors, plainTint := vertexColorsOf(gradient)
+	tintedColors, appliedTint := vertexColorsOf(newTintedPrimitive(gradient, tint))
+
+	// Assert
+	assert.Nil(t, flatColors, "頂点カラーを持たないプリミティブは単色描画")
+	assert.Equal(t, white, flatTint)
+	assert.Equal(t, gradient.GetVertexColors(), colors)
+	assert.Equal(t, white, plainTint)
+	assert.Equal(t, gradient.GetVertexColors(), tintedColors, "ティント越しでも頂点カラーを取得できる")
+	assert.Equal(t, tint, appliedTint)
+}
@@ -0,0 +1,120 @@
+package renderer
+
+import (
+	"sort"
+)
+
+// LayeredPrimitive は描画レイヤーを持つプリミティブ
+// Primitive の任意拡張で、実装しないプリミティブはレイヤー0として扱われる
+type LayeredPrimitive interface {
+	Primitive
+
+	// GetLayer は描画レイヤーを取得する（大きいほど手前に描画される）
+	GetLayer() int
+}
+
+// layeredPrimitive は既存のプリミティブに描画レイヤーを付与するラッパー
+type layeredPrimitive struct {
+	Primitive
+	layer int
+}
+
+// WithLayer はプリミティブに描画レイヤーを付与する
+// BeginScene から EndScene の間に描画すると、レイヤーの昇順に並べ替えて描画される
+func WithLayer(p Primitive, layer int) LayeredPrimitive {
+	return &layeredPrimitive{
+		Primitive: p,
+		layer:     layer,
+	}
+}
+
+// GetLayer は描画レイヤーを取得する
+func (l *layeredPrimitive) GetLayer() int {
+	return l.layer
+}
+
+// layerOf はプリミティブの描画レイヤーを取得する（レイヤーを持たない場合は0）
+func layerOf(p Primitive) int {
+	if layered, ok := p.(LayeredPrimitive); ok {
+		return layered.GetLayer()
+	}
+	return 0
+}
+
+// sceneEntry はシーンに追加されたプリミティブと描画レイヤー
+type sceneEntry struct {
+	primitive Primitive
+	layer     int
+}
+
+// sceneQueue は BeginScene から EndScene までに描画されたプリミティブを保持する
+type sceneQueue struct {
+	entries []sceneEntry
+}
+
+// add はプリミティブをシーンに追加する
+// WithLayer のラッパーは外し、描画時には元のプリミティブを使用する
+func (q *sceneQueue) add(p Primitive) {
+	entry := sceneEntry{primitive: p, layer: layerOf(p)}
+	if layered, ok := p.(*layeredPrimitive); ok {
+		entry.primitive = layered.Primitive
+	}
+	q.entries = append(q.entries, entry)
+}
+
+// sorted はレイヤーの昇順に並べたプリミティブを取得する
+// 同じレイヤーのプリミティブは追加した順序を保つ
+func (q *sceneQueue) sorted() []Primitive {
+	entries := make([]sceneEntry, len(q.entries))
+	copy(entries, q.entries)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].layer < entries[j].layer
+	})
+
+	primitives := make([]Primitive, len(entries))
+	for i, entry := range entries {
+		primitives[i] = entry.primitive
+	}
+	return primitives
+}
+
+// BeginScene はレイヤー順の描画を開始する
+// EndScene までの DrawPrimitive はすぐには描画されず、EndScene でレイヤーの昇順に描画される
+// シーン中にクリップ矩形・カメラ・ブレンド・描画先などの状態を変更すると、
+// 変更前に追加したプリミティブを変更前の状態で先に描画する（レイヤー順は状態変更の区間ごとになる）
+func (r *OpenGLRenderer) BeginScene() {
+	if r.scene != nil {
+		r.EndScene()
+	}
+	r.scene = &sceneQueue{}
+}
+
+// EndScene はシーンのプリミティブをレイヤーの昇順に描画してレイヤー順の描画を終了する
+func (r *OpenGLRenderer) EndScene() {
+	r.flushScene()
+	r.scene = nil
+}
+
+// flushScene はレイヤー順の描画中であれば、それまでに追加されたプリミティブをレイヤーの昇順に描画する
+// シーンは継続し、以降のプリミティブは再び EndScene（または次の状態変更）まで保持される
+func (r *OpenGLRenderer) flushScene() {
+	scene := r.scene
+	if scene == nil || len(scene.entries) == 0 {
+		return
+	}
+
+	primitives := scene.sorted()
+	scene.entries = scene.entries[:0]
+
+	// 描画中はシーンを外し、DrawPrimitive が再びシーンに追加しないようにする
+	r.scene = nil
+	for _, p := range primitives {
+		r.DrawPrimitive(p)
+	}
+	r.scene = scene
+}
+
+// IsInScene はレイヤー順の描画中かを判定する
+func (r *OpenGLRenderer) IsInScene() bool {
+	return r.scene != nil
+}
@@ -0,0 +1,174 @@
+package renderer
+
+import (
+	"testing"
+
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLayerOf(t *testing.T) {
+	rect := NewRectangle(0, 0, 10, 10, NewColorRGB(1, 1, 1))
+
+	assert.Equal(t, 0, layerOf(rect), "レイヤーを持たないプリミティブは0")
+	assert.Equal(t, 5, layerOf(WithLayer(rect, 5)))
+	assert.Equal(t, -2, layerOf(WithLayer(rect, -2)))
+}
+
+func TestSceneQueue_SortsByLayer(t *testing.T) {
+	// Arrange
+	color := NewColorRGB(1, 1, 1)
+	hud := NewRectangle(0, 0, 100, 20, color)
+	player := NewCircle(50, 50, 10, color)
+	background := NewRectangle(0, 0, 800, 600, color)
+	var queue sceneQueue
+
+	// Act
+	// 描画順とは逆の順序で追加する
+	queue.add(WithLayer(hud, 10))
+	queue.add(player)
+	queue.add(WithLayer(background, -1))
+	sorted := queue.sorted()
+
+	// Assert
+	// ラッパーは外れて元のプリミティブが返る
+	assert.Equal(t, []Primitive{background, player, hud}, sorted)
+}
+
+func TestSceneQueue_StableWithinLayer(t *testing.T) {
+	// Arrange
+	color := NewColorRGB(1, 1, 1)
+	first := NewRectangle(0, 0, 10, 10, color)
+	second := NewRectangle(10, 0, 10, 10, color)
+	third := NewRectangle(20, 0, 10, 10, color)
+	top := NewRectangle(30, 0, 10, 10, color)
+	var queue sceneQueue
+
+	// Act
+	queue.add(WithLayer(top, 1))
+	queue.add(first)
+	queue.add(second)
+	queue.add(third)
+	sorted := queue.sorted()
+
+	// Assert
+	assert.Equal(t, []Primitive{first, second, third, top}, sorted)
+}
+
+func TestOpenGLRenderer_SceneDefersDrawing(t *testing.T) {
+	// Arrange
+	renderer := &OpenGLRenderer{}
+	rect := NewRectangle(0, 0, 10, 10, NewColorRGB(1, 1, 1))
+
+	// Act
+	renderer.BeginScene()
+	renderer.DrawPrimitive(WithLayer(rect, 3))
+	renderer.DrawPrimitive("not a primitive")
+	queued := len(renderer.scene.entries)
+	inScene := renderer.IsInScene()
+	renderer.EndScene()
+
+	// Assert
+	assert.True(t, inScene)
+	assert.Equal(t, 1, queued, "プリミティブ以外は無視される")
+	assert.False(t, renderer.IsInScene())
+}
+
+func TestTintPrimitive_KeepsLayer(t *testing.T) {
+	// Arrange
+	white := NewColorRGB(1, 1, 1)
+	tint := NewColor(1, 0, 0, 0.5)
+	top := NewRectangle(0, 0, 10, 10, white)
+	bottom := NewRectangle(10, 0, 10, 10, white)
+	var queue sceneQueue
+
+	// Act
+	queue.add(tintPrimitive(WithLayer(top, 5), tint))
+	queue.add(bottom)
+	sorted := queue.sorted()
+
+	// Assert
+	// ティントを付与してもレイヤーが保たれる
+	assert.Equal(t, 5, layerOf(tintPrimitive(WithLayer(top, 5), tint)))
+	assert.Equal(t, bottom, sorted[0])
+	assert.Equal(t, newTintedPrimitive(top, tint), sorted[1])
+	assert.Equal(t, white.Multiply(tint), sorted[1].GetColor())
+}
+
+func TestOpenGLRenderer_SceneFlushesOnStateChange(t *testing.T) {
+	// Arrange
+	camera := mathlib.NewCamera2D()
+	renderer := &OpenGLRenderer{camera: &camera}
+	type flushState struct {
+		camera  *mathlib.Camera2D
+		clipped bool
+		count   int
+	}
+	var flushes []flushState
+	renderer.BeginBatch()
+	renderer.batch.flushFunc = func(vertices []float32, indices []uint32, color Color, primitiveType PrimitiveType) {
+		_, clipped := renderer.GetClipRect()
+		flushes = append(flushes, flushState{renderer.GetCamera(), clipped, len(indices) / 6})
+	}
+	white := NewColorRGB(1, 1, 1)
+
+	// Act
+	renderer.BeginScene()
+	renderer.DrawPrimitive(WithLayer(NewRectangle(0, 0, 10, 10, white), 1))
+	renderer.DrawPrimitive(NewRectangle(10, 0, 10, 10, white))
+	renderer.SetCamera(nil)
+	renderer.PushClipRect(mathlib.Rect{Max: mathlib.Vector2{X: 50, Y: 50}})
+	renderer.DrawPrimitive(NewRectangle(20, 0, 10, 10, white))
+	renderer.PopClipRect()
+	renderer.EndScene()
+	renderer.EndBatch()
+
+	// Assert
+	// 状態変更前のプリミティブは変更前の状態で、クリップ中のプリミティブはクリップされた状態で描画される
+	assert.Equal(t, []flushState{
+		{camera: &camera, clipped: false, count: 2},
+		{camera: nil, clipped: true, count: 1},
+	}, flushes)
+	assert.False(t, renderer.IsInScene())
+}
+
+func TestOpenGLRenderer_DrawPrimitiveCheckedKeepsSceneOrder(t *testing.T) {
+	// Arrange
+	manager := NewShaderManager()
+	manager.shaders["basic"] = newTestShaderWithProgram(NewMockOpenGLBackend(), 1)
+	manager.currentShader = "basic"
+	renderer := &OpenGLRenderer{shaderManager: manager}
+	renderer.BeginBatch()
+	var drawn []Color
+	renderer.batch.flushFunc = func(vertices []float32, indices []uint32, color Color, primitiveType PrimitiveType) {
+		drawn = append(drawn, color)
+	}
+	background := NewColorRGB(0, 0, 1)
+	foreground := NewColorRGB(1, 0, 0)
+
+	// Act
+	// 手前のレイヤーを先に検証付きで描画し、奥のレイヤーを後から追加する
+	renderer.BeginScene()
+	err := renderer.DrawPrimitiveChecked(WithLayer(NewRectangle(0, 0, 10, 10, foreground), 1))
+	renderer.DrawPrimitive(NewRectangle(0, 0, 100, 100, background))
+	queued := len(renderer.scene.entries)
+	renderer.EndScene()
+	renderer.EndBatch()
+
+	// Assert
+	// 検証付きの描画もシーンに追加され、レイヤー順に描画される
+	assert.NoError(t, err)
+	assert.Equal(t, 2, queued)
+	assert.Equal(t, []Color{background, foreground}, drawn)
+}
+
+func TestOpenGLRenderer_DrawPrimitiveCheckedValidatesInScene(t *testing.T) {
+	renderer := &OpenGLRenderer{shaderManager: NewShaderManager()}
+
+	renderer.BeginScene()
+	err := renderer.DrawPrimitiveChecked(NewRectangle(0, 0, 10, 10, NewColorRGB(1, 1, 1)))
+
+	// 描画できないプリミティブはシーンに追加せずにエラーを返す
+	assert.ErrorIs(t, err, ErrNoCurrentShader)
+	assert.Empty(t, renderer.scene.entries)
+}
@@ -0,0 +1,134 @@
+package renderer
+
+import (
+	"fmt"
+
+	"github.com/go-gl/gl/v4.1-core/gl"
+)
+
+// Mesh は専用のVAO/VBO/EBOに一度だけ転送して繰り返し描画する静的ジオメトリ
+// 背景やタイルマップなど毎フレーム変化しない形状に使用し、バッファの再転送を避ける
+// GPUへの転送は最初の描画時（または Update 後の描画時）にのみ行われる
+type Mesh struct {
+	backend  OpenGLBackend
+	vao      uint32
+	vbo      uint32
+	ebo      uint32
+	created  bool
+	dirty    bool
+	vertices []float32
+	indices  []uint32
+	drawMode uint32
+}
+
+// NewMesh は新しいMeshを作成する（頂点は x, y, z の3要素、三角形リストとして描画）
+func NewMesh(backend OpenGLBackend, vertices []float32, indices []uint32) *Mesh {
+	return &Mesh{
+		backend:  backend,
+		vertices: vertices,
+		indices:  indices,
+		drawMode: gl.TRIANGLES,
+		dirty:    true,
+	}
+}
+
+// NewMeshFromPrimitive はプリミティブの頂点・インデックスからMeshを作成する
+// 太さのある線は四角形として、インデックスを持たない点などは頂点順の連番インデックスで描画する
+func NewMeshFromPrimitive(backend OpenGLBackend, p Primitive) *Mesh {
+	vertices, indices, primitiveType := primitiveGeometry(p)
+	if len(indices) == 0 {
+		indices = sequentialIndices(len(vertices) / VertexPositionSize)
+	}
+
+	mesh := NewMesh(backend, vertices, indices)
+	mesh.drawMode = drawModeFor(primitiveType)
+	return mesh
+}
+
+// sequentialIndices は 0 から vertexCount-1 までの連番インデックスを作成する
+func sequentialIndices(vertexCount int) []uint32 {
+	indices := make([]uint32, vertexCount)
+	for i := range indices {
+		indices[i] = uint32(i)
+	}
+	return indices
+}
+
+// Update はジオメトリを置き換える（次の描画時に再転送される）
+func (m *Mesh) Update(vertices []float32, indices []uint32) {
+	m.vertices = vertices
+	m.indices = indices
+	m.dirty = true
+}
+
+// NeedsUpload はGPUへの転送が必要かを確認する
+func (m *Mesh) NeedsUpload() bool {
+	return m.dirty
+}
+
+// IndexCount は描画するインデックス数を取得する
+func (m *Mesh) IndexCount() int {
+	return len(m.indices)
+}
+
+// Upload は必要な場合のみジオメトリをGPUに転送する
+func (m *Mesh) Upload() {
+	if !m.dirty {
+		return
+	}
+
+	if !m.created {
+		m.vao = m.backend.GenVertexArray()
+		m.vbo = m.backend.GenBuffer()
+		m.ebo = m.backend.GenBuffer()
+		m.created = true
+	}
+
+	m.backend.BindVertexArray(m.vao)
+	m.backend.BindBuffer(gl.ARRAY_BUFFER, m.vbo)
+	m.backend.BufferDataFloat32(gl.ARRAY_BUFFER, m.vertices, gl.STATIC_DRAW)
+	m.backend.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, m.ebo)
+	m.backend.BufferDataUint32(gl.ELEMENT_ARRAY_BUFFER, m.indices, gl.STATIC_DRAW)
+	m.backend.VertexAttribPointer(VertexPositionAttrib, VertexPositionSize, VertexPositionSize*FloatSizeBytes, 0)
+	m.backend.EnableVertexAttribArray(VertexPositionAttrib)
+	m.backend.BindVertexArray(0)
+
+	m.dirty = false
+}
+
+// Draw はレンダラーの現在のシェーダーと座標系でメッシュを描画する
+func (m *Mesh) Draw(renderer *OpenGLRenderer, color Color) error {
+	shader, err := renderer.resolveDrawShader(m.vertices)
+	if err != nil {
+		return err
+	}
+	if len(m.indices) == 0 {
+		return fmt.Errorf("mesh has no indices")
+	}
+
+	// バッチやシーンに保留中の描画を先に行い、描画順を保つ
+	renderer.flushState()
+	m.Upload()
+
+	shader.Use()
+	renderer.applyDrawUniforms(shader, color)
+
+	m.backend.BindVertexArray(m.vao)
+	m.backend.DrawElements(m.drawMode, int32(len(m.indices)))
+	m.backend.BindVertexArray(0)
+	return nil
+}
+
+// Destroy はGPUリソースを解放する（再度描画すると作り直される）
+func (m *Mesh) Destroy() {
+	if !m.created {
+		return
+	}
+
+	m.backend.DeleteBuffer(m.vbo)
+	m.backend.DeleteBuffer(m.ebo)
+	m.backend.DeleteVertexArray(m.vao)
+	m.vao, m.vbo, m.ebo = 0, 0, 0
+	m.created = false
+	m.dirty = true
+}
@@ -0,0 +1,148 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/v4.1-core/gl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newMeshTestBackend はメッシュ転送で使用するバッファ操作を受け付けるモックを作成する
+func newMeshTestBackend() *MockOpenGLBackend {
+	backend := NewMockOpenGLBackend()
+	backend.On("GenVertexArray").Return(uint32(10))
+	backend.On("GenBuffer").Return(uint32(20)).Once()
+	backend.On("GenBuffer").Return(uint32(21)).Once()
+	backend.On("BindVertexArray", mock.Anything).Return()
+	backend.On("BindBuffer", mock.Anything, mock.Anything).Return()
+	backend.On("BufferDataFloat32", mock.Anything, mock.Anything, mock.Anything).Return()
+	backend.On("BufferDataUint32", mock.Anything, mock.Anything, mock.Anything).Return()
+	backend.On("VertexAttribPointer", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	backend.On("EnableVertexAttribArray", mock.Anything).Return()
+	backend.On("DeleteBuffer", mock.Anything).Return()
+	backend.On("DeleteVertexArray", mock.Anything).Return()
+	return backend
+}
+
+func TestMesh_UploadOnlyWhenDirty(t *testing.T) {
+	// Arrange
+	backend := newMeshTestBackend()
+	rect := NewRectangle(0, 0, 10, 10, NewColorRGB(1, 1, 1))
+	mesh := NewMeshFromPrimitive(backend, rect)
+	assert.True(t, mesh.NeedsUpload())
+
+	// Act
+	mesh.Upload()
+	mesh.Upload()
+
+	// Assert
+	assert.False(t, mesh.NeedsUpload())
+	backend.AssertNumberOfCalls(t, "GenVertexArray", 1)
+	backend.AssertNumberOfCalls(t, "BufferDataFloat32", 1)
+	backend.AssertCalled(t, "BufferDataFloat32", uint32(gl.ARRAY_BUFFER), rect.GetVertices(), uint32(gl.STATIC_DRAW))
+	backend.AssertCalled(t, "BufferDataUint32", uint32(gl.ELEMENT_ARRAY_BUFFER), rect.GetIndices(), uint32(gl.STATIC_DRAW))
+}
+
+func TestMesh_UpdateReuploadsIntoSameBuffers(t *testing.T) {
+	// Arrange
+	backend := newMeshTestBackend()
+	mesh := NewMesh(backend, []float32{0, 0, 0, 1, 0, 0, 0, 1, 0}, []uint32{0, 1, 2})
+	mesh.Upload()
+
+	// Act
+	newVertices := []float32{0, 0, 0, 2, 0, 0, 0, 2, 0}
+	mesh.Update(newVertices, []uint32{0, 1, 2})
+	assert.True(t, mesh.NeedsUpload())
+	mesh.Upload()
+
+	// Assert
+	backend.AssertNumberOfCalls(t, "GenVertexArray", 1)
+	backend.AssertNumberOfCalls(t, "GenBuffer", 2)
+	backend.AssertNumberOfCalls(t, "BufferDataFloat32", 2)
+	backend.AssertCalled(t, "BufferDataFloat32", uint32(gl.ARRAY_BUFFER), newVertices, uint32(gl.STATIC_DRAW))
+}
+
+func TestMesh_Destroy(t *testing.T) {
+	backend := newMeshTestBackend()
+	mesh := NewMesh(backend, []float32{0, 0, 0, 1, 0, 0, 0, 1, 0}, []uint32{0, 1, 2})
+
+	// 未転送の場合は何もしない
+	mesh.Destroy()
+	backend.AssertNotCalled(t, "DeleteBuffer", mock.Anything)
+
+	mesh.Upload()
+	mesh.Destroy()
+
+	backend.AssertCalled(t, "DeleteBuffer", uint32(20))
+	backend.AssertCalled(t, "DeleteBuffer", uint32(21))
+	backend.AssertCalled(t, "DeleteVertexArray", uint32(10))
+	assert.True(t, mesh.NeedsUpload(), "破棄後は再転送が必要")
+}
+
+func TestMesh_Draw_WithoutShaderManager(t *testing.T) {
+	backend := newMeshTestBackend()
+	mesh := NewMesh(backend, []float32{0, 0, 0, 1, 0, 0, 0, 1, 0}, []uint32{0, 1, 2})
+	renderer := &OpenGLRenderer{width: 800, height: 600}
+
+	err := mesh.Draw(renderer, NewColorRGB(1, 1, 1))
+
+	assert.ErrorIs(t, err, ErrNoShaderManager)
+	assert.True(t, mesh.NeedsUpload(), "描画できない場合は転送しない")
+}
+
+func TestNewMeshFromPrimitive(t *testing.T) {
+	white := NewColorRGB(1, 1, 1)
+	tests := []struct {
+		name       string
+		primitive  Primitive
+		drawMode   uint32
+		indexCount int
+	}{
+		{"矩形", NewRectangle(0, 0, 10, 10, white), gl.TRIANGLES, 6},
+		{"線", NewLine(0, 0, 10, 10, white), gl.LINES, 2},
+		{"太さのある線は四角形", NewLineWithWidth(0, 0, 10, 10, 4, white), gl.TRIANGLES, 6},
+		{"インデックスのない点は連番で描画", NewPoint(5, 5, white), gl.POINTS, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mesh := NewMeshFromPrimitive(NewMockOpenGLBackend(), tt.primitive)
+
+			assert.Equal(t, tt.drawMode, mesh.drawMode)
+			assert.Equal(t, tt.indexCount, mesh.IndexCount())
+		})
+	}
+}
+
+func TestMesh_Draw_FlushesPendingBatchFirst(t *testing.T) {
+	// Arrange
+	backend := newMeshTestBackend()
+	backend.On("UseProgram", mock.Anything).Return()
+	backend.On("GetUniformLocation", mock.Anything, mock.Anything).Return(int32(-1))
+	manager := NewShaderManager()
+	manager.shaders["basic"] = newTestShaderWithProgram(backend, 1)
+	manager.currentShader = "basic"
+	renderer := &OpenGLRenderer{width: 800, height: 600, shaderManager: manager}
+	renderer.BeginBatch()
+
+	var events []string
+	renderer.batch.flushFunc = func(vertices []float32, indices []uint32, color Color, primitiveType PrimitiveType) {
+		events = append(events, "batch")
+	}
+	backend.On("DrawElements", mock.Anything, mock.Anything).Return().Run(func(mock.Arguments) {
+		events = append(events, "mesh")
+	})
+	mesh := NewMesh(backend, []float32{0, 0, 0, 1, 0, 0, 0, 1, 0}, []uint32{0, 1, 2})
+
+	// Act
+	// バッチ中に矩形を描画した後、背景のメッシュを描画する
+	renderer.DrawPrimitive(NewRectangle(0, 0, 10, 10, NewColorRGB(1, 1, 1)))
+	err := mesh.Draw(renderer, NewColorRGB(0, 0, 1))
+	renderer.EndBatch()
+
+	// Assert
+	// 先に描画した矩形がメッシュより先に描画される
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"batch", "mesh"}, events)
+}
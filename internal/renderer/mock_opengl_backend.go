@@ -287,11 +287,26 @@ func (m *MockOpenGLBackend) UniformMatrix4fv(location int32, matrix [16]float32)
 	m.Called(location, matrix)
 }
 
+// UniformMatrix3fv は3x3行列のユニフォーム変数を設定する
+func (m *MockOpenGLBackend) UniformMatrix3fv(location int32, matrix [9]float32) {
+	m.Called(location, matrix)
+}
+
+// Uniform2fv は2次元ベクトルのユニフォーム変数を設定する
+func (m *MockOpenGLBackend) Uniform2fv(location int32, vector [2]float32) {
+	m.Called(location, vector)
+}
+
 // Uniform3fv は3次元ベクトルのユニフォーム変数を設定する
 func (m *MockOpenGLBackend) Uniform3fv(location int32, vector [3]float32) {
 	m.Called(location, vector)
 }
 
+// Uniform4fv は4次元ベクトルのユニフォーム変数を設定する
+func (m *MockOpenGLBackend) Uniform4fv(location int32, vector [4]float32) {
+	m.Called(location, vector)
+}
+
 // Uniform1f は浮動小数点数のユニフォーム変数を設定する
 func (m *MockOpenGLBackend) Uniform1f(location int32, value float32) {
 	m.Called(location, value)
@@ -302,6 +317,127 @@ func (m *MockOpenGLBackend) Uniform1i(location int32, value int32) {
 	m.Called(location, value)
 }
 
+// GenTexture は新しいテクスチャオブジェクトを作成する
+func (m *MockOpenGLBackend) GenTexture() uint32 {
+	args := m.Called()
+	return args.Get(0).(uint32)
+}
+
+// BindTexture はテクスチャをバインドする
+func (m *MockOpenGLBackend) BindTexture(target, texture uint32) {
+	m.Called(target, texture)
+}
+
+// TexParameteri はテクスチャパラメータを設定する
+func (m *MockOpenGLBackend) TexParameteri(target, pname uint32, param int32) {
+	m.Called(target, pname, param)
+}
+
+// TexImage2D はRGBA形式のピクセルデータをテクスチャに転送する
+func (m *MockOpenGLBackend) TexImage2D(target uint32, width, height int32, pixels []uint8) {
+	m.Called(target, width, height, pixels)
+}
+
+// DeleteTexture はテクスチャオブジェクトを削除する
+func (m *MockOpenGLBackend) DeleteTexture(texture uint32) {
+	m.Called(texture)
+}
+
+// GenVertexArray は新しい頂点配列オブジェクトを作成する
+func (m *MockOpenGLBackend) GenVertexArray() uint32 {
+	args := m.Called()
+	return args.Get(0).(uint32)
+}
+
+// BindVertexArray は頂点配列オブジェクトをバインドする
+func (m *MockOpenGLBackend) BindVertexArray(vao uint32) {
+	m.Called(vao)
+}
+
+// DeleteVertexArray は頂点配列オブジェクトを削除する
+func (m *MockOpenGLBackend) DeleteVertexArray(vao uint32) {
+	m.Called(vao)
+}
+
+// GenBuffer は新しいバッファオブジェクトを作成する
+func (m *MockOpenGLBackend) GenBuffer() uint32 {
+	args := m.Called()
+	return args.Get(0).(uint32)
+}
+
+// BindBuffer はバッファオブジェクトをバインドする
+func (m *MockOpenGLBackend) BindBuffer(target, buffer uint32) {
+	m.Called(target, buffer)
+}
+
+// BufferDataFloat32 はfloat32のデータをバッファに転送する
+func (m *MockOpenGLBackend) BufferDataFloat32(target uint32, data []float32, usage uint32) {
+	m.Called(target, data, usage)
+}
+
+// BufferDataUint32 はuint32のデータをバッファに転送する
+func (m *MockOpenGLBackend) BufferDataUint32(target uint32, data []uint32, usage uint32) {
+	m.Called(target, data, usage)
+}
+
+// DeleteBuffer はバッファオブジェクトを削除する
+func (m *MockOpenGLBackend) DeleteBuffer(buffer uint32) {
+	m.Called(buffer)
+}
+
+// VertexAttribPointer はfloat型の頂点属性のレイアウトを設定する
+func (m *MockOpenGLBackend) VertexAttribPointer(index uint32, size, stride int32, offset int) {
+	m.Called(index, size, stride, offset)
+}
+
+// EnableVertexAttribArray は頂点属性を有効にする
+func (m *MockOpenGLBackend) EnableVertexAttribArray(index uint32) {
+	m.Called(index)
+}
+
+// DrawElements はバインド中のEBOのuint32インデックスで描画する
+func (m *MockOpenGLBackend) DrawElements(mode uint32, count int32) {
+	m.Called(mode, count)
+}
+
+// GenFramebuffer は新しいフレームバッファオブジェクトを作成する
+func (m *MockOpenGLBackend) GenFramebuffer() uint32 {
+	args := m.Called()
+	return args.Get(0).(uint32)
+}
+
+// BindFramebuffer はフレームバッファをバインドする（0で画面）
+func (m *MockOpenGLBackend) BindFramebuffer(framebuffer uint32) {
+	m.Called(framebuffer)
+}
+
+// FramebufferTexture2D はバインド中のフレームバッファにテクスチャを接続する
+func (m *MockOpenGLBackend) FramebufferTexture2D(texture uint32) {
+	m.Called(texture)
+}
+
+// CheckFramebufferStatus はバインド中のフレームバッファの完全性を確認する
+func (m *MockOpenGLBackend) CheckFramebufferStatus() uint32 {
+	args := m.Called()
+	return args.Get(0).(uint32)
+}
+
+// DeleteFramebuffer はフレームバッファオブジェクトを削除する
+func (m *MockOpenGLBackend) DeleteFramebuffer(framebuffer uint32) {
+	m.Called(framebuffer)
+}
+
+// Viewport はビューポートを設定する
+func (m *MockOpenGLBackend) Viewport(x, y, width, height int32) {
+	m.Called(x, y, width, height)
+}
+
+// ReadPixels はフレームバッファの矩形をRGBA形式で読み出す
+// モックでは Run で pixels に書き込むことで読み出し結果を再現する
+func (m *MockOpenGLBackend) ReadPixels(x, y, width, height int32, pixels []uint8) {
+	m.Called(x, y, width, height, pixels)
+}
+
 // ヘルパーメソッド：テスト用
 func (m *MockOpenGLBackend) GetShader(id uint32) *MockShader {
 	return m.shaders[id]
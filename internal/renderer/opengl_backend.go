@@ -23,7 +23,39 @@ type OpenGLBackend interface {
 	// ユニフォーム関連
 	GetUniformLocation(program uint32, name string) int32
 	UniformMatrix4fv(location int32, matrix [16]float32)
+	UniformMatrix3fv(location int32, matrix [9]float32)
+	Uniform2fv(location int32, vector [2]float32)
 	Uniform3fv(location int32, vector [3]float32)
+	Uniform4fv(location int32, vector [4]float32)
 	Uniform1f(location int32, value float32)
 	Uniform1i(location int32, value int32)
+
+	// テクスチャ関連
+	GenTexture() uint32
+	BindTexture(target, texture uint32)
+	TexParameteri(target, pname uint32, param int32)
+	TexImage2D(target uint32, width, height int32, pixels []uint8)
+	DeleteTexture(texture uint32)
+
+	// バッファ関連
+	GenVertexArray() uint32
+	BindVertexArray(vao uint32)
+	DeleteVertexArray(vao uint32)
+	GenBuffer() uint32
+	BindBuffer(target, buffer uint32)
+	BufferDataFloat32(target uint32, data []float32, usage uint32)
+	BufferDataUint32(target uint32, data []uint32, usage uint32)
+	DeleteBuffer(buffer uint32)
+	VertexAttribPointer(index uint32, size, stride int32, offset int)
+	EnableVertexAttribArray(index uint32)
+	DrawElements(mode uint32, count int32)
+
+	// フレームバッファ関連
+	GenFramebuffer() uint32
+	BindFramebuffer(framebuffer uint32)
+	FramebufferTexture2D(texture uint32)
+	CheckFramebufferStatus() uint32
+	DeleteFramebuffer(framebuffer uint32)
+	Viewport(x, y, width, height int32)
+	ReadPixels(x, y, width, height int32, pixels []uint8)
 }
@@ -1,9 +1,13 @@
 package renderer
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"runtime"
 
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+	"github.com/ganyariya/tinyengine/internal/platform"
 	"github.com/ganyariya/tinyengine/pkg/tinyengine"
 	"github.com/go-gl/gl/v4.1-core/gl"
 	"github.com/go-gl/glfw/v3.3/glfw"
@@ -19,6 +23,15 @@ const (
 	DefaultBufferPoolSize = 100
 )
 
+// 描画エラー
+var (
+	ErrNilPrimitive    = errors.New("primitive is nil")
+	ErrNoShaderManager = errors.New("renderer has no shader manager")
+	ErrNoCurrentShader = errors.New("no shader is currently in use")
+	ErrEmptyVertices   = errors.New("primitive has no vertices")
+	ErrNoTexture       = errors.New("sprite has no texture")
+)
+
 // デフォルトカラー設定
 var (
 	DefaultClearColor = [4]float32{0.0, 0.0, 0.0, 1.0} // 黒背景
@@ -55,6 +68,40 @@ type OpenGLRenderer struct {
 	window        *glfw.Window
 	shaderManager *ShaderManager
 	bufferPool    *BufferPool
+
+	// 仮想解像度（0の場合は実際のフレームバッファサイズを使用）
+	virtualWidth  int
+	virtualHeight int
+
+	// フレーム録画（録画していない場合はnil）
+	recorder *frameRecorder
+
+	// ブレンド設定
+	blend blendState
+
+	// 画面クリア時の背景色
+	clearColor Color
+
+	// 描画に使用するカメラ（nilの場合はピクセル座標をそのまま使用する）
+	camera *mathlib.Camera2D
+
+	// バッチ処理中の描画待ちプリミティブ（バッチ処理中でない場合はnil）
+	batch *primitiveBatch
+
+	// 三角形の塗りつぶし方法
+	polygonMode PolygonMode
+
+	// オフスクリーンの描画先（nilの場合は画面に描画する）
+	renderTarget *RenderTarget
+
+	// 入れ子になったクリップ矩形
+	clips clipStack
+
+	// レイヤー順の描画中に保持するプリミティブ（レイヤー順の描画中でない場合はnil）
+	scene *sceneQueue
+
+	// 垂直同期が有効か
+	vsync bool
 }
 
 // NewOpenGLRenderer は新しいOpenGLRendererを作成する
@@ -63,8 +110,9 @@ func NewOpenGLRenderer(width, height int) (tinyengine.Renderer, error) {
 	// ウィンドウ作成とOpenGL初期化のみ行う
 
 	renderer := &OpenGLRenderer{
-		width:  width,
-		height: height,
+		width:      width,
+		height:     height,
+		clearColor: defaultClearColor(),
 	}
 
 	// ヘッドレス環境のテスト対応
@@ -80,8 +128,8 @@ func NewOpenGLRenderer(width, height int) (tinyengine.Renderer, error) {
 func NewOpenGLRendererWithWindow(width, height int, title string) (tinyengine.Renderer, error) {
 	runtime.LockOSThread()
 
-	// GLFW初期化確認
-	if err := glfw.Init(); err != nil {
+	// GLFW初期化確認（複数ウィンドウに対応するため参照カウントで管理）
+	if err := platform.AcquireGLFW(); err != nil {
 		return nil, fmt.Errorf("failed to initialize GLFW: %v", err)
 	}
 
@@ -94,7 +142,7 @@ func NewOpenGLRendererWithWindow(width, height int, title string) (tinyengine.Re
 	// ウィンドウ作成
 	window, err := glfw.CreateWindow(width, height, title, nil, nil)
 	if err != nil {
-		glfw.Terminate()
+		platform.ReleaseGLFW()
 		return nil, fmt.Errorf("failed to create window: %v", err)
 	}
 
@@ -103,7 +151,7 @@ func NewOpenGLRendererWithWindow(width, height int, title string) (tinyengine.Re
 	// OpenGL初期化
 	if err := gl.Init(); err != nil {
 		window.Destroy()
-		glfw.Terminate()
+		platform.ReleaseGLFW()
 		return nil, fmt.Errorf("failed to initialize OpenGL: %v", err)
 	}
 
@@ -116,10 +164,38 @@ func NewOpenGLRendererWithWindow(width, height int, title string) (tinyengine.Re
 	// シェーダーマネージャーでシェーダーを読み込み
 	if err := shaderManager.LoadShader("basic", BasicVertexShaderSource, BasicFragmentShaderSource); err != nil {
 		window.Destroy()
-		glfw.Terminate()
+		platform.ReleaseGLFW()
 		return nil, fmt.Errorf("failed to load basic shader: %v", err)
 	}
 	
+	if err := shaderManager.LoadShader(CircleSDFShaderName, CircleSDFVertexShaderSource, CircleSDFFragmentShaderSource); err != nil {
+		shaderManager.DeleteAllShaders()
+		window.Destroy()
+		platform.ReleaseGLFW()
+		return nil, fmt.Errorf("failed to load circle SDF shader: %v", err)
+	}
+	
+	if err := shaderManager.LoadShader(AALineShaderName, AALineVertexShaderSource, AALineFragmentShaderSource); err != nil {
+		shaderManager.DeleteAllShaders()
+		window.Destroy()
+		platform.ReleaseGLFW()
+		return nil, fmt.Errorf("failed to load anti-aliased line shader: %v", err)
+	}
+	
+	if err := shaderManager.LoadShader(GradientShaderName, GradientVertexShaderSource, GradientFragmentShaderSource); err != nil {
+		shaderManager.DeleteAllShaders()
+		window.Destroy()
+		platform.ReleaseGLFW()
+		return nil, fmt.Errorf("failed to load gradient shader: %v", err)
+	}
+	
+	if err := shaderManager.LoadShader(TextureShaderName, TextureVertexShaderSource, TextureFragmentShaderSource); err != nil {
+		shaderManager.DeleteAllShaders()
+		window.Destroy()
+		platform.ReleaseGLFW()
+		return nil, fmt.Errorf("failed to load texture shader: %v", err)
+	}
+	
 	shaderManager.UseShader("basic")
 
 	renderer := &OpenGLRenderer{
@@ -128,19 +204,47 @@ func NewOpenGLRendererWithWindow(width, height int, title string) (tinyengine.Re
 		window:        window,
 		shaderManager: shaderManager,
 		bufferPool:    NewBufferPool(DefaultBufferPoolSize),
+		blend:         blendState{mode: BlendModeAlpha},
+		clearColor:    defaultClearColor(),
 	}
 
+	// 半透明の色が正しく合成されるようデフォルトでアルファブレンドを有効にする
+	renderer.EnableBlending(true)
+	// platform.Window と同じくデフォルトで垂直同期を有効にする
+	renderer.SetVSync(true)
+
 	return renderer, nil
 }
 
-// Clear は画面をクリアする
+// Clear は設定された背景色で画面をクリアする
 func (r *OpenGLRenderer) Clear() {
-	gl.ClearColor(DefaultClearColor[0], DefaultClearColor[1], DefaultClearColor[2], DefaultClearColor[3])
+	r.flushState()
+	gl.ClearColor(r.clearColor.R, r.clearColor.G, r.clearColor.B, r.clearColor.A)
 	gl.Clear(gl.COLOR_BUFFER_BIT)
 }
 
+// SetClearColor は画面クリア時の背景色を設定する
+func (r *OpenGLRenderer) SetClearColor(red, green, blue, alpha float32) {
+	r.clearColor = NewColor(red, green, blue, alpha)
+}
+
+// GetClearColor は画面クリア時の背景色を取得する
+func (r *OpenGLRenderer) GetClearColor() Color {
+	return r.clearColor
+}
+
+// defaultClearColor は DefaultClearColor を Color として返す
+func defaultClearColor() Color {
+	return NewColor(DefaultClearColor[0], DefaultClearColor[1], DefaultClearColor[2], DefaultClearColor[3])
+}
+
 // Present は描画内容を画面に表示する
 func (r *OpenGLRenderer) Present() {
+	r.flushBatch()
+
+	// バッファ交換前に描画結果をキャプチャする
+	r.recordFrame()
+
 	if r.window != nil {
 		r.window.SwapBuffers()
 		glfw.PollEvents()
@@ -156,15 +260,94 @@ func (r *OpenGLRenderer) DrawRectangle(x, y, width, height float32) {
 
 // DrawPrimitive はプリミティブを描画する
 func (r *OpenGLRenderer) DrawPrimitive(primitive interface{}) {
+	// レイヤー順の描画中は EndScene まで描画を遅らせる
+	if r.scene != nil {
+		if p, ok := primitive.(Primitive); ok {
+			r.scene.add(p)
+		}
+		return
+	}
+
+	// WithLayer のラッパーはレイヤー順の描画以外では不要なため外す
+	if layered, ok := primitive.(*layeredPrimitive); ok {
+		primitive = layered.Primitive
+	}
+
+	// スプライトはテクスチャ座標が必要なため専用の描画経路を使用する
+	if sprite, ok := spriteOf(primitive); ok {
+		r.DrawSprite(sprite)
+		return
+	}
+
 	if p, ok := primitive.(Primitive); ok {
-		vertices := p.GetVertices()
-		indices := p.GetIndices()
+		vertices, indices, primitiveType := primitiveGeometry(p)
 		color := p.GetColor()
+		vertexColors, tint := vertexColorsOf(p)
+		
+		if r.batch != nil && isBatchable(indices, vertexColors) {
+			r.batch.Add(vertices, indices, color, primitiveType)
+			return
+		}
 		
-		r.drawVertices(vertices, indices, color, p.GetType())
+		r.flushBatch()
+		applyPointSize(p)
+		r.drawVertices(vertices, indices, color, primitiveType, vertexColors, tint)
 	}
 }
 
+// applyPointSize は点プリミティブの場合に描画する点の大きさを設定する
+func applyPointSize(p Primitive) {
+	if tinted, ok := p.(*tintedPrimitive); ok {
+		p = tinted.Primitive
+	}
+	if point, ok := p.(*Point); ok && point.Size > 0 {
+		gl.PointSize(point.Size)
+	}
+}
+
+// primitiveGeometry はプリミティブを描画する頂点データ、インデックスデータ、描画タイプを取得する
+// 太い線は gl.LINES では1pxでしか描画できないため、四角形に展開して三角形として描画する
+func primitiveGeometry(p Primitive) ([]float32, []uint32, PrimitiveType) {
+	base := p
+	if tinted, ok := p.(*tintedPrimitive); ok {
+		base = tinted.Primitive
+	}
+
+	if line, ok := base.(*Line); ok && line.IsThick() {
+		quad := line.GetQuadVertices()
+		if quad == nil {
+			return []float32{}, []uint32{}, PrimitiveTypeRectangle
+		}
+		return quad, line.GetQuadIndices(), PrimitiveTypeRectangle
+	}
+
+	return p.GetVertices(), p.GetIndices(), p.GetType()
+}
+
+// DrawPrimitiveTinted はプリミティブの色にティントを乗算して描画する
+// プリミティブ自体の色は変更されないため、フェードや色付けに利用できる
+func (r *OpenGLRenderer) DrawPrimitiveTinted(p Primitive, tint Color) {
+	if p == nil {
+		return
+	}
+	r.DrawPrimitive(tintPrimitive(p, tint))
+}
+
+// tintPrimitive はプリミティブにティントを付与する
+// 描画レイヤーを持つプリミティブはティントを付与した上でレイヤーを付け直し、レイヤー順の描画で失われないようにする
+func tintPrimitive(p Primitive, tint Color) Primitive {
+	layered, ok := p.(LayeredPrimitive)
+	if !ok {
+		return newTintedPrimitive(p, tint)
+	}
+
+	inner := p
+	if wrapper, ok := p.(*layeredPrimitive); ok {
+		inner = wrapper.Primitive
+	}
+	return WithLayer(newTintedPrimitive(inner, tint), layered.GetLayer())
+}
+
 // DrawRectangleColor は色付き矩形を描画する
 func (r *OpenGLRenderer) DrawRectangleColor(x, y, width, height float32, red, green, blue, alpha float32) {
 	color := NewColor(red, green, blue, alpha)
@@ -186,46 +369,149 @@ func (r *OpenGLRenderer) DrawLine(x1, y1, x2, y2 float32, red, green, blue, alph
 	r.DrawPrimitive(line)
 }
 
-// drawVertices は頂点データを描画する共通メソッド
-func (r *OpenGLRenderer) drawVertices(vertices []float32, indices []uint32, color Color, primitiveType PrimitiveType) {
+// DrawTriangle は三角形を描画する
+func (r *OpenGLRenderer) DrawTriangle(x1, y1, x2, y2, x3, y3 float32, red, green, blue, alpha float32) {
+	color := NewColor(red, green, blue, alpha)
+	triangle := NewTriangle(x1, y1, x2, y2, x3, y3, color)
+	r.DrawPrimitive(triangle)
+}
+
+// DrawPoint は点を描画する
+func (r *OpenGLRenderer) DrawPoint(x, y float32, red, green, blue, alpha float32) {
+	color := NewColor(red, green, blue, alpha)
+	point := NewPoint(x, y, color)
+	r.DrawPrimitive(point)
+}
+
+// DrawPrimitiveChecked はプリミティブを描画し、描画できない場合は原因を示すエラーを返す
+// DrawPrimitive は設定不備の場合に何も描画せずに戻るため、原因の調査に使用する
+func (r *OpenGLRenderer) DrawPrimitiveChecked(p Primitive) error {
+	if p == nil {
+		return ErrNilPrimitive
+	}
+	base := p
+	if layered, ok := base.(*layeredPrimitive); ok {
+		base = layered.Primitive
+	}
+
+	if err := r.checkPrimitive(base); err != nil {
+		return err
+	}
+
+	// レイヤー順の描画中は検証だけ行い、DrawPrimitive と同じく EndScene まで描画を遅らせる
+	if r.scene != nil {
+		r.scene.add(p)
+		return nil
+	}
+
+	// スプライトは DrawPrimitive と同じくテクスチャ描画の経路で描画する
+	if sprite, ok := spriteOf(base); ok {
+		r.DrawSprite(sprite)
+		return nil
+	}
+
+	vertices, indices, primitiveType := primitiveGeometry(base)
+	vertexColors, tint := vertexColorsOf(base)
+
+	r.flushState()
+	applyPointSize(base)
+	r.drawVertices(vertices, indices, base.GetColor(), primitiveType, vertexColors, tint)
+	return nil
+}
+
+// checkPrimitive はプリミティブを現在の状態で描画できるかを検証する
+func (r *OpenGLRenderer) checkPrimitive(p Primitive) error {
+	if sprite, ok := spriteOf(p); ok {
+		return r.checkSprite(sprite)
+	}
+
+	vertices, _, _ := primitiveGeometry(p)
+	_, err := r.resolveDrawShader(vertices)
+	return err
+}
+
+// checkSprite はスプライトをテクスチャ描画シェーダーで描画できるかを検証する
+func (r *OpenGLRenderer) checkSprite(sprite *Sprite) error {
+	if sprite.Texture == nil || sprite.Texture.GetID() == 0 {
+		return ErrNoTexture
+	}
 	if r.shaderManager == nil {
-		return // シェーダーマネージャーが初期化されていない場合は何もしない
+		return ErrNoShaderManager
+	}
+	if r.shaderManager.GetShader(TextureShaderName) == nil {
+		return fmt.Errorf("%w: shader %q is not loaded", ErrNoCurrentShader, TextureShaderName)
+	}
+	return nil
+}
+
+// resolveDrawShader は描画に必要な状態を検証し、使用するシェーダーを返す
+func (r *OpenGLRenderer) resolveDrawShader(vertices []float32) (*Shader, error) {
+	if r.shaderManager == nil {
+		return nil, ErrNoShaderManager
 	}
-	
 
-	// 現在のシェーダーを取得
 	currentShaderName := r.shaderManager.GetCurrentShader()
 	if currentShaderName == "" {
-		return
+		return nil, ErrNoCurrentShader
 	}
-	
+
 	shader := r.shaderManager.GetShader(currentShaderName)
 	if shader == nil {
+		return nil, fmt.Errorf("%w: shader %q is not loaded", ErrNoCurrentShader, currentShaderName)
+	}
+
+	if len(vertices) == 0 {
+		return nil, ErrEmptyVertices
+	}
+
+	return shader, nil
+}
+
+// drawVertices は頂点データを描画する共通メソッド
+// 頂点カラーがある場合は頂点カラーシェーダーで描画し、使用できなければ単色で描画する
+// 描画できない状態の場合は何もしない（原因は DrawPrimitiveChecked で確認できる）
+func (r *OpenGLRenderer) drawVertices(vertices []float32, indices []uint32, color Color, primitiveType PrimitiveType, vertexColors []Color, tint Color) {
+	shader, err := r.resolveDrawShader(vertices)
+	if err != nil {
+		return
+	}
+
+	if vertexColors != nil && r.drawVertexColored(vertices, indices, vertexColors, tint, primitiveType) {
 		return
 	}
 
-	// VBO, VAO, EBO取得（プールから再利用 or 新規作成）
+	r.drawVerticesWithShader(shader, vertices, indices, color, primitiveType)
+}
+
+// drawVerticesWithShader は指定シェーダーで頂点データを描画する
+func (r *OpenGLRenderer) drawVerticesWithShader(shader *Shader, vertices []float32, indices []uint32, color Color, primitiveType PrimitiveType) {
+	call := drawCallFor(vertices, indices)
+
+	// VBO, VAO取得（プールから再利用 or 新規作成）
 	vao := r.bufferPool.GetVAO()
 	vbo := r.bufferPool.GetVBO()
-	ebo := r.bufferPool.GetEBO()
 	
 	// defer文でリソースの確実な返却を保証
 	defer func() {
 		gl.BindVertexArray(0)
 		r.bufferPool.ReturnVAO(vao)
 		r.bufferPool.ReturnVBO(vbo)
-		r.bufferPool.ReturnEBO(ebo)
 	}()
 
 	gl.BindVertexArray(vao)
 
 	// 頂点データをVBOに設定
 	gl.BindBuffer(gl.ARRAY_BUFFER, vbo)
-	gl.BufferData(gl.ARRAY_BUFFER, len(vertices)*4, gl.Ptr(vertices), gl.STATIC_DRAW)
+	r.bufferPool.UploadFloat32(gl.ARRAY_BUFFER, vbo, vertices)
+
+	// インデックスがある場合のみEBOを使用する
+	if call.useElements {
+		ebo := r.bufferPool.GetEBO()
+		defer r.bufferPool.ReturnEBO(ebo)
 
-	// インデックスデータをEBOに設定
-	gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, ebo)
-	gl.BufferData(gl.ELEMENT_ARRAY_BUFFER, len(indices)*4, gl.Ptr(indices), gl.STATIC_DRAW)
+		gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, ebo)
+		r.bufferPool.UploadUint32(gl.ELEMENT_ARRAY_BUFFER, ebo, indices)
+	}
 
 	// 頂点属性の設定（位置のみ: x, y, z）
 	gl.VertexAttribPointer(0, 3, gl.FLOAT, false, 3*4, gl.PtrOffset(0))
@@ -234,64 +520,186 @@ func (r *OpenGLRenderer) drawVertices(vertices []float32, indices []uint32, colo
 	// シェーダーを使用
 	shader.Use()
 
+	r.applyDrawUniforms(shader, color)
+	r.applyPolygonMode()
+	
+	// 描画タイプに応じて描画
+	drawMode := drawModeFor(primitiveType)
+	if call.useElements {
+		gl.DrawElements(drawMode, call.count, gl.UNSIGNED_INT, gl.PtrOffset(0))
+	} else {
+		gl.DrawArrays(drawMode, 0, call.count)
+	}
+	
+	// クリーンアップはdefer文で処理
+}
+
+// drawCall は1回の描画呼び出しの方式と描画要素数を表す
+type drawCall struct {
+	useElements bool  // trueならDrawElements、falseならDrawArrays
+	count       int32 // インデックス数または頂点数
+}
+
+// drawModeFor はプリミティブタイプに対応するOpenGLの描画モードを返す
+// 線と点以外は三角形として描画する
+func drawModeFor(primitiveType PrimitiveType) uint32 {
+	switch primitiveType {
+	case PrimitiveTypeLine:
+		return gl.LINES
+	case PrimitiveTypePoint:
+		return gl.POINTS
+	default:
+		return gl.TRIANGLES
+	}
+}
+
+// drawCallFor はインデックスの有無から描画方式を決定する
+// インデックスが空の場合は頂点を順番に描画し、描画数は頂点数（x, y, zの3要素単位）になる
+func drawCallFor(vertices []float32, indices []uint32) drawCall {
+	if len(indices) > 0 {
+		return drawCall{useElements: true, count: int32(len(indices))}
+	}
+	return drawCall{useElements: false, count: int32(len(vertices) / 3)}
+}
+
+// applyDrawUniforms は現在のフレームバッファサイズに合わせた変換行列と描画色をシェーダーに設定する
+func (r *OpenGLRenderer) applyDrawUniforms(shader *Shader, color Color) {
 	// 正規化デバイス座標系への変換を実行
 	// 左上原点のピクセル座標系をOpenGLのNDC座標系に変換
 	// ピクセル座標 (0,0) = 左上 → NDC (-1,1)
 	// ピクセル座標 (width,height) = 右下 → NDC (1,-1)
 	
-	// 現在のフレームバッファサイズを取得（ウィンドウサイズ変更に対応）
-	var fbWidth, fbHeight int32
-	if r.window != nil {
-		w, h := r.window.GetFramebufferSize()
-		fbWidth, fbHeight = int32(w), int32(h)
-		// ビューポートも現在のサイズに合わせて更新
-		gl.Viewport(0, 0, fbWidth, fbHeight)
-	} else {
-		fbWidth, fbHeight = int32(r.width), int32(r.height)
-	}
+	// 現在の描画先のサイズを取得（ウィンドウサイズ変更・RenderTargetに対応）
+	w, h := r.surfaceSize()
+	fbWidth, fbHeight := int32(w), int32(h)
+	// ビューポートも現在のサイズに合わせて更新
+	r.applyViewport()
 	
-	width := float32(fbWidth)
-	height := float32(fbHeight)
-	
-	// 正射投影行列 (Orthographic Projection)
-	// 左上原点のピクセル座標系 → OpenGL NDC座標系
-	// ピクセル座標 Y=0 (上) → NDC Y=1 (上)
-	// ピクセル座標 Y=height (下) → NDC Y=-1 (下)
-	transformMatrix := [16]float32{
-		2.0/width,   0,            0, 0,  // X: [0,width] → [-1,1]
-		0,           -2.0/height,  0, 0,  // Y: [0,height] → [1,-1] (反転)
-		0,           0,            1, 0,  // Z: そのまま
-		-1,          1,            0, 1,  // 平行移動: (0,0)→(-1,1)
-	}
+	// 仮想解像度が設定されている場合は仮想座標系で変換する
+	transformMatrix := r.projectionMatrix(int(fbWidth), int(fbHeight))
 	
 	// Uniform変数を設定
-	transformLoc := shader.GetUniformLocation("u_transform")
-	if transformLoc != -1 {
-		gl.UniformMatrix4fv(transformLoc, 1, false, &transformMatrix[0])
+	shader.SetUniformMat4(shader.GetUniformLocation("u_transform"), transformMatrix)
+	shader.SetUniformVec4(shader.GetUniformLocation("u_color"), [4]float32{color.R, color.G, color.B, color.A})
+}
+
+// SetVirtualResolution は描画座標系として使用する仮想解像度を設定する
+// 描画座標は仮想解像度の単位で指定し、縦横比を保ったまま実際のフレームバッファサイズへ拡大縮小される
+// 縦横比が異なる場合は中央に配置し、上下または左右の余白には描画しない（レターボックス）
+// 幅または高さが0以下の場合は仮想解像度を無効にする
+func (r *OpenGLRenderer) SetVirtualResolution(width, height int) {
+	r.flushState()
+	if width <= 0 || height <= 0 {
+		r.virtualWidth = 0
+		r.virtualHeight = 0
+		return
 	}
-	
-	colorLoc := shader.GetUniformLocation("u_color")
-	if colorLoc != -1 {
-		gl.Uniform4f(colorLoc, color.R, color.G, color.B, color.A)
+	r.virtualWidth = width
+	r.virtualHeight = height
+}
+
+// GetVirtualResolution は仮想解像度を取得する（未設定の場合は0, 0）
+func (r *OpenGLRenderer) GetVirtualResolution() (int, int) {
+	return r.virtualWidth, r.virtualHeight
+}
+
+// coordinateSize は描画座標系のサイズを取得する
+func (r *OpenGLRenderer) coordinateSize(framebufferWidth, framebufferHeight int) (int, int) {
+	if r.virtualWidth > 0 && r.virtualHeight > 0 {
+		return r.virtualWidth, r.virtualHeight
 	}
-	
-	// 描画タイプに応じて描画
-	var drawMode uint32
-	switch primitiveType {
-	case PrimitiveTypeLine:
-		drawMode = gl.LINES
-	case PrimitiveTypeTriangle:
-		drawMode = gl.TRIANGLES
-	case PrimitiveTypeRectangle, PrimitiveTypeCircle:
-		drawMode = gl.TRIANGLES
-	default:
-		drawMode = gl.TRIANGLES
+	return framebufferWidth, framebufferHeight
+}
+
+// viewportRect は描画先のサイズに対するビューポート（左下原点の x, y, 幅, 高さ）を計算する
+// 仮想解像度が設定されている場合は FitContain で縦横比を保って中央に配置する
+func (r *OpenGLRenderer) viewportRect(surfaceWidth, surfaceHeight int) (int32, int32, int32, int32) {
+	if r.virtualWidth <= 0 || r.virtualHeight <= 0 {
+		return 0, 0, int32(surfaceWidth), int32(surfaceHeight)
 	}
 
-	// 描画実行
-	gl.DrawElements(drawMode, int32(len(indices)), gl.UNSIGNED_INT, gl.PtrOffset(0))
-	
-	// クリーンアップはdefer文で処理
+	virtualWidth, virtualHeight := float64(r.virtualWidth), float64(r.virtualHeight)
+	scale, offsetX, offsetY := mathlib.FitContain(virtualWidth, virtualHeight, float64(surfaceWidth), float64(surfaceHeight))
+	return int32(math.Round(offsetX)), int32(math.Round(offsetY)),
+		int32(math.Round(virtualWidth * scale)), int32(math.Round(virtualHeight * scale))
+}
+
+// applyViewport は現在の描画先のサイズに合わせてビューポートを設定する
+// OpenGLコンテキストを持たない場合（ウィンドウなし）は何もしない
+func (r *OpenGLRenderer) applyViewport() {
+	if r.window == nil {
+		return
+	}
+	gl.Viewport(r.viewportRect(r.surfaceSize()))
+}
+
+// SetCamera は描画に使用するカメラを設定する
+// カメラ設定中の描画座標はピクセル単位のワールド座標（Y軸下向き）として扱い、
+// 画面上の位置は Camera2D.WorldToScreenPixels / ScreenPixelsToWorld で相互に変換できる
+// カメラはポインタで保持するため、設定後にカメラを移動・ズームすると次の描画から反映される
+// nil を指定するとカメラを解除し、ピクセル座標をそのまま描画する
+func (r *OpenGLRenderer) SetCamera(camera *mathlib.Camera2D) {
+	r.flushState()
+	r.camera = camera
+}
+
+// GetCamera は描画に使用しているカメラを取得する（未設定の場合はnil）
+func (r *OpenGLRenderer) GetCamera() *mathlib.Camera2D {
+	return r.camera
+}
+
+// projectionMatrix は描画座標系からNDC座標系への変換行列を計算する
+// カメラが設定されている場合はカメラのビュー行列を合成する
+func (r *OpenGLRenderer) projectionMatrix(framebufferWidth, framebufferHeight int) [16]float32 {
+	width, height := r.coordinateSize(framebufferWidth, framebufferHeight)
+	if r.camera != nil {
+		return cameraToNDCMatrix(*r.camera, float32(width), float32(height))
+	}
+	return pixelToNDCMatrix(float32(width), float32(height))
+}
+
+// cameraToNDCMatrix はワールド座標をカメラのビュー変換を通してNDC座標系に変換する行列を作成する
+// カメラの位置が画面中央に表示され、ズームと回転は画面中央を基準に適用される
+// ワールド座標は縦横とも同じピクセル単位のため、円や回転した図形が歪まない
+func cameraToNDCMatrix(camera mathlib.Camera2D, width, height float32) [16]float32 {
+	view := mathlib.FromMatrix3x3(camera.GetPixelViewMatrix(float64(width), float64(height)))
+	return mathlib.NewOrtho(0, width, height, 0, -1, 1).Multiply(view).ToArray()
+}
+
+// pixelToNDCMatrix は左上原点のピクセル座標系をNDC座標系に変換する正射投影行列を作成する
+// ピクセル座標 Y=0 (上) → NDC Y=1 (上)
+// ピクセル座標 Y=height (下) → NDC Y=-1 (下)
+func pixelToNDCMatrix(width, height float32) [16]float32 {
+	return mathlib.NewOrtho(0, width, height, 0, -1, 1).ToArray()
+}
+
+// MakeCurrent はこのレンダラーのOpenGLコンテキストを現在のスレッドで有効にする
+// 複数のレンダラー（ウィンドウ）を使用する場合は描画前に呼び出す
+func (r *OpenGLRenderer) MakeCurrent() {
+	if r.window != nil {
+		r.window.MakeContextCurrent()
+	}
+}
+
+// SetVSync は垂直同期の有効・無効を切り替える
+// ウィンドウを持たない場合は設定値の更新のみ行う
+func (r *OpenGLRenderer) SetVSync(enabled bool) {
+	r.vsync = enabled
+	if r.window == nil {
+		return
+	}
+
+	r.window.MakeContextCurrent()
+	if enabled {
+		glfw.SwapInterval(1)
+	} else {
+		glfw.SwapInterval(0)
+	}
+}
+
+// VSyncEnabled は垂直同期が有効かを取得する
+func (r *OpenGLRenderer) VSyncEnabled() bool {
+	return r.vsync
 }
 
 // GetWindow はGLFWウィンドウを取得する
@@ -309,6 +717,8 @@ func (r *OpenGLRenderer) Destroy() {
 	}
 	if r.window != nil {
 		r.window.Destroy()
-		glfw.Terminate()
+		// 2回目の Destroy で他のウィンドウの参照を解放しないよう nil にする
+		r.window = nil
+		platform.ReleaseGLFW()
 	}
 }
@@ -1,10 +1,14 @@
 package renderer
 
 import (
+	stdmath "math"
 	"testing"
 
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
 	"github.com/ganyariya/tinyengine/pkg/tinyengine"
+	"github.com/go-gl/gl/v4.1-core/gl"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNewOpenGLRenderer(t *testing.T) {
@@ -36,3 +40,466 @@ func TestOpenGLRenderer_Methods(t *testing.T) {
 	// Skip test when OpenGL is not available
 	t.Skip("OpenGL methods require GL context initialization - skipping in CI environment")
 }
+
+// transformPoint は列優先の4x4行列で2D座標を変換する（テスト用）
+func transformPoint(matrix [16]float32, x, y float32) (float32, float32) {
+	return matrix[0]*x + matrix[4]*y + matrix[12],
+		matrix[1]*x + matrix[5]*y + matrix[13]
+}
+
+func TestOpenGLRenderer_ProjectionMatrix_Default(t *testing.T) {
+	// Arrange
+	renderer := &OpenGLRenderer{width: 800, height: 600}
+
+	// Act
+	matrix := renderer.projectionMatrix(800, 600)
+
+	// Assert
+	x, y := transformPoint(matrix, 0, 0)
+	assert.InDelta(t, -1.0, x, 1e-6)
+	assert.InDelta(t, 1.0, y, 1e-6)
+
+	x, y = transformPoint(matrix, 800, 600)
+	assert.InDelta(t, 1.0, x, 1e-6)
+	assert.InDelta(t, -1.0, y, 1e-6)
+}
+
+func TestOpenGLRenderer_ProjectionMatrix_VirtualResolution(t *testing.T) {
+	// Arrange
+	renderer := &OpenGLRenderer{width: 800, height: 600}
+	renderer.SetVirtualResolution(320, 180)
+
+	// Act
+	// 実際のフレームバッファは1280x720だが仮想解像度で変換される
+	matrix := renderer.projectionMatrix(1280, 720)
+
+	// Assert
+	x, y := transformPoint(matrix, 320, 180)
+	assert.InDelta(t, 1.0, x, 1e-6)
+	assert.InDelta(t, -1.0, y, 1e-6)
+
+	x, y = transformPoint(matrix, 160, 90)
+	assert.InDelta(t, 0.0, x, 1e-6)
+	assert.InDelta(t, 0.0, y, 1e-6)
+}
+
+func TestOpenGLRenderer_ViewportRect_Letterbox(t *testing.T) {
+	tests := []struct {
+		name               string
+		virtualW, virtualH int
+		surfaceW, surfaceH int
+		expected           [4]int32
+	}{
+		{"仮想解像度なし", 0, 0, 1280, 720, [4]int32{0, 0, 1280, 720}},
+		{"同じ縦横比", 320, 180, 1280, 720, [4]int32{0, 0, 1280, 720}},
+		{"横長の画面は左右に余白", 320, 180, 1440, 720, [4]int32{80, 0, 1280, 720}},
+		{"縦長の画面は上下に余白", 320, 180, 1280, 800, [4]int32{0, 40, 1280, 720}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			renderer := &OpenGLRenderer{}
+			renderer.SetVirtualResolution(tt.virtualW, tt.virtualH)
+
+			// Act
+			x, y, w, h := renderer.viewportRect(tt.surfaceW, tt.surfaceH)
+
+			// Assert
+			assert.Equal(t, tt.expected, [4]int32{x, y, w, h})
+		})
+	}
+}
+
+func TestOpenGLRenderer_SetVirtualResolution_Disable(t *testing.T) {
+	// Arrange
+	renderer := &OpenGLRenderer{width: 800, height: 600}
+	renderer.SetVirtualResolution(320, 180)
+
+	// Act
+	renderer.SetVirtualResolution(0, 0)
+
+	// Assert
+	w, h := renderer.GetVirtualResolution()
+	assert.Equal(t, 0, w)
+	assert.Equal(t, 0, h)
+
+	matrix := renderer.projectionMatrix(1280, 720)
+	x, y := transformPoint(matrix, 1280, 720)
+	assert.InDelta(t, 1.0, x, 1e-6)
+	assert.InDelta(t, -1.0, y, 1e-6)
+}
+
+func TestOpenGLRenderer_DrawPrimitiveTinted_WithoutShaderManager(t *testing.T) {
+	// Arrange
+	renderer := &OpenGLRenderer{width: 800, height: 600}
+	rect := NewRectangle(0, 0, 10, 10, NewColorRGB(1.0, 1.0, 1.0))
+
+	// Act & Assert
+	// シェーダーマネージャー未初期化・nilプリミティブでもパニックしない
+	assert.NotPanics(t, func() {
+		renderer.DrawPrimitiveTinted(rect, NewColor(1.0, 0.0, 0.0, 0.5))
+		renderer.DrawPrimitiveTinted(nil, NewColor(1.0, 0.0, 0.0, 0.5))
+	})
+}
+
+// newUniformCaptureShader は u_transform と u_color の設定をモックで記録するシェーダーを作成する
+func newUniformCaptureShader() (*Shader, *MockOpenGLBackend) {
+	backend := NewMockOpenGLBackend()
+	backend.On("GetUniformLocation", uint32(1), "u_transform").Return(int32(0))
+	backend.On("GetUniformLocation", uint32(1), "u_color").Return(int32(1))
+	backend.On("UniformMatrix4fv", int32(0), mock.Anything).Return()
+	backend.On("Uniform4fv", int32(1), mock.Anything).Return()
+
+	shader := NewShader(backend)
+	shader.programID = 1
+	return shader, backend
+}
+
+func TestOpenGLRenderer_DrawPrimitiveTinted_SetsTintedColorUniform(t *testing.T) {
+	// Arrange
+	renderer := &OpenGLRenderer{width: 800, height: 600}
+	shader, backend := newUniformCaptureShader()
+	rect := NewRectangle(0, 0, 10, 10, NewColor(1.0, 0.5, 1.0, 1.0))
+	tinted := tintPrimitive(rect, NewColor(0.5, 1.0, 0.0, 0.5))
+
+	// Act
+	renderer.applyDrawUniforms(shader, tinted.GetColor())
+
+	// Assert
+	// u_color にはプリミティブの色とティントを乗算した色が送られる
+	backend.AssertCalled(t, "Uniform4fv", int32(1), [4]float32{0.5, 0.5, 0.0, 0.5})
+	assert.Equal(t, NewColor(1.0, 0.5, 1.0, 1.0), rect.GetColor(), "元のプリミティブの色は変わらない")
+}
+
+func TestSpriteOf_UnwrapsTintedSprite(t *testing.T) {
+	// Arrange
+	renderer := &OpenGLRenderer{width: 800, height: 600}
+	shader, backend := newUniformCaptureShader()
+	sprite := NewSprite(&Texture{}, 0, 0, 32, 32)
+	tint := NewColor(1.0, 0.0, 0.0, 0.5)
+
+	// Act
+	tintedSprite, ok := spriteOf(tintPrimitive(sprite, tint))
+	renderer.applyDrawUniforms(shader, tintedSprite.Color)
+
+	// Assert
+	// ティント付きのスプライトもテクスチャ描画の経路で描画される
+	assert.True(t, ok)
+	assert.Equal(t, sprite.Texture, tintedSprite.Texture)
+	backend.AssertCalled(t, "Uniform4fv", int32(1), [4]float32{1.0, 0.0, 0.0, 0.5})
+	assert.Equal(t, NewColor(1, 1, 1, 1), sprite.Color, "元のスプライトの色は変わらない")
+
+	_, ok = spriteOf(tintPrimitive(NewRectangle(0, 0, 1, 1, tint), tint))
+	assert.False(t, ok)
+}
+
+func TestOpenGLRenderer_DrawPrimitiveChecked_Errors(t *testing.T) {
+	rect := NewRectangle(0, 0, 10, 10, NewColorRGB(1.0, 1.0, 1.0))
+
+	t.Run("nilプリミティブ", func(t *testing.T) {
+		renderer := &OpenGLRenderer{width: 800, height: 600, shaderManager: NewShaderManager()}
+
+		err := renderer.DrawPrimitiveChecked(nil)
+
+		assert.ErrorIs(t, err, ErrNilPrimitive)
+	})
+
+	t.Run("シェーダーマネージャーなし", func(t *testing.T) {
+		renderer := &OpenGLRenderer{width: 800, height: 600}
+
+		err := renderer.DrawPrimitiveChecked(rect)
+
+		assert.ErrorIs(t, err, ErrNoShaderManager)
+	})
+
+	t.Run("使用中のシェーダーなし", func(t *testing.T) {
+		renderer := &OpenGLRenderer{width: 800, height: 600, shaderManager: NewShaderManager()}
+
+		err := renderer.DrawPrimitiveChecked(rect)
+
+		assert.ErrorIs(t, err, ErrNoCurrentShader)
+	})
+
+	t.Run("使用中のシェーダーが読み込まれていない", func(t *testing.T) {
+		manager := NewShaderManager()
+		manager.currentShader = "missing"
+		renderer := &OpenGLRenderer{width: 800, height: 600, shaderManager: manager}
+
+		err := renderer.DrawPrimitiveChecked(rect)
+
+		assert.ErrorIs(t, err, ErrNoCurrentShader)
+		assert.Contains(t, err.Error(), "missing")
+	})
+
+	t.Run("頂点データが空", func(t *testing.T) {
+		manager := NewShaderManager()
+		manager.shaders["basic"] = newTestShaderWithProgram(NewMockOpenGLBackend(), 1)
+		manager.currentShader = "basic"
+		renderer := &OpenGLRenderer{width: 800, height: 600, shaderManager: manager}
+
+		err := renderer.DrawPrimitiveChecked(NewPolyLine(nil, 1, NewColorRGB(1, 1, 1)))
+
+		assert.ErrorIs(t, err, ErrEmptyVertices)
+	})
+
+	t.Run("スプライトのテクスチャなし", func(t *testing.T) {
+		renderer := &OpenGLRenderer{width: 800, height: 600, shaderManager: NewShaderManager()}
+		sprite := NewSprite(nil, 0, 0, 10, 10)
+
+		err := renderer.DrawPrimitiveChecked(WithLayer(sprite, 1))
+
+		assert.ErrorIs(t, err, ErrNoTexture)
+	})
+
+	t.Run("テクスチャ描画シェーダーが読み込まれていない", func(t *testing.T) {
+		manager := NewShaderManager()
+		manager.shaders["basic"] = newTestShaderWithProgram(NewMockOpenGLBackend(), 1)
+		manager.currentShader = "basic"
+		renderer := &OpenGLRenderer{width: 800, height: 600, shaderManager: manager}
+		sprite := NewSprite(&Texture{id: 1}, 0, 0, 10, 10)
+
+		// ティントを付与したスプライトもスプライトとして検証される
+		err := renderer.DrawPrimitiveChecked(tintPrimitive(sprite, NewColorRGB(1, 0, 0)))
+
+		assert.ErrorIs(t, err, ErrNoCurrentShader)
+		assert.Contains(t, err.Error(), TextureShaderName)
+	})
+}
+
+func TestDrawCallFor_WithIndicesUsesElements(t *testing.T) {
+	rect := NewRectangle(0, 0, 10, 10, NewColorRGB(1, 1, 1))
+
+	call := drawCallFor(rect.GetVertices(), rect.GetIndices())
+
+	assert.True(t, call.useElements)
+	assert.Equal(t, int32(6), call.count)
+}
+
+func TestDrawCallFor_WithoutIndicesUsesArrays(t *testing.T) {
+	// 三角形1つ分の頂点（x, y, z × 3）
+	vertices := []float32{0, 0, 0, 10, 0, 0, 5, 8, 0}
+
+	nilCall := drawCallFor(vertices, nil)
+	emptyCall := drawCallFor(vertices, []uint32{})
+
+	assert.False(t, nilCall.useElements)
+	assert.Equal(t, int32(3), nilCall.count, "描画数は頂点数になる")
+	assert.Equal(t, nilCall, emptyCall)
+}
+
+func TestDrawModeFor(t *testing.T) {
+	assert.Equal(t, uint32(gl.LINES), drawModeFor(PrimitiveTypeLine))
+	assert.Equal(t, uint32(gl.POINTS), drawModeFor(PrimitiveTypePoint))
+	assert.Equal(t, uint32(gl.TRIANGLES), drawModeFor(PrimitiveTypeTriangle))
+	assert.Equal(t, uint32(gl.TRIANGLES), drawModeFor(PrimitiveTypeRectangle))
+	assert.Equal(t, uint32(gl.TRIANGLES), drawModeFor(PrimitiveTypeCircle))
+}
+
+func TestPrimitiveGeometry_ThickLineExpandsToQuad(t *testing.T) {
+	line := NewLineWithWidth(0, 10, 20, 10, 4, NewColorRGB(1, 1, 1))
+
+	vertices, indices, primitiveType := primitiveGeometry(line)
+
+	assert.Equal(t, line.GetQuadVertices(), vertices)
+	assert.Equal(t, line.GetQuadIndices(), indices)
+	assert.Equal(t, PrimitiveTypeRectangle, primitiveType, "三角形として描画する")
+}
+
+func TestPrimitiveGeometry_ThinLineUsesLines(t *testing.T) {
+	line := NewLine(0, 10, 20, 10, NewColorRGB(1, 1, 1))
+
+	vertices, indices, primitiveType := primitiveGeometry(line)
+
+	assert.Equal(t, line.GetVertices(), vertices)
+	assert.Equal(t, line.GetIndices(), indices)
+	assert.Equal(t, PrimitiveTypeLine, primitiveType)
+}
+
+func TestPrimitiveGeometry_ZeroLengthThickLineDrawsNothing(t *testing.T) {
+	line := NewLineWithWidth(5, 5, 5, 5, 4, NewColorRGB(1, 1, 1))
+
+	vertices, _, _ := primitiveGeometry(newTintedPrimitive(line, NewColorRGB(1, 1, 1)))
+
+	assert.Empty(t, vertices)
+}
+
+func TestOpenGLRenderer_ClearColor(t *testing.T) {
+	// Arrange
+	renderer := &OpenGLRenderer{clearColor: defaultClearColor()}
+
+	// Act
+	defaultColor := renderer.GetClearColor()
+	renderer.SetClearColor(0.5, 0.8, 1.0, 1.0)
+
+	// Assert
+	assert.Equal(t, NewColor(0, 0, 0, 1), defaultColor, "デフォルトは黒")
+	assert.Equal(t, NewColor(0.5, 0.8, 1.0, 1.0), renderer.GetClearColor())
+}
+
+func TestOpenGLRenderer_VSync(t *testing.T) {
+	// Arrange
+	// ウィンドウなしのレンダラーはGLを呼ばずに設定値だけを更新する
+	renderer := &OpenGLRenderer{}
+
+	// Act & Assert
+	assert.False(t, renderer.VSyncEnabled())
+
+	renderer.SetVSync(true)
+	assert.True(t, renderer.VSyncEnabled())
+
+	renderer.SetVSync(false)
+	assert.False(t, renderer.VSyncEnabled())
+}
+
+func TestOpenGLRenderer_ProjectionMatrix_NoCameraKeepsPixelSpace(t *testing.T) {
+	// Arrange
+	renderer := &OpenGLRenderer{width: 800, height: 600}
+	renderer.SetCamera(nil)
+
+	// Act
+	matrix := renderer.projectionMatrix(800, 600)
+
+	// Assert
+	assert.Nil(t, renderer.GetCamera())
+	assert.Equal(t, pixelToNDCMatrix(800, 600), matrix)
+}
+
+func TestOpenGLRenderer_ProjectionMatrix_CameraPosition(t *testing.T) {
+	// Arrange
+	renderer := &OpenGLRenderer{width: 800, height: 600}
+	camera := mathlib.NewCamera2D()
+	camera.SetPosition(mathlib.Vector2{X: 100, Y: 50})
+	renderer.SetCamera(&camera)
+
+	// Act
+	matrix := renderer.projectionMatrix(800, 600)
+
+	// Assert
+	// カメラ位置が画面中央（NDC原点）に表示される
+	x, y := transformPoint(matrix, 100, 50)
+	assert.InDelta(t, 0.0, x, 1e-6)
+	assert.InDelta(t, 0.0, y, 1e-6)
+
+	// カメラから右に画面幅の半分（400ピクセル）の点は右端
+	x, _ = transformPoint(matrix, 500, 50)
+	assert.InDelta(t, 1.0, x, 1e-6)
+}
+
+func TestOpenGLRenderer_ProjectionMatrix_CameraZoomAppliesAfterSet(t *testing.T) {
+	// Arrange
+	renderer := &OpenGLRenderer{width: 800, height: 600}
+	camera := mathlib.NewCamera2D()
+	renderer.SetCamera(&camera)
+
+	// Act
+	// 設定後のカメラ操作も描画に反映される
+	camera.SetZoom(2.0)
+	matrix := renderer.projectionMatrix(800, 600)
+
+	// Assert
+	// 2倍ズームでは原点から200ピクセル離れた点が画面右端に表示される
+	x, y := transformPoint(matrix, 200, 0)
+	assert.InDelta(t, 1.0, x, 1e-6)
+	assert.InDelta(t, 0.0, y, 1e-6)
+
+	_, y = transformPoint(matrix, 0, 150)
+	assert.InDelta(t, -1.0, y, 1e-6, "ワールド座標のY軸は画面と同じ下向き")
+}
+
+// projectToPixel は描画座標を変換行列でNDC座標に変換し、さらに左上原点のピクセル座標に変換する
+func projectToPixel(matrix [16]float32, worldX, worldY float32, width, height float64) mathlib.Vector2 {
+	x, y := transformPoint(matrix, worldX, worldY)
+	return mathlib.Vector2{
+		X: (float64(x) + 1) / 2 * width,
+		Y: (1 - float64(y)) / 2 * height,
+	}
+}
+
+func TestOpenGLRenderer_ProjectionMatrix_MatchesWorldToScreenPixels(t *testing.T) {
+	// Arrange
+	const width, height = 800, 600
+	renderer := &OpenGLRenderer{width: width, height: height}
+	camera := mathlib.NewCamera2DWithValues(mathlib.Vector2{X: 30, Y: -20}, 1.5, 0.4)
+	renderer.SetCamera(&camera)
+	matrix := renderer.projectionMatrix(width, height)
+
+	points := []mathlib.Vector2{{X: 0, Y: 0}, {X: 50, Y: 0}, {X: -70, Y: 90}, {X: 120, Y: -40}}
+	for _, point := range points {
+		// Act
+		pixel := projectToPixel(matrix, float32(point.X), float32(point.Y), width, height)
+
+		// Assert
+		// 描画位置とカメラのピッキングが一致する
+		expected := camera.WorldToScreenPixels(point, width, height)
+		assert.InDelta(t, expected.X, pixel.X, 1e-3)
+		assert.InDelta(t, expected.Y, pixel.Y, 1e-3)
+	}
+}
+
+func TestOpenGLRenderer_ProjectionMatrix_CircleStaysRound(t *testing.T) {
+	// Arrange
+	// 縦横比の異なる画面で回転・ズームしたカメラ
+	const width, height = 800, 600
+	renderer := &OpenGLRenderer{width: width, height: height}
+	camera := mathlib.NewCamera2DWithValues(mathlib.Vector2{X: 10, Y: 20}, 2.0, 0.7)
+	renderer.SetCamera(&camera)
+	matrix := renderer.projectionMatrix(width, height)
+	center := projectToPixel(matrix, 10, 20, width, height)
+
+	for i := 0; i < 8; i++ {
+		// Act
+		angle := float64(i) * stdmath.Pi / 4
+		x, y := float32(10+stdmath.Cos(angle)), float32(20+stdmath.Sin(angle))
+		pixel := projectToPixel(matrix, x, y, width, height)
+
+		// Assert
+		// 単位円上の点はどの方向でもズーム倍率（2ピクセル）の距離に描画される
+		assert.InDelta(t, 2.0, pixel.Distance(center), 1e-3)
+	}
+}
+
+func TestOpenGLRenderer_ProjectionMatrix_CameraKeepsSpriteUpright(t *testing.T) {
+	// Arrange
+	const width, height = 800, 600
+	renderer := &OpenGLRenderer{width: width, height: height}
+	camera := mathlib.NewCamera2DWithValues(mathlib.Vector2{X: 50, Y: 50}, 1.5, 0)
+	renderer.SetCamera(&camera)
+	matrix := renderer.projectionMatrix(width, height)
+	sprite := NewSprite(nil, 40, 40, 20, 20)
+	data := sprite.GetTexturedVertices()
+
+	// Act
+	// V0 を持つ頂点と V1 を持つ頂点の画面上の高さを比べる
+	var topY, bottomY float64
+	for i := 0; i < len(data); i += SpriteVertexStride {
+		pixel := projectToPixel(matrix, data[i], data[i+1], width, height)
+		if data[i+4] == sprite.V0 {
+			topY = pixel.Y
+		} else {
+			bottomY = pixel.Y
+		}
+	}
+
+	// Assert
+	// テクスチャの上端（V0）は画面の上側に表示される
+	assert.Less(t, topY, bottomY)
+}
+
+func TestCameraToNDCMatrix_UsesVirtualResolution(t *testing.T) {
+	// Arrange
+	renderer := &OpenGLRenderer{width: 800, height: 600}
+	renderer.SetVirtualResolution(320, 180)
+	camera := mathlib.NewCamera2D()
+	renderer.SetCamera(&camera)
+
+	// Act
+	matrix := renderer.projectionMatrix(1280, 720)
+
+	// Assert
+	// 仮想解像度を画面サイズとして変換し、ワールド座標 (-160, -90) が画面左上になる
+	assert.Equal(t, cameraToNDCMatrix(camera, 320, 180), matrix)
+	x, y := transformPoint(matrix, -160, -90)
+	assert.InDelta(t, -1.0, x, 1e-6)
+	assert.InDelta(t, 1.0, y, 1e-6)
+}
@@ -0,0 +1,97 @@
+package renderer
+
+import (
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+)
+
+// Polygon は任意の単純多角形（自己交差しない多角形）を塗りつぶすプリミティブ
+// 凸多角形は扇形分割、凹多角形は耳刈り法で三角形分割される
+type Polygon struct {
+	Points [][2]float32 // 頂点座標（時計回り・反時計回りどちらでも可）
+	Color  Color        // 色
+}
+
+// NewPolygon は新しい多角形を作成する
+func NewPolygon(points [][2]float32, color Color) *Polygon {
+	return &Polygon{
+		Points: points,
+		Color:  color,
+	}
+}
+
+// GetVertices は多角形の頂点データを取得する
+// 三角形分割できない多角形の場合は空を返す（インデックスなしで頂点列が描画されるのを防ぐ）
+func (p *Polygon) GetVertices() []float32 {
+	if _, err := p.triangulate(); err != nil {
+		return []float32{}
+	}
+
+	vertices := make([]float32, 0, len(p.Points)*3)
+	for _, point := range p.Points {
+		vertices = append(vertices, point[0], point[1], 0.0)
+	}
+	return vertices
+}
+
+// GetIndices は多角形のインデックスデータを取得する
+// 三角形分割できない多角形（退化・自己交差）の場合は空を返す
+func (p *Polygon) GetIndices() []uint32 {
+	indices, err := p.triangulate()
+	if err != nil {
+		return []uint32{}
+	}
+	return indices
+}
+
+// Validate は多角形が描画可能な頂点数（3点以上）を持つかを検証する
+func (p *Polygon) Validate() error {
+	if len(p.Points) < 3 {
+		return mathlib.ErrPolygonTooFewVertices
+	}
+	return nil
+}
+
+// triangulate は凸多角形なら扇形分割、そうでなければ耳刈り法で三角形分割する
+// 星形のように各頂点の曲がる向きが揃っていても辺が交差する多角形は、扇形分割する前にエラーにする
+func (p *Polygon) triangulate() ([]uint32, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	points := make([]mathlib.Vector2, len(p.Points))
+	for i, point := range p.Points {
+		points[i] = mathlib.Vector2{X: float64(point[0]), Y: float64(point[1])}
+	}
+
+	if mathlib.IsSelfIntersecting(points) {
+		return nil, mathlib.ErrPolygonSelfIntersecting
+	}
+	if mathlib.IsConvexPolygon(points) {
+		return fanIndices(len(points)), nil
+	}
+
+	return mathlib.Triangulate(points)
+}
+
+// GetColor は多角形の色を取得する
+func (p *Polygon) GetColor() Color {
+	return p.Color
+}
+
+// GetType は多角形のプリミティブタイプを取得する
+func (p *Polygon) GetType() PrimitiveType {
+	return PrimitiveTypePolygon
+}
+
+// fanIndices は頂点0を中心とした扇形分割のインデックスを作成する
+func fanIndices(vertexCount int) []uint32 {
+	if vertexCount < 3 {
+		return []uint32{}
+	}
+
+	indices := make([]uint32, 0, (vertexCount-2)*3)
+	for i := 1; i < vertexCount-1; i++ {
+		indices = append(indices, 0, uint32(i), uint32(i+1))
+	}
+	return indices
+}
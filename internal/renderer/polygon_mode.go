@@ -0,0 +1,55 @@
+package renderer
+
+import (
+	"github.com/go-gl/gl/v4.1-core/gl"
+)
+
+// PolygonMode は三角形の塗りつぶし方法を表す
+type PolygonMode int
+
+const (
+	PolygonModeFill PolygonMode = iota // 塗りつぶし（デフォルト）
+	PolygonModeLine                    // 辺のみを描画するワイヤーフレーム（メッシュ生成のデバッグ用）
+)
+
+// String はポリゴンモードの名前を返す
+func (m PolygonMode) String() string {
+	switch m {
+	case PolygonModeFill:
+		return "Fill"
+	case PolygonModeLine:
+		return "Line"
+	default:
+		return "Unknown"
+	}
+}
+
+// glPolygonMode はポリゴンモードに対応する gl.PolygonMode の値を返す
+// 未知のモードは塗りつぶしとして扱う
+func glPolygonMode(mode PolygonMode) uint32 {
+	if mode == PolygonModeLine {
+		return gl.LINE
+	}
+	return gl.FILL
+}
+
+// SetPolygonMode はポリゴンモードを設定する
+// 設定は次の描画から適用され、変更するまでフレームをまたいで維持される
+func (r *OpenGLRenderer) SetPolygonMode(mode PolygonMode) {
+	r.flushState()
+	r.polygonMode = mode
+}
+
+// GetPolygonMode は現在のポリゴンモードを取得する
+func (r *OpenGLRenderer) GetPolygonMode() PolygonMode {
+	return r.polygonMode
+}
+
+// applyPolygonMode は保持しているポリゴンモードを GL に反映する
+// OpenGLコンテキストを持たない場合（ウィンドウなし）は何もしない
+func (r *OpenGLRenderer) applyPolygonMode() {
+	if r.window == nil {
+		return
+	}
+	gl.PolygonMode(gl.FRONT_AND_BACK, glPolygonMode(r.polygonMode))
+}
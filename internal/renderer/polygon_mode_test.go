@@ -0,0 +1,52 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/v4.1-core/gl"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolygonMode_String(t *testing.T) {
+	assert.Equal(t, "Fill", PolygonModeFill.String())
+	assert.Equal(t, "Line", PolygonModeLine.String())
+	assert.Equal(t, "Unknown", PolygonMode(99).String())
+}
+
+func TestGLPolygonMode(t *testing.T) {
+	assert.Equal(t, uint32(gl.FILL), glPolygonMode(PolygonModeFill))
+	assert.Equal(t, uint32(gl.LINE), glPolygonMode(PolygonModeLine))
+	assert.Equal(t, uint32(gl.FILL), glPolygonMode(PolygonMode(99)), "未知のモードは塗りつぶし")
+}
+
+func TestOpenGLRenderer_SetPolygonMode(t *testing.T) {
+	// Arrange
+	renderer := &OpenGLRenderer{}
+
+	// Act
+	defaultMode := renderer.GetPolygonMode()
+	renderer.SetPolygonMode(PolygonModeLine)
+
+	// Assert
+	assert.Equal(t, PolygonModeFill, defaultMode)
+	assert.Equal(t, PolygonModeLine, renderer.GetPolygonMode())
+}
+
+func TestOpenGLRenderer_PolygonModePersistsAcrossFrames(t *testing.T) {
+	// Arrange
+	renderer := &OpenGLRenderer{}
+	renderer.SetPolygonMode(PolygonModeLine)
+
+	// Act
+	// フレームをまたいでもモードは維持される
+	renderer.Present()
+	modeAfterFrame := renderer.GetPolygonMode()
+
+	// 塗りつぶしに戻すと次のフレームも塗りつぶしになる
+	renderer.SetPolygonMode(PolygonModeFill)
+	renderer.Present()
+
+	// Assert
+	assert.Equal(t, PolygonModeLine, modeAfterFrame)
+	assert.Equal(t, PolygonModeFill, renderer.GetPolygonMode())
+}
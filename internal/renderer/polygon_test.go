@@ -0,0 +1,108 @@
+package renderer
+
+import (
+	"testing"
+
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolygon_GetVertices(t *testing.T) {
+	polygon := NewPolygon([][2]float32{{0, 0}, {10, 0}, {5, 8}}, NewColorRGB(1, 0, 0))
+
+	assert.Equal(t, []float32{0, 0, 0, 10, 0, 0, 5, 8, 0}, polygon.GetVertices())
+	assert.Equal(t, PrimitiveTypePolygon, polygon.GetType())
+}
+
+func TestPolygon_GetIndices_ConvexUsesFan(t *testing.T) {
+	polygon := NewPolygon([][2]float32{{0, 0}, {10, 0}, {10, 10}, {0, 10}}, NewColorRGB(1, 1, 1))
+
+	assert.Equal(t, []uint32{0, 1, 2, 0, 2, 3}, polygon.GetIndices())
+}
+
+func TestPolygon_GetIndices_Concave(t *testing.T) {
+	// 凹多角形（矢印型）は扇形分割できない
+	polygon := NewPolygon([][2]float32{{0, 0}, {10, 5}, {0, 10}, {4, 5}}, NewColorRGB(1, 1, 1))
+
+	indices := polygon.GetIndices()
+
+	assert.Len(t, indices, 6)
+	// 凹頂点(4,5)=3 からの扇形 {3,0,1},{3,1,2} と同じ領域になる
+	assert.NotContains(t, triangleSets(indices), [3]uint32{0, 1, 2}, "凹部を覆う三角形を含まない")
+}
+
+func TestPolygon_GetIndices_Degenerate(t *testing.T) {
+	polygon := NewPolygon([][2]float32{{0, 0}, {1, 1}, {2, 2}}, NewColorRGB(1, 1, 1))
+
+	assert.Empty(t, polygon.GetIndices())
+}
+
+func TestPolygon_GetIndices_PentagramIsSelfIntersecting(t *testing.T) {
+	// Arrange
+	// 正五角形の頂点を1つおきに結んだ星形（各頂点の曲がる向きは揃っている）
+	pentagram := NewPolygon([][2]float32{
+		{0, 1}, {0.588, -0.809}, {-0.951, 0.309}, {0.951, 0.309}, {-0.588, -0.809},
+	}, NewColorRGB(1, 1, 1))
+
+	// Act
+	_, err := pentagram.triangulate()
+
+	// Assert
+	assert.ErrorIs(t, err, mathlib.ErrPolygonSelfIntersecting)
+	assert.Empty(t, pentagram.GetIndices(), "扇形分割で星形の外側を塗らない")
+}
+
+// triangleSets はインデックス列を頂点順を正規化した三角形の集合に変換する
+func triangleSets(indices []uint32) [][3]uint32 {
+	result := make([][3]uint32, 0, len(indices)/3)
+	for i := 0; i+2 < len(indices); i += 3 {
+		tri := [3]uint32{indices[i], indices[i+1], indices[i+2]}
+		// 昇順に並べ替え
+		for a := 0; a < 3; a++ {
+			for b := a + 1; b < 3; b++ {
+				if tri[b] < tri[a] {
+					tri[a], tri[b] = tri[b], tri[a]
+				}
+			}
+		}
+		result = append(result, tri)
+	}
+	return result
+}
+
+func TestPolygon_GetVertices_DegenerateIsEmpty(t *testing.T) {
+	// 分割できない多角形はインデックスなし描画にならないよう頂点も返さない
+	polygon := NewPolygon([][2]float32{{0, 0}, {1, 1}, {2, 2}}, NewColorRGB(1, 1, 1))
+
+	assert.Empty(t, polygon.GetVertices())
+}
+
+func TestPolygon_GetIndices_ConvexIndexCount(t *testing.T) {
+	for n := 3; n <= 8; n++ {
+		// 正n角形は凸なので扇形分割される
+		circle := NewCircleWithSegments(0, 0, 10, NewColorRGB(1, 1, 1), n)
+		vertices := circle.GetVertices()
+		points := make([][2]float32, n)
+		for i := 0; i < n; i++ {
+			points[i] = [2]float32{vertices[(i+1)*3], vertices[(i+1)*3+1]}
+		}
+		polygon := NewPolygon(points, NewColorRGB(1, 1, 1))
+
+		assert.Len(t, polygon.GetIndices(), (n-2)*3, "n=%d", n)
+	}
+}
+
+func TestPolygon_Validate(t *testing.T) {
+	color := NewColorRGB(1, 1, 1)
+
+	assert.NoError(t, NewPolygon([][2]float32{{0, 0}, {10, 0}, {5, 8}}, color).Validate())
+	assert.ErrorIs(t, NewPolygon([][2]float32{{0, 0}, {10, 0}}, color).Validate(), mathlib.ErrPolygonTooFewVertices)
+	assert.ErrorIs(t, NewPolygon(nil, color).Validate(), mathlib.ErrPolygonTooFewVertices)
+}
+
+func TestPolygon_TooFewPointsIsEmpty(t *testing.T) {
+	polygon := NewPolygon([][2]float32{{0, 0}, {10, 0}}, NewColorRGB(1, 1, 1))
+
+	assert.Empty(t, polygon.GetVertices())
+	assert.Empty(t, polygon.GetIndices())
+}
@@ -0,0 +1,238 @@
+package renderer
+
+import (
+	"math"
+
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+)
+
+// PolyLineのデフォルト値の定数
+const (
+	DefaultMiterLimit         = 4.0 // マイター長と線幅の半分の比の上限（SVGと同じ）
+	DefaultPolyLineRoundSteps = 8   // 丸い接合部・端点を構成する分割数
+	polyLineEpsilon           = 1e-6
+)
+
+// LineJoin は線分同士の接合部のスタイルを表す
+type LineJoin int
+
+const (
+	// JoinMiter は外側の辺を延長して尖った角で接合する
+	JoinMiter LineJoin = iota
+	// JoinBevel は外側の角を直線で切り落として接合する
+	JoinBevel
+	// JoinRound は外側の角を円弧で接合する
+	JoinRound
+)
+
+// LineCap は線の端点のスタイルを表す
+type LineCap int
+
+const (
+	// CapButt は端点で線を切り落とす
+	CapButt LineCap = iota
+	// CapRound は端点に半円を追加する
+	CapRound
+	// CapSquare は端点を線幅の半分だけ延長する
+	CapSquare
+)
+
+// PolyLine は連続した線分からなる太線プリミティブ
+// 各線分は矩形（2つの三角形）に展開され、接合部と端点には追加のジオメトリが生成される
+type PolyLine struct {
+	Points        [][2]float32 // 頂点座標のリスト
+	Width         float32      // 線の太さ
+	Color         Color        // 色
+	Join          LineJoin     // 接合部のスタイル
+	Cap           LineCap      // 端点のスタイル
+	MiterLimit    float32      // マイター接合の上限（超えた場合はベベルになる）
+	RoundSegments int          // 丸い接合部・端点の分割数
+}
+
+// NewPolyLine は新しいPolyLineを作成する
+func NewPolyLine(points [][2]float32, width float32, color Color) *PolyLine {
+	return &PolyLine{
+		Points:        points,
+		Width:         width,
+		Color:         color,
+		Join:          JoinMiter,
+		Cap:           CapButt,
+		MiterLimit:    DefaultMiterLimit,
+		RoundSegments: DefaultPolyLineRoundSteps,
+	}
+}
+
+// GetVertices はPolyLineの頂点データを取得する
+func (p *PolyLine) GetVertices() []float32 {
+	vertices, _ := p.buildGeometry()
+	return vertices
+}
+
+// GetIndices はPolyLineのインデックスデータを取得する
+func (p *PolyLine) GetIndices() []uint32 {
+	_, indices := p.buildGeometry()
+	return indices
+}
+
+// GetColor はPolyLineの色を取得する
+func (p *PolyLine) GetColor() Color {
+	return p.Color
+}
+
+// GetType はPolyLineのプリミティブタイプを取得する
+func (p *PolyLine) GetType() PrimitiveType {
+	return PrimitiveTypePolyLine
+}
+
+// perpendicular はベクトルを90度回転した法線方向を返す
+func perpendicular(v mathlib.Vector2) mathlib.Vector2 {
+	return mathlib.Vector2{X: -v.Y, Y: v.X}
+}
+
+// geometryBuilder は頂点とインデックスを蓄積する
+type geometryBuilder struct {
+	vertices []float32
+	indices  []uint32
+}
+
+// addVertex は頂点を追加してそのインデックスを返す
+func (b *geometryBuilder) addVertex(v mathlib.Vector2) uint32 {
+	index := uint32(len(b.vertices) / 3)
+	b.vertices = append(b.vertices, float32(v.X), float32(v.Y), 0.0)
+	return index
+}
+
+// addTriangle は三角形を追加する
+func (b *geometryBuilder) addTriangle(v0, v1, v2 mathlib.Vector2) {
+	i0 := b.addVertex(v0)
+	i1 := b.addVertex(v1)
+	i2 := b.addVertex(v2)
+	b.indices = append(b.indices, i0, i1, i2)
+}
+
+// addQuad は4頂点（a0, a1 が一辺、b0, b1 が対辺）の矩形を追加する
+func (b *geometryBuilder) addQuad(a0, a1, b0, b1 mathlib.Vector2) {
+	i0 := b.addVertex(a0)
+	i1 := b.addVertex(a1)
+	i2 := b.addVertex(b0)
+	i3 := b.addVertex(b1)
+	b.indices = append(b.indices, i0, i1, i2, i2, i1, i3)
+}
+
+// addFan は中心点から角度startAngleをsweepだけ回転する扇形を追加する
+func (b *geometryBuilder) addFan(center mathlib.Vector2, radius, startAngle, sweep float64, segments int) {
+	if segments < 1 {
+		segments = 1
+	}
+	centerIndex := b.addVertex(center)
+	for i := 0; i <= segments; i++ {
+		angle := startAngle + sweep*float64(i)/float64(segments)
+		b.addVertex(center.Add(mathlib.Vector2{X: math.Cos(angle), Y: math.Sin(angle)}.Scale(radius)))
+		if i > 0 {
+			current := centerIndex + uint32(i) + 1
+			b.indices = append(b.indices, centerIndex, current-1, current)
+		}
+	}
+}
+
+// distinctPoints は連続する重複点を取り除いた頂点列を返す
+func (p *PolyLine) distinctPoints() []mathlib.Vector2 {
+	points := make([]mathlib.Vector2, 0, len(p.Points))
+	for _, point := range p.Points {
+		v := mathlib.Vector2{X: float64(point[0]), Y: float64(point[1])}
+		if len(points) > 0 && v.Sub(points[len(points)-1]).Length() < polyLineEpsilon {
+			continue
+		}
+		points = append(points, v)
+	}
+	return points
+}
+
+// buildGeometry は線分・接合部・端点の三角形ジオメトリを生成する
+func (p *PolyLine) buildGeometry() ([]float32, []uint32) {
+	points := p.distinctPoints()
+	if len(points) < 2 || p.Width <= 0 {
+		return []float32{}, []uint32{}
+	}
+
+	halfWidth := float64(p.Width) / 2.0
+	builder := &geometryBuilder{}
+
+	// 各線分を矩形に展開
+	for i := 0; i < len(points)-1; i++ {
+		normal := perpendicular(points[i+1].Sub(points[i]).Normalize()).Scale(halfWidth)
+		builder.addQuad(
+			points[i].Add(normal), points[i].Sub(normal),
+			points[i+1].Add(normal), points[i+1].Sub(normal),
+		)
+	}
+
+	// 接合部
+	for i := 1; i < len(points)-1; i++ {
+		p.addJoin(builder, points[i-1], points[i], points[i+1], halfWidth)
+	}
+
+	// 端点
+	first := points[1].Sub(points[0]).Normalize()
+	last := points[len(points)-1].Sub(points[len(points)-2]).Normalize()
+	p.addCap(builder, points[0], first.Scale(-1), halfWidth)
+	p.addCap(builder, points[len(points)-1], last, halfWidth)
+
+	return builder.vertices, builder.indices
+}
+
+// addJoin は頂点cornerにおける2線分の接合部ジオメトリを追加する
+func (p *PolyLine) addJoin(builder *geometryBuilder, prev, corner, next mathlib.Vector2, halfWidth float64) {
+	d0 := corner.Sub(prev).Normalize()
+	d1 := next.Sub(corner).Normalize()
+
+	turn := d0.Cross(d1)
+	if math.Abs(turn) < polyLineEpsilon {
+		return // 同一直線上の場合は隙間ができない
+	}
+
+	// 曲がる方向と反対側（外側）に隙間ができる
+	side := 1.0
+	if turn > 0 {
+		side = -1.0
+	}
+	n0 := perpendicular(d0).Scale(side)
+	n1 := perpendicular(d1).Scale(side)
+	outer0 := corner.Add(n0.Scale(halfWidth))
+	outer1 := corner.Add(n1.Scale(halfWidth))
+
+	switch p.Join {
+	case JoinRound:
+		builder.addFan(corner, halfWidth, n0.Angle(), n0.AngleBetween(n1), p.RoundSegments)
+	case JoinMiter:
+		miterDir := n0.Add(n1).Normalize()
+		cosHalf := miterDir.Dot(n0)
+		// マイター長の比が上限を超える場合はベベルにフォールバック
+		if cosHalf > polyLineEpsilon && 1.0/cosHalf <= float64(p.MiterLimit) {
+			miter := corner.Add(miterDir.Scale(halfWidth / cosHalf))
+			start := builder.addVertex(corner)
+			builder.addVertex(outer0)
+			builder.addVertex(miter)
+			builder.addVertex(outer1)
+			builder.indices = append(builder.indices, start, start+1, start+2, start, start+2, start+3)
+			return
+		}
+		builder.addTriangle(corner, outer0, outer1)
+	default:
+		builder.addTriangle(corner, outer0, outer1)
+	}
+}
+
+// addCap は端点endpointに外向き方向outwardの端点ジオメトリを追加する
+func (p *PolyLine) addCap(builder *geometryBuilder, endpoint, outward mathlib.Vector2, halfWidth float64) {
+	normal := perpendicular(outward).Scale(halfWidth)
+
+	switch p.Cap {
+	case CapSquare:
+		extended := endpoint.Add(outward.Scale(halfWidth))
+		builder.addQuad(endpoint.Sub(normal), endpoint.Add(normal), extended.Sub(normal), extended.Add(normal))
+	case CapRound:
+		// 法線の片側から外向き方向を経由して反対側まで半円を描く
+		builder.addFan(endpoint, halfWidth, normal.Scale(-1).Angle(), math.Pi, p.RoundSegments)
+	}
+}
@@ -0,0 +1,189 @@
+package renderer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// L字型のテスト用PolyLine（(0,0)→(10,0)→(10,10)、線幅2）
+func newTestLPolyLine(join LineJoin) *PolyLine {
+	points := [][2]float32{{0, 0}, {10, 0}, {10, 10}}
+	polyLine := NewPolyLine(points, 2, NewColorRGB(1.0, 1.0, 1.0))
+	polyLine.Join = join
+	return polyLine
+}
+
+// vertexAt は頂点データからi番目の頂点座標を取得する
+func vertexAt(vertices []float32, i int) (float32, float32) {
+	return vertices[i*3], vertices[i*3+1]
+}
+
+func TestNewPolyLine(t *testing.T) {
+	color := NewColorRGB(1.0, 0.0, 0.0)
+	points := [][2]float32{{0, 0}, {10, 0}}
+
+	polyLine := NewPolyLine(points, 3, color)
+
+	assert.Equal(t, points, polyLine.Points)
+	assert.Equal(t, float32(3), polyLine.Width)
+	assert.Equal(t, JoinMiter, polyLine.Join)
+	assert.Equal(t, CapButt, polyLine.Cap)
+	assert.Equal(t, float32(DefaultMiterLimit), polyLine.MiterLimit)
+	assert.Equal(t, color, polyLine.GetColor())
+	assert.Equal(t, PrimitiveTypePolyLine, polyLine.GetType())
+}
+
+func TestPolyLine_SingleSegment(t *testing.T) {
+	polyLine := NewPolyLine([][2]float32{{0, 0}, {10, 0}}, 2, NewColorRGB(1.0, 1.0, 1.0))
+
+	vertices := polyLine.GetVertices()
+	indices := polyLine.GetIndices()
+
+	// 1線分 = 4頂点・2三角形
+	assert.Equal(t, 4*3, len(vertices))
+	assert.Equal(t, 6, len(indices))
+
+	expected := []float32{
+		0, 1, 0,
+		0, -1, 0,
+		10, 1, 0,
+		10, -1, 0,
+	}
+	assert.InDeltaSlice(t, expected, vertices, 1e-5)
+}
+
+func TestPolyLine_BevelJoin(t *testing.T) {
+	polyLine := newTestLPolyLine(JoinBevel)
+
+	vertices := polyLine.GetVertices()
+	indices := polyLine.GetIndices()
+
+	// 2線分(8頂点) + ベベル三角形(3頂点)
+	assert.Equal(t, 11*3, len(vertices))
+	assert.Equal(t, 15, len(indices))
+
+	// 接合部の三角形は角・外側の2点で構成される
+	x, y := vertexAt(vertices, 8)
+	assert.InDelta(t, 10.0, x, 1e-5)
+	assert.InDelta(t, 0.0, y, 1e-5)
+	x, y = vertexAt(vertices, 9)
+	assert.InDelta(t, 10.0, x, 1e-5)
+	assert.InDelta(t, -1.0, y, 1e-5)
+	x, y = vertexAt(vertices, 10)
+	assert.InDelta(t, 11.0, x, 1e-5)
+	assert.InDelta(t, 0.0, y, 1e-5)
+}
+
+func TestPolyLine_MiterJoin(t *testing.T) {
+	polyLine := newTestLPolyLine(JoinMiter)
+
+	vertices := polyLine.GetVertices()
+	indices := polyLine.GetIndices()
+
+	// 2線分(8頂点) + マイター(角・外側2点・マイター点の4頂点)
+	assert.Equal(t, 12*3, len(vertices))
+	assert.Equal(t, 18, len(indices))
+
+	// マイター点はL字の外側の角になる
+	x, y := vertexAt(vertices, 10)
+	assert.InDelta(t, 11.0, x, 1e-5)
+	assert.InDelta(t, -1.0, y, 1e-5)
+}
+
+func TestPolyLine_MiterJoin_FallbackToBevel(t *testing.T) {
+	// 鋭角に折り返す線はマイター上限を超えるためベベルになる
+	points := [][2]float32{{0, 0}, {10, 0}, {0, 1}}
+	polyLine := NewPolyLine(points, 2, NewColorRGB(1.0, 1.0, 1.0))
+
+	vertices := polyLine.GetVertices()
+	indices := polyLine.GetIndices()
+
+	assert.Equal(t, 11*3, len(vertices))
+	assert.Equal(t, 15, len(indices))
+}
+
+func TestPolyLine_RoundJoin(t *testing.T) {
+	polyLine := newTestLPolyLine(JoinRound)
+	polyLine.RoundSegments = 4
+
+	vertices := polyLine.GetVertices()
+	indices := polyLine.GetIndices()
+
+	// 2線分(8頂点) + 扇形(中心1 + 円弧5頂点)
+	assert.Equal(t, 14*3, len(vertices))
+	assert.Equal(t, 12+4*3, len(indices))
+
+	// 円弧上の頂点は角から線幅の半分の距離にある
+	for i := 9; i < 14; i++ {
+		x, y := vertexAt(vertices, i)
+		distance := math.Hypot(float64(x-10), float64(y))
+		assert.InDelta(t, 1.0, distance, 1e-5)
+	}
+
+	// 円弧は外側の2点を結ぶ
+	x, y := vertexAt(vertices, 9)
+	assert.InDelta(t, 10.0, x, 1e-5)
+	assert.InDelta(t, -1.0, y, 1e-5)
+	x, y = vertexAt(vertices, 13)
+	assert.InDelta(t, 11.0, x, 1e-5)
+	assert.InDelta(t, 0.0, y, 1e-5)
+}
+
+func TestPolyLine_CollinearPointsNoJoin(t *testing.T) {
+	points := [][2]float32{{0, 0}, {5, 0}, {10, 0}}
+	polyLine := NewPolyLine(points, 2, NewColorRGB(1.0, 1.0, 1.0))
+
+	// 同一直線上の接合部には追加ジオメトリを生成しない
+	assert.Equal(t, 8*3, len(polyLine.GetVertices()))
+	assert.Equal(t, 12, len(polyLine.GetIndices()))
+}
+
+func TestPolyLine_SquareCap(t *testing.T) {
+	polyLine := NewPolyLine([][2]float32{{0, 0}, {10, 0}}, 2, NewColorRGB(1.0, 1.0, 1.0))
+	polyLine.Cap = CapSquare
+
+	vertices := polyLine.GetVertices()
+
+	// 線分(4頂点) + 両端の矩形(4頂点ずつ)
+	assert.Equal(t, 12*3, len(vertices))
+	assert.Equal(t, 18, len(polyLine.GetIndices()))
+
+	// 始点側は線幅の半分だけ後方に延長される
+	x, _ := vertexAt(vertices, 6)
+	assert.InDelta(t, -1.0, x, 1e-5)
+	// 終点側は線幅の半分だけ前方に延長される
+	x, _ = vertexAt(vertices, 10)
+	assert.InDelta(t, 11.0, x, 1e-5)
+}
+
+func TestPolyLine_RoundCap(t *testing.T) {
+	polyLine := NewPolyLine([][2]float32{{0, 0}, {10, 0}}, 2, NewColorRGB(1.0, 1.0, 1.0))
+	polyLine.Cap = CapRound
+	polyLine.RoundSegments = 4
+
+	vertices := polyLine.GetVertices()
+
+	// 線分(4頂点) + 両端の半円(中心1 + 円弧5頂点ずつ)
+	assert.Equal(t, 16*3, len(vertices))
+	assert.Equal(t, 6+4*3*2, len(polyLine.GetIndices()))
+
+	// 始点側の半円の中間点は後方(-1, 0)にある
+	x, y := vertexAt(vertices, 7)
+	assert.InDelta(t, -1.0, x, 1e-5)
+	assert.InDelta(t, 0.0, y, 1e-5)
+	// 終点側の半円の中間点は前方(11, 0)にある
+	x, y = vertexAt(vertices, 13)
+	assert.InDelta(t, 11.0, x, 1e-5)
+	assert.InDelta(t, 0.0, y, 1e-5)
+}
+
+func TestPolyLine_DegenerateInput(t *testing.T) {
+	color := NewColorRGB(1.0, 1.0, 1.0)
+
+	// 1点のみ・重複点のみ・線幅0の場合は何も生成しない
+	assert.Empty(t, NewPolyLine([][2]float32{{0, 0}}, 2, color).GetVertices())
+	assert.Empty(t, NewPolyLine([][2]float32{{5, 5}, {5, 5}}, 2, color).GetVertices())
+	assert.Empty(t, NewPolyLine([][2]float32{{0, 0}, {10, 0}}, 0, color).GetIndices())
+}
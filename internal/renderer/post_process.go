@@ -0,0 +1,293 @@
+package renderer
+
+import (
+	"fmt"
+
+	"github.com/go-gl/gl/v4.1-core/gl"
+)
+
+// 組み込みのポストエフェクト名
+const (
+	PostEffectGrayscale = "grayscale"
+	PostEffectVignette  = "vignette"
+)
+
+// postProcessScreen はパスの書き込み先が画面であることを表す
+const postProcessScreen = -1
+
+// PostProcessVertexShaderSource は全画面四角形を描画する共通の頂点シェーダー
+const PostProcessVertexShaderSource = `#version 410 core
+layout (location = 0) in vec2 aPos;
+layout (location = 1) in vec2 aTexCoord;
+
+out vec2 vTexCoord;
+
+void main()
+{
+    vTexCoord = aTexCoord;
+    gl_Position = vec4(aPos, 0.0, 1.0);
+}`
+
+// 組み込みのポストエフェクト用フラグメントシェーダー
+// 前段の描画結果を u_texture から読み取る
+const (
+	GrayscaleFragmentShaderSource = `#version 410 core
+in vec2 vTexCoord;
+
+uniform sampler2D u_texture;
+
+out vec4 FragColor;
+
+void main()
+{
+    vec4 color = texture(u_texture, vTexCoord);
+    float luminance = dot(color.rgb, vec3(0.299, 0.587, 0.114));
+    FragColor = vec4(vec3(luminance), color.a);
+}`
+
+	VignetteFragmentShaderSource = `#version 410 core
+in vec2 vTexCoord;
+
+uniform sampler2D u_texture;
+
+out vec4 FragColor;
+
+void main()
+{
+    vec4 color = texture(u_texture, vTexCoord);
+    float distance = length(vTexCoord - vec2(0.5));
+    float vignette = smoothstep(0.75, 0.35, distance);
+    FragColor = vec4(color.rgb * vignette, color.a);
+}`
+)
+
+// builtinPostEffects は組み込みエフェクト名とフラグメントシェーダーの対応表
+var builtinPostEffects = map[string]string{
+	PostEffectGrayscale: GrayscaleFragmentShaderSource,
+	PostEffectVignette:  VignetteFragmentShaderSource,
+}
+
+// 全画面四角形の頂点データ（x, y, u, v）
+var (
+	fullscreenQuadVertices = []float32{
+		-1, -1, 0, 0,
+		1, -1, 1, 0,
+		1, 1, 1, 1,
+		-1, 1, 0, 1,
+	}
+	fullscreenQuadIndices = []uint32{0, 1, 2, 2, 3, 0}
+)
+
+// postProcessStep は1つのパスの読み込み元と書き込み先のRenderTargetの番号
+type postProcessStep struct {
+	source      int
+	destination int // postProcessScreen の場合は画面
+}
+
+// planPostProcess はパス数に応じたピンポンの読み書き先を決定する
+// シーンは0番に描画され、各パスは前段の結果を読んでもう一方に書き込み、最後のパスは画面に書き込む
+func planPostProcess(passCount int) []postProcessStep {
+	steps := make([]postProcessStep, passCount)
+	for i := range steps {
+		steps[i] = postProcessStep{source: i % 2, destination: (i + 1) % 2}
+	}
+	if passCount > 0 {
+		steps[passCount-1].destination = postProcessScreen
+	}
+	return steps
+}
+
+// postProcessPass は名前付きのポストエフェクトパス
+type postProcessPass struct {
+	name   string
+	shader *Shader
+	owned  bool // チェーンが作成したシェーダーか（Deleteで削除する）
+}
+
+// PostProcessChain はシーンをRenderTargetに描画し、全画面シェーダーのパスを順に適用して画面に出力する
+// 2枚のRenderTargetを交互に読み書き（ピンポン）するため、パスの数に関わらずメモリ使用量は一定
+type PostProcessChain struct {
+	renderer *OpenGLRenderer
+	backend  OpenGLBackend
+	targets  [2]*RenderTarget
+	passes  []postProcessPass
+
+	quadVAO     uint32
+	quadVBO     uint32
+	quadEBO     uint32
+	quadCreated bool
+}
+
+// NewPostProcessChain は指定サイズのPostProcessChainを作成する
+// シーンの描画先の切り替えは renderer の SetRenderTarget を通して行う
+func NewPostProcessChain(renderer *OpenGLRenderer, backend OpenGLBackend, width, height int) (*PostProcessChain, error) {
+	chain := &PostProcessChain{renderer: renderer, backend: backend}
+	if err := chain.createTargets(width, height); err != nil {
+		return nil, err
+	}
+	return chain, nil
+}
+
+// createTargets はピンポン用のRenderTargetを作成する
+func (c *PostProcessChain) createTargets(width, height int) error {
+	for i := range c.targets {
+		target, err := NewRenderTarget(c.backend, width, height)
+		if err != nil {
+			c.deleteTargets()
+			return fmt.Errorf("failed to create post-process target %d: %w", i, err)
+		}
+		c.targets[i] = target
+	}
+	return nil
+}
+
+// deleteTargets はRenderTargetを削除する
+func (c *PostProcessChain) deleteTargets() {
+	for i, target := range c.targets {
+		if target != nil {
+			target.Delete()
+			c.targets[i] = nil
+		}
+	}
+}
+
+// Resize はRenderTargetを作り直して指定サイズに合わせる
+func (c *PostProcessChain) Resize(width, height int) error {
+	c.deleteTargets()
+	return c.createTargets(width, height)
+}
+
+// AddPass はシェーダーを使用するパスを末尾に追加する
+// シェーダーは PostProcessVertexShaderSource と組み合わせ、u_texture から前段の結果を読み取る必要がある
+func (c *PostProcessChain) AddPass(name string, shader *Shader) {
+	c.passes = append(c.passes, postProcessPass{name: name, shader: shader})
+}
+
+// AddBuiltinPass は組み込みエフェクトのパスを末尾に追加する
+func (c *PostProcessChain) AddBuiltinPass(effect string) error {
+	fragmentSource, ok := builtinPostEffects[effect]
+	if !ok {
+		return fmt.Errorf("unknown post effect: %s", effect)
+	}
+
+	shader := NewShader(c.backend)
+	if err := shader.LoadVertexShader(PostProcessVertexShaderSource); err != nil {
+		return fmt.Errorf("post effect %s: %w", effect, err)
+	}
+	if err := shader.LoadFragmentShader(fragmentSource); err != nil {
+		shader.Delete()
+		return fmt.Errorf("post effect %s: %w", effect, err)
+	}
+	if err := shader.LinkProgram(); err != nil {
+		shader.Delete()
+		return fmt.Errorf("post effect %s: %w", effect, err)
+	}
+
+	c.passes = append(c.passes, postProcessPass{name: effect, shader: shader, owned: true})
+	return nil
+}
+
+// RemovePass は指定した名前のパスを削除する
+func (c *PostProcessChain) RemovePass(name string) bool {
+	for i, pass := range c.passes {
+		if pass.name == name {
+			if pass.owned {
+				pass.shader.Delete()
+			}
+			c.passes = append(c.passes[:i], c.passes[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// PassNames は適用順のパス名を取得する
+func (c *PostProcessChain) PassNames() []string {
+	names := make([]string, len(c.passes))
+	for i, pass := range c.passes {
+		names[i] = pass.name
+	}
+	return names
+}
+
+// Begin はレンダラーの描画先をシーン用のRenderTargetに設定する
+// パスがない場合は画面に直接描画する
+func (c *PostProcessChain) Begin() {
+	if len(c.passes) == 0 {
+		c.renderer.SetRenderTarget(nil)
+		return
+	}
+	c.renderer.SetRenderTarget(c.targets[0])
+}
+
+// End はレンダラーの描画先を画面に戻し、パスを順に適用して最終結果を指定サイズの画面に出力する
+// 描画先を戻す際にバッチやシーンの保留中の描画はシーン用のRenderTargetに描画される
+func (c *PostProcessChain) End(screenWidth, screenHeight int) {
+	c.renderer.SetRenderTarget(nil)
+	if len(c.passes) == 0 {
+		return
+	}
+
+	c.ensureQuad()
+	c.backend.BindVertexArray(c.quadVAO)
+	for i, step := range planPostProcess(len(c.passes)) {
+		if step.destination == postProcessScreen {
+			c.backend.BindFramebuffer(0)
+			c.backend.Viewport(0, 0, int32(screenWidth), int32(screenHeight))
+		} else {
+			c.targets[step.destination].Bind()
+		}
+
+		shader := c.passes[i].shader
+		shader.Use()
+		shader.SetUniformInt(shader.GetUniformLocation("u_texture"), 0)
+		c.targets[step.source].GetTexture().Bind()
+		c.backend.DrawElements(gl.TRIANGLES, int32(len(fullscreenQuadIndices)))
+	}
+	c.backend.BindTexture(gl.TEXTURE_2D, 0)
+	c.backend.BindVertexArray(0)
+}
+
+// ensureQuad は全画面四角形のバッファを必要に応じて作成する
+func (c *PostProcessChain) ensureQuad() {
+	if c.quadCreated {
+		return
+	}
+
+	c.quadVAO = c.backend.GenVertexArray()
+	c.quadVBO = c.backend.GenBuffer()
+	c.quadEBO = c.backend.GenBuffer()
+
+	c.backend.BindVertexArray(c.quadVAO)
+	c.backend.BindBuffer(gl.ARRAY_BUFFER, c.quadVBO)
+	c.backend.BufferDataFloat32(gl.ARRAY_BUFFER, fullscreenQuadVertices, gl.STATIC_DRAW)
+	c.backend.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, c.quadEBO)
+	c.backend.BufferDataUint32(gl.ELEMENT_ARRAY_BUFFER, fullscreenQuadIndices, gl.STATIC_DRAW)
+
+	stride := int32(4 * FloatSizeBytes)
+	c.backend.VertexAttribPointer(0, 2, stride, 0)
+	c.backend.EnableVertexAttribArray(0)
+	c.backend.VertexAttribPointer(1, 2, stride, 2*FloatSizeBytes)
+	c.backend.EnableVertexAttribArray(1)
+	c.backend.BindVertexArray(0)
+
+	c.quadCreated = true
+}
+
+// Delete はRenderTarget、全画面四角形のバッファ、チェーンが作成したシェーダーを削除する
+func (c *PostProcessChain) Delete() {
+	c.deleteTargets()
+	for _, pass := range c.passes {
+		if pass.owned {
+			pass.shader.Delete()
+		}
+	}
+	c.passes = nil
+
+	if c.quadCreated {
+		c.backend.DeleteBuffer(c.quadVBO)
+		c.backend.DeleteBuffer(c.quadEBO)
+		c.backend.DeleteVertexArray(c.quadVAO)
+		c.quadCreated = false
+	}
+}
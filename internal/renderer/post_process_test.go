@@ -0,0 +1,157 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/v4.1-core/gl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// expectTextureCreation はテクスチャ作成時のバックエンド呼び出しを許可する
+func expectTextureCreation(backend *MockOpenGLBackend, id uint32) {
+	backend.On("GenTexture").Return(id).Once()
+	backend.On("BindTexture", uint32(gl.TEXTURE_2D), mock.Anything).Return()
+	backend.On("TexParameteri", mock.Anything, mock.Anything, mock.Anything).Return()
+	backend.On("TexImage2D", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+}
+
+func TestPlanPostProcess_PingPong(t *testing.T) {
+	for passCount := 1; passCount <= 5; passCount++ {
+		steps := planPostProcess(passCount)
+
+		require.Len(t, steps, passCount)
+		assert.Equal(t, 0, steps[0].source, "最初のパスはシーンの描画先を読む")
+		assert.Equal(t, postProcessScreen, steps[passCount-1].destination, "最後のパスは画面に書き込む")
+		for i, step := range steps {
+			assert.NotEqual(t, step.source, step.destination, "パス%dが同じターゲットを読み書きしている", i)
+			if i > 0 {
+				assert.Equal(t, steps[i-1].destination, step.source, "パス%dは前段の結果を読む", i)
+			}
+		}
+	}
+}
+
+func TestPlanPostProcess_NoPasses(t *testing.T) {
+	assert.Empty(t, planPostProcess(0))
+}
+
+func TestNewRenderTarget_AttachesTexture(t *testing.T) {
+	// Arrange
+	backend := NewMockOpenGLBackend()
+	expectTextureCreation(backend, 7)
+	backend.On("GenFramebuffer").Return(uint32(3))
+	backend.On("BindFramebuffer", mock.Anything).Return()
+	backend.On("FramebufferTexture2D", uint32(7)).Return()
+	backend.On("CheckFramebufferStatus").Return(uint32(gl.FRAMEBUFFER_COMPLETE))
+	backend.On("Viewport", int32(0), int32(0), int32(320), int32(240)).Return()
+
+	// Act
+	target, err := NewRenderTarget(backend, 320, 240)
+	require.NoError(t, err)
+	target.Bind()
+
+	// Assert
+	assert.Equal(t, uint32(7), target.GetTexture().GetID())
+	assert.Equal(t, 320, target.Width())
+	assert.Equal(t, 240, target.Height())
+	backend.AssertCalled(t, "BindFramebuffer", uint32(3))
+	backend.AssertCalled(t, "Viewport", int32(0), int32(0), int32(320), int32(240))
+}
+
+func TestNewRenderTarget_IncompleteFramebuffer(t *testing.T) {
+	backend := NewMockOpenGLBackend()
+	expectTextureCreation(backend, 7)
+	backend.On("GenFramebuffer").Return(uint32(3))
+	backend.On("BindFramebuffer", mock.Anything).Return()
+	backend.On("FramebufferTexture2D", uint32(7)).Return()
+	backend.On("CheckFramebufferStatus").Return(uint32(gl.FRAMEBUFFER_UNSUPPORTED))
+	backend.On("DeleteFramebuffer", uint32(3)).Return()
+	backend.On("DeleteTexture", uint32(7)).Return()
+
+	target, err := NewRenderTarget(backend, 320, 240)
+
+	assert.Error(t, err)
+	assert.Nil(t, target)
+	backend.AssertCalled(t, "DeleteFramebuffer", uint32(3))
+	backend.AssertCalled(t, "DeleteTexture", uint32(7))
+}
+
+func TestPostProcessChain_AddBuiltinPass_Unknown(t *testing.T) {
+	chain := &PostProcessChain{backend: NewMockOpenGLBackend()}
+
+	err := chain.AddBuiltinPass("sepia-deluxe")
+
+	assert.Error(t, err)
+	assert.Empty(t, chain.PassNames())
+}
+
+func TestPostProcessChain_PassManagement(t *testing.T) {
+	chain := &PostProcessChain{backend: NewMockOpenGLBackend()}
+
+	chain.AddPass("blur", &Shader{})
+	chain.AddPass(PostEffectVignette, &Shader{})
+
+	assert.Equal(t, []string{"blur", PostEffectVignette}, chain.PassNames())
+	assert.True(t, chain.RemovePass("blur"))
+	assert.False(t, chain.RemovePass("blur"))
+	assert.Equal(t, []string{PostEffectVignette}, chain.PassNames())
+}
+
+func TestPostProcessChain_BeginWithoutPassesDrawsToScreen(t *testing.T) {
+	backend := NewMockOpenGLBackend()
+	backend.On("BindFramebuffer", uint32(0)).Return()
+	offscreen := &RenderTarget{backend: backend, framebuffer: 5, bound: true}
+	renderer := &OpenGLRenderer{renderTarget: offscreen}
+	chain := &PostProcessChain{renderer: renderer, backend: backend}
+
+	chain.Begin()
+	chain.End(800, 600)
+
+	assert.Nil(t, renderer.GetRenderTarget())
+	backend.AssertCalled(t, "BindFramebuffer", uint32(0))
+	backend.AssertNotCalled(t, "DrawElements", mock.Anything, mock.Anything)
+}
+
+func TestPostProcessChain_FlushesBatchIntoSceneTarget(t *testing.T) {
+	// Arrange
+	backend := newMeshTestBackend()
+	expectTextureCreation(backend, 7)
+	expectTextureCreation(backend, 8)
+	backend.On("GenFramebuffer").Return(uint32(3))
+	backend.On("BindFramebuffer", mock.Anything).Return()
+	backend.On("FramebufferTexture2D", mock.Anything).Return()
+	backend.On("CheckFramebufferStatus").Return(uint32(gl.FRAMEBUFFER_COMPLETE))
+	backend.On("Viewport", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	backend.On("UseProgram", mock.Anything).Return()
+	backend.On("GetUniformLocation", mock.Anything, "u_texture").Return(int32(0))
+	backend.On("Uniform1i", mock.Anything, mock.Anything).Return()
+
+	renderer := &OpenGLRenderer{}
+	renderer.BeginBatch()
+	chain, err := NewPostProcessChain(renderer, backend, 320, 240)
+	require.NoError(t, err)
+	chain.AddPass(PostEffectGrayscale, newTestShaderWithProgram(backend, 1))
+
+	var events []string
+	var batchTarget *RenderTarget
+	renderer.batch.flushFunc = func(vertices []float32, indices []uint32, color Color, primitiveType PrimitiveType) {
+		events = append(events, "batch")
+		batchTarget = renderer.GetRenderTarget()
+	}
+	backend.On("DrawElements", mock.Anything, mock.Anything).Return().Run(func(mock.Arguments) {
+		events = append(events, "pass")
+	})
+
+	// Act
+	chain.Begin()
+	renderer.DrawPrimitive(NewRectangle(0, 0, 10, 10, NewColorRGB(1, 1, 1)))
+	chain.End(800, 600)
+
+	// Assert
+	// バッチに溜まった描画はパスの適用前にシーン用のRenderTargetへ描画される
+	assert.Equal(t, []string{"batch", "pass"}, events)
+	assert.Same(t, chain.targets[0], batchTarget)
+	assert.Nil(t, renderer.GetRenderTarget())
+}
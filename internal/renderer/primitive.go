@@ -8,6 +8,7 @@ import (
 const (
 	DefaultCircleSegments = 32  // 円のデフォルトセグメント数
 	DefaultLineWidth      = 1.0 // 線のデフォルト幅
+	DefaultPointSize      = 1.0 // 点のデフォルトサイズ（ピクセル）
 	DefaultAlpha          = 1.0 // デフォルトアルファ値
 )
 
@@ -26,6 +27,16 @@ func NewColorRGB(r, g, b float32) Color {
 	return Color{R: r, G: g, B: b, A: DefaultAlpha}
 }
 
+// Multiply は各成分を掛け合わせた色を返す（ティント適用などに使用）
+func (c Color) Multiply(other Color) Color {
+	return Color{
+		R: c.R * other.R,
+		G: c.G * other.G,
+		B: c.B * other.B,
+		A: c.A * other.A,
+	}
+}
+
 // Primitive は描画プリミティブの基底インターフェース
 type Primitive interface {
 	// GetVertices は頂点データを取得する
@@ -49,6 +60,13 @@ const (
 	PrimitiveTypeRectangle
 	PrimitiveTypeCircle
 	PrimitiveTypeLine
+	PrimitiveTypePolyLine
+	PrimitiveTypePolygon
+	PrimitiveTypeEllipse
+	PrimitiveTypeArc
+	PrimitiveTypeRoundedRectangle
+	PrimitiveTypePoint
+	PrimitiveTypeSprite
 )
 
 // Rectangle は矩形プリミティブ
@@ -102,6 +120,148 @@ func (r *Rectangle) GetType() PrimitiveType {
 	return PrimitiveTypeRectangle
 }
 
+// Triangle は三角形プリミティブ
+type Triangle struct {
+	X1, Y1 float32 // 第1頂点
+	X2, Y2 float32 // 第2頂点
+	X3, Y3 float32 // 第3頂点
+	Color  Color   // 色
+}
+
+// NewTriangle は新しい三角形を作成する
+func NewTriangle(x1, y1, x2, y2, x3, y3 float32, color Color) *Triangle {
+	return &Triangle{
+		X1:    x1,
+		Y1:    y1,
+		X2:    x2,
+		Y2:    y2,
+		X3:    x3,
+		Y3:    y3,
+		Color: color,
+	}
+}
+
+// GetVertices は三角形の頂点データを取得する
+func (t *Triangle) GetVertices() []float32 {
+	return []float32{
+		t.X1, t.Y1, 0.0, // 第1頂点
+		t.X2, t.Y2, 0.0, // 第2頂点
+		t.X3, t.Y3, 0.0, // 第3頂点
+	}
+}
+
+// GetIndices は三角形のインデックスデータを取得する
+func (t *Triangle) GetIndices() []uint32 {
+	return []uint32{0, 1, 2}
+}
+
+// GetColor は三角形の色を取得する
+func (t *Triangle) GetColor() Color {
+	return t.Color
+}
+
+// GetType は三角形のプリミティブタイプを取得する
+func (t *Triangle) GetType() PrimitiveType {
+	return PrimitiveTypeTriangle
+}
+
+// RoundedRectangle は角の丸い矩形プリミティブ
+type RoundedRectangle struct {
+	X, Y           float32 // 左上角の座標
+	Width, Height  float32 // 幅と高さ
+	CornerRadius   float32 // 角の半径（min(Width, Height)/2 を上限とする）
+	Color          Color   // 色
+	CornerSegments int     // 角1つあたりの円弧の線分数
+}
+
+// NewRoundedRectangle は新しい角丸矩形を作成する
+// cornerRadius は 0 以上 min(width, height)/2 以下に、cornerSegments は1以上に補正される
+func NewRoundedRectangle(x, y, width, height, cornerRadius float32, color Color, cornerSegments int) *RoundedRectangle {
+	maxRadius := width / 2
+	if height < width {
+		maxRadius = height / 2
+	}
+	if cornerRadius > maxRadius {
+		cornerRadius = maxRadius
+	}
+	if cornerRadius < 0 {
+		cornerRadius = 0
+	}
+	if cornerSegments < 1 {
+		cornerSegments = 1
+	}
+
+	return &RoundedRectangle{
+		X:              x,
+		Y:              y,
+		Width:          width,
+		Height:         height,
+		CornerRadius:   cornerRadius,
+		Color:          color,
+		CornerSegments: cornerSegments,
+	}
+}
+
+// GetVertices は角丸矩形の頂点データを取得する
+// 中心点の後に左上・右上・右下・左下の順で各角の円弧上の点が続き、最後に最初の外周点を繰り返して閉じる
+// 角の間の直線の辺は、隣り合う円弧の端点を結ぶ三角形で塗りつぶされる
+func (r *RoundedRectangle) GetVertices() []float32 {
+	perimeterCount := 4 * (r.CornerSegments + 1)
+	vertices := make([]float32, 0, (perimeterCount+2)*3)
+
+	// 中心点
+	vertices = append(vertices, r.X+r.Width/2, r.Y+r.Height/2, 0.0)
+
+	radius := r.CornerRadius
+	corners := []struct {
+		centerX, centerY float32
+		startAngle       float64
+	}{
+		{r.X + radius, r.Y + radius, math.Pi},                  // 左上
+		{r.X + r.Width - radius, r.Y + radius, math.Pi * 1.5},  // 右上
+		{r.X + r.Width - radius, r.Y + r.Height - radius, 0},   // 右下
+		{r.X + radius, r.Y + r.Height - radius, math.Pi * 0.5}, // 左下
+	}
+
+	for _, corner := range corners {
+		for i := 0; i <= r.CornerSegments; i++ {
+			angle := corner.startAngle + (math.Pi/2)*float64(i)/float64(r.CornerSegments)
+			x := corner.centerX + radius*float32(math.Cos(angle))
+			y := corner.centerY + radius*float32(math.Sin(angle))
+			vertices = append(vertices, x, y, 0.0)
+		}
+	}
+
+	// 最初の外周点で閉じる
+	vertices = append(vertices, vertices[3], vertices[4], 0.0)
+
+	return vertices
+}
+
+// GetIndices は角丸矩形のインデックスデータを取得する
+func (r *RoundedRectangle) GetIndices() []uint32 {
+	perimeterCount := 4 * (r.CornerSegments + 1)
+	indices := make([]uint32, perimeterCount*3)
+
+	for i := 0; i < perimeterCount; i++ {
+		indices[i*3] = 0               // 中心点
+		indices[i*3+1] = uint32(i + 1) // 現在の外周点
+		indices[i*3+2] = uint32(i + 2) // 次の外周点
+	}
+
+	return indices
+}
+
+// GetColor は角丸矩形の色を取得する
+func (r *RoundedRectangle) GetColor() Color {
+	return r.Color
+}
+
+// GetType は角丸矩形のプリミティブタイプを取得する
+func (r *RoundedRectangle) GetType() PrimitiveType {
+	return PrimitiveTypeRoundedRectangle
+}
+
 // Circle は円プリミティブ
 type Circle struct {
 	X, Y   float32 // 中心座標
@@ -179,12 +339,204 @@ func (c *Circle) GetType() PrimitiveType {
 	return PrimitiveTypeCircle
 }
 
+// Ellipse は楕円プリミティブ
+type Ellipse struct {
+	X, Y             float32 // 中心座標
+	RadiusX, RadiusY float32 // X方向・Y方向の半径
+	Color            Color   // 色
+	Segments         int     // 楕円を構成する線分数（デフォルト32）
+}
+
+// NewEllipse は新しい楕円を作成する
+func NewEllipse(x, y, radiusX, radiusY float32, color Color) *Ellipse {
+	return NewEllipseWithSegments(x, y, radiusX, radiusY, color, DefaultCircleSegments)
+}
+
+// NewEllipseWithSegments は線分数を指定して新しい楕円を作成する
+func NewEllipseWithSegments(x, y, radiusX, radiusY float32, color Color, segments int) *Ellipse {
+	return &Ellipse{
+		X:        x,
+		Y:        y,
+		RadiusX:  radiusX,
+		RadiusY:  radiusY,
+		Color:    color,
+		Segments: segments,
+	}
+}
+
+// GetVertices は楕円の頂点データを取得する
+// Circle と同じ扇形の頂点配置で、cos/sin をそれぞれ RadiusX/RadiusY で拡大する
+func (e *Ellipse) GetVertices() []float32 {
+	vertices := make([]float32, (e.Segments+2)*3) // 中心点 + 外周点 + 最初の外周点
+	
+	// 中心点
+	vertices[0] = e.X
+	vertices[1] = e.Y
+	vertices[2] = 0.0
+	
+	// 外周点を計算
+	for i := 0; i <= e.Segments; i++ {
+		angle := 2.0 * math.Pi * float64(i) / float64(e.Segments)
+		idx := (i + 1) * 3
+		vertices[idx] = e.X + e.RadiusX*float32(math.Cos(angle))
+		vertices[idx+1] = e.Y + e.RadiusY*float32(math.Sin(angle))
+		vertices[idx+2] = 0.0
+	}
+	
+	return vertices
+}
+
+// GetIndices は楕円のインデックスデータを取得する
+func (e *Ellipse) GetIndices() []uint32 {
+	indices := make([]uint32, e.Segments*3)
+	
+	for i := 0; i < e.Segments; i++ {
+		indices[i*3] = 0               // 中心点
+		indices[i*3+1] = uint32(i + 1) // 現在の外周点
+		indices[i*3+2] = uint32(i + 2) // 次の外周点
+	}
+	
+	return indices
+}
+
+// GetColor は楕円の色を取得する
+func (e *Ellipse) GetColor() Color {
+	return e.Color
+}
+
+// GetType は楕円のプリミティブタイプを取得する
+func (e *Ellipse) GetType() PrimitiveType {
+	return PrimitiveTypeEllipse
+}
+
+// Arc は円の一部（扇形）プリミティブ
+// 円グラフやクールダウン表示など、角度範囲だけを塗りつぶす場合に使用する
+type Arc struct {
+	X, Y       float32 // 中心座標
+	Radius     float32 // 半径
+	StartAngle float64 // 開始角度（ラジアン）
+	EndAngle   float64 // 終了角度（ラジアン）。開始角度より小さい場合は1周分加えて扱う
+	Color      Color   // 色
+	Segments   int     // 扇形の弧を構成する線分数（デフォルト32）
+}
+
+// NewArc は新しい扇形を作成する
+func NewArc(x, y, radius float32, startAngle, endAngle float64, color Color) *Arc {
+	return NewArcWithSegments(x, y, radius, startAngle, endAngle, color, DefaultCircleSegments)
+}
+
+// NewArcWithSegments は線分数を指定して新しい扇形を作成する
+func NewArcWithSegments(x, y, radius float32, startAngle, endAngle float64, color Color, segments int) *Arc {
+	return &Arc{
+		X:          x,
+		Y:          y,
+		Radius:     radius,
+		StartAngle: startAngle,
+		EndAngle:   endAngle,
+		Color:      color,
+		Segments:   segments,
+	}
+}
+
+// Sweep は開始角度から終了角度までの角度（ラジアン）を取得する
+// 終了角度が開始角度より小さい場合は 2π を加えて折り返す
+func (a *Arc) Sweep() float64 {
+	sweep := a.EndAngle - a.StartAngle
+	if sweep < 0 {
+		sweep += 2.0 * math.Pi
+	}
+	return sweep
+}
+
+// GetVertices は扇形の頂点データを取得する
+// 中心点の後に開始角度から終了角度までの外周点が Segments+1 個続く
+func (a *Arc) GetVertices() []float32 {
+	vertices := make([]float32, (a.Segments+2)*3) // 中心点 + 外周点
+	
+	// 中心点
+	vertices[0] = a.X
+	vertices[1] = a.Y
+	vertices[2] = 0.0
+	
+	// 外周点を計算
+	sweep := a.Sweep()
+	for i := 0; i <= a.Segments; i++ {
+		angle := a.StartAngle + sweep*float64(i)/float64(a.Segments)
+		idx := (i + 1) * 3
+		vertices[idx] = a.X + a.Radius*float32(math.Cos(angle))
+		vertices[idx+1] = a.Y + a.Radius*float32(math.Sin(angle))
+		vertices[idx+2] = 0.0
+	}
+	
+	return vertices
+}
+
+// GetIndices は扇形のインデックスデータを取得する
+func (a *Arc) GetIndices() []uint32 {
+	indices := make([]uint32, a.Segments*3)
+	
+	for i := 0; i < a.Segments; i++ {
+		indices[i*3] = 0               // 中心点
+		indices[i*3+1] = uint32(i + 1) // 現在の外周点
+		indices[i*3+2] = uint32(i + 2) // 次の外周点
+	}
+	
+	return indices
+}
+
+// GetColor は扇形の色を取得する
+func (a *Arc) GetColor() Color {
+	return a.Color
+}
+
+// GetType は扇形のプリミティブタイプを取得する
+func (a *Arc) GetType() PrimitiveType {
+	return PrimitiveTypeArc
+}
+
+// Point は点プリミティブ
+type Point struct {
+	X, Y  float32 // 座標
+	Size  float32 // 点の大きさ（ピクセル）
+	Color Color   // 色
+}
+
+// NewPoint は新しい点を作成する
+func NewPoint(x, y float32, color Color) *Point {
+	return &Point{
+		X:     x,
+		Y:     y,
+		Size:  DefaultPointSize,
+		Color: color,
+	}
+}
+
+// GetVertices は点の頂点データを取得する
+func (p *Point) GetVertices() []float32 {
+	return []float32{p.X, p.Y, 0.0}
+}
+
+// GetIndices は点のインデックスデータを取得する（点は不要なため空）
+func (p *Point) GetIndices() []uint32 {
+	return []uint32{}
+}
+
+// GetColor は点の色を取得する
+func (p *Point) GetColor() Color {
+	return p.Color
+}
+
+// GetType は点のプリミティブタイプを取得する
+func (p *Point) GetType() PrimitiveType {
+	return PrimitiveTypePoint
+}
+
 // Line は線プリミティブ
 type Line struct {
 	X1, Y1 float32 // 開始点
 	X2, Y2 float32 // 終了点
 	Color  Color   // 色
-	Width  float32 // 線の太さ（将来対応）
+	Width  float32 // 線の太さ（DefaultLineWidth を超える場合は四角形に展開して描画）
 }
 
 // NewLine は新しい線を作成する
@@ -199,6 +551,13 @@ func NewLine(x1, y1, x2, y2 float32, color Color) *Line {
 	}
 }
 
+// NewLineWithWidth は太さを指定して新しい線を作成する
+func NewLineWithWidth(x1, y1, x2, y2, width float32, color Color) *Line {
+	line := NewLine(x1, y1, x2, y2, color)
+	line.Width = width
+	return line
+}
+
 // GetVertices は線の頂点データを取得する
 func (l *Line) GetVertices() []float32 {
 	return []float32{
@@ -220,4 +579,59 @@ func (l *Line) GetColor() Color {
 // GetType は線のプリミティブタイプを取得する
 func (l *Line) GetType() PrimitiveType {
 	return PrimitiveTypeLine
-}
\ No newline at end of file
+}
+
+// IsThick は線を四角形に展開して描画する太さかどうかを判定する
+func (l *Line) IsThick() bool {
+	return l.Width > DefaultLineWidth
+}
+
+// GetQuadVertices は線分を法線方向に太さの半分ずつ広げた四角形の頂点データを取得する
+// 頂点順は 始点+法線、終点+法線、終点-法線、始点-法線 で、GetQuadIndices の2つの三角形で塗りつぶす
+// 長さ0の線分は方向が定まらないため nil を返す
+func (l *Line) GetQuadVertices() []float32 {
+	dx := float64(l.X2 - l.X1)
+	dy := float64(l.Y2 - l.Y1)
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return nil
+	}
+
+	halfWidth := float64(l.Width) / 2.0
+	nx := float32(-dy / length * halfWidth)
+	ny := float32(dx / length * halfWidth)
+
+	return []float32{
+		l.X1 + nx, l.Y1 + ny, 0.0,
+		l.X2 + nx, l.Y2 + ny, 0.0,
+		l.X2 - nx, l.Y2 - ny, 0.0,
+		l.X1 - nx, l.Y1 - ny, 0.0,
+	}
+}
+
+// GetQuadIndices は GetQuadVertices の四角形を構成するインデックスデータを取得する
+func (l *Line) GetQuadIndices() []uint32 {
+	return []uint32{
+		0, 1, 2,
+		2, 3, 0,
+	}
+}
+
+// tintedPrimitive は元のプリミティブの色にティントを乗算して返すラッパー
+type tintedPrimitive struct {
+	Primitive
+	tint Color
+}
+
+// newTintedPrimitive は新しいtintedPrimitiveを作成する
+func newTintedPrimitive(primitive Primitive, tint Color) *tintedPrimitive {
+	return &tintedPrimitive{
+		Primitive: primitive,
+		tint:      tint,
+	}
+}
+
+// GetColor はティントを乗算した色を取得する
+func (t *tintedPrimitive) GetColor() Color {
+	return t.Primitive.GetColor().Multiply(t.tint)
+}
@@ -23,6 +23,35 @@ func TestNewColorRGB(t *testing.T) {
 	assert.Equal(t, float32(1.0), color.A) // アルファ値は1.0
 }
 
+func TestColor_Multiply(t *testing.T) {
+	color := NewColor(1.0, 0.5, 0.2, 0.8)
+	tint := NewColor(0.5, 0.5, 1.0, 0.5)
+	
+	result := color.Multiply(tint)
+	
+	assert.InDelta(t, 0.5, result.R, 1e-6)
+	assert.InDelta(t, 0.25, result.G, 1e-6)
+	assert.InDelta(t, 0.2, result.B, 1e-6)
+	assert.InDelta(t, 0.4, result.A, 1e-6)
+}
+
+func TestTintedPrimitive(t *testing.T) {
+	color := NewColor(1.0, 0.5, 0.2, 1.0)
+	rect := NewRectangle(0, 0, 10, 20, color)
+	tint := NewColor(0.5, 1.0, 1.0, 0.25)
+	
+	tinted := newTintedPrimitive(rect, tint)
+	
+	// 色は成分ごとの積になる
+	assert.Equal(t, color.Multiply(tint), tinted.GetColor())
+	// 頂点・インデックス・種類は元のプリミティブと同じ
+	assert.Equal(t, rect.GetVertices(), tinted.GetVertices())
+	assert.Equal(t, rect.GetIndices(), tinted.GetIndices())
+	assert.Equal(t, PrimitiveTypeRectangle, tinted.GetType())
+	// 元のプリミティブの色は変更されない
+	assert.Equal(t, color, rect.Color)
+}
+
 func TestNewRectangle(t *testing.T) {
 	color := NewColorRGB(1.0, 0.0, 0.0)
 	rect := NewRectangle(10, 20, 100, 50, color)
@@ -74,6 +103,66 @@ func TestRectangleInterface(t *testing.T) {
 	assert.Equal(t, PrimitiveTypeRectangle, rect.GetType())
 }
 
+func TestNewRoundedRectangle_ClampsRadius(t *testing.T) {
+	color := NewColorRGB(1.0, 1.0, 1.0)
+	
+	assert.Equal(t, float32(10), NewRoundedRectangle(0, 0, 40, 20, 50, color, 4).CornerRadius, "min(w,h)/2 に制限")
+	assert.Equal(t, float32(0), NewRoundedRectangle(0, 0, 40, 20, -5, color, 4).CornerRadius)
+	assert.Equal(t, 1, NewRoundedRectangle(0, 0, 40, 20, 5, color, 0).CornerSegments)
+	assert.Equal(t, PrimitiveTypeRoundedRectangle, NewRoundedRectangle(0, 0, 40, 20, 5, color, 4).GetType())
+}
+
+func TestRoundedRectangle_ZeroRadiusMatchesRectangle(t *testing.T) {
+	color := NewColorRGB(1.0, 1.0, 1.0)
+	rounded := NewRoundedRectangle(10, 20, 100, 50, 0, color, 4)
+	rect := NewRectangle(10, 20, 100, 50, color)
+	
+	rectVertices := rect.GetVertices()
+	corners := map[[2]float32]bool{}
+	for i := 0; i < len(rectVertices); i += 3 {
+		corners[[2]float32{rectVertices[i], rectVertices[i+1]}] = false
+	}
+	
+	// 外周点はすべて矩形の四隅のいずれかに一致し、四隅をすべて通る
+	vertices := rounded.GetVertices()
+	for i := 3; i < len(vertices); i += 3 {
+		point := [2]float32{vertices[i], vertices[i+1]}
+		_, ok := corners[point]
+		assert.True(t, ok, "外周点 %v は矩形の角", point)
+		corners[point] = true
+	}
+	for corner, visited := range corners {
+		assert.True(t, visited, "角 %v を通る", corner)
+	}
+}
+
+func TestRoundedRectangle_CornerVerticesLieOnRadius(t *testing.T) {
+	color := NewColorRGB(1.0, 1.0, 1.0)
+	segments := 4
+	rounded := NewRoundedRectangle(0, 0, 100, 60, 10, color, segments)
+	vertices := rounded.GetVertices()
+	
+	centers := [][2]float64{{10, 10}, {90, 10}, {90, 50}, {10, 50}} // 左上、右上、右下、左下
+	for corner, center := range centers {
+		for i := 0; i <= segments; i++ {
+			idx := (1 + corner*(segments+1) + i) * 3
+			distance := math.Hypot(float64(vertices[idx])-center[0], float64(vertices[idx+1])-center[1])
+			assert.InDelta(t, 10.0, distance, 1e-4, "corner %d point %d", corner, i)
+		}
+	}
+}
+
+func TestRoundedRectangleGetIndices(t *testing.T) {
+	rounded := NewRoundedRectangle(0, 0, 100, 60, 10, NewColorRGB(1, 1, 1), 2)
+	indices := rounded.GetIndices()
+	vertexCount := len(rounded.GetVertices()) / 3
+	
+	assert.Len(t, indices, 4*(2+1)*3)
+	for _, index := range indices {
+		assert.Less(t, int(index), vertexCount)
+	}
+}
+
 func TestNewCircle(t *testing.T) {
 	color := NewColorRGB(0.0, 1.0, 0.0)
 	circle := NewCircle(50, 50, 25, color)
@@ -135,6 +224,169 @@ func TestCircleInterface(t *testing.T) {
 	assert.Equal(t, PrimitiveTypeCircle, circle.GetType())
 }
 
+func TestNewTriangle(t *testing.T) {
+	color := NewColorRGB(1.0, 1.0, 0.0)
+	triangle := NewTriangle(0, 0, 10, 0, 5, 10, color)
+	
+	assert.Equal(t, float32(0), triangle.X1)
+	assert.Equal(t, float32(0), triangle.Y1)
+	assert.Equal(t, float32(10), triangle.X2)
+	assert.Equal(t, float32(0), triangle.Y2)
+	assert.Equal(t, float32(5), triangle.X3)
+	assert.Equal(t, float32(10), triangle.Y3)
+	assert.Equal(t, color, triangle.Color)
+}
+
+func TestTriangleGetVertices(t *testing.T) {
+	color := NewColorRGB(1.0, 1.0, 0.0)
+	triangle := NewTriangle(1, 2, 3, 4, 5, 6, color)
+	vertices := triangle.GetVertices()
+	
+	expected := []float32{
+		1, 2, 0, // 第1頂点
+		3, 4, 0, // 第2頂点
+		5, 6, 0, // 第3頂点
+	}
+	
+	assert.Equal(t, expected, vertices)
+}
+
+func TestTriangleGetIndices(t *testing.T) {
+	color := NewColorRGB(1.0, 1.0, 0.0)
+	triangle := NewTriangle(0, 0, 10, 0, 5, 10, color)
+	indices := triangle.GetIndices()
+	
+	expected := []uint32{0, 1, 2}
+	assert.Equal(t, expected, indices)
+}
+
+func TestTriangleInterface(t *testing.T) {
+	color := NewColorRGB(1.0, 1.0, 0.0)
+	var triangle Primitive = NewTriangle(0, 0, 10, 0, 5, 10, color)
+	
+	assert.Equal(t, color, triangle.GetColor())
+	assert.Equal(t, PrimitiveTypeTriangle, triangle.GetType())
+}
+
+func TestNewEllipse(t *testing.T) {
+	color := NewColorRGB(0.0, 1.0, 1.0)
+	ellipse := NewEllipse(10, 20, 30, 15, color)
+	
+	assert.Equal(t, float32(10), ellipse.X)
+	assert.Equal(t, float32(20), ellipse.Y)
+	assert.Equal(t, float32(30), ellipse.RadiusX)
+	assert.Equal(t, float32(15), ellipse.RadiusY)
+	assert.Equal(t, color, ellipse.Color)
+	assert.Equal(t, DefaultCircleSegments, ellipse.Segments)
+	assert.Equal(t, PrimitiveTypeEllipse, ellipse.GetType())
+}
+
+func TestEllipseGetVertices_EqualRadiiMatchesCircle(t *testing.T) {
+	color := NewColorRGB(0.0, 1.0, 1.0)
+	ellipse := NewEllipseWithSegments(50, 60, 25, 25, color, 12)
+	circle := NewCircleWithSegments(50, 60, 25, color, 12)
+	
+	ellipseVertices := ellipse.GetVertices()
+	circleVertices := circle.GetVertices()
+	
+	assert.Len(t, ellipseVertices, len(circleVertices))
+	for i := range circleVertices {
+		assert.InDelta(t, circleVertices[i], ellipseVertices[i], 1e-4, "index %d", i)
+	}
+	assert.Equal(t, circle.GetIndices(), ellipse.GetIndices())
+}
+
+func TestEllipseGetVertices_ScalesAxes(t *testing.T) {
+	ellipse := NewEllipseWithSegments(0, 0, 40, 10, NewColorRGB(1, 1, 1), 4)
+	vertices := ellipse.GetVertices()
+	
+	// 外周点: 0°, 90°, 180°, 270°
+	assert.InDelta(t, 40.0, vertices[3], 1e-4)
+	assert.InDelta(t, 0.0, vertices[4], 1e-4)
+	assert.InDelta(t, 0.0, vertices[6], 1e-4)
+	assert.InDelta(t, 10.0, vertices[7], 1e-4)
+	assert.InDelta(t, -40.0, vertices[9], 1e-4)
+	assert.InDelta(t, -10.0, vertices[13], 1e-4)
+}
+
+func TestNewArc(t *testing.T) {
+	color := NewColorRGB(1.0, 0.5, 0.0)
+	arc := NewArc(10, 20, 30, 0, math.Pi/2, color)
+	
+	assert.Equal(t, float32(10), arc.X)
+	assert.Equal(t, float32(20), arc.Y)
+	assert.Equal(t, float32(30), arc.Radius)
+	assert.Equal(t, color, arc.Color)
+	assert.Equal(t, DefaultCircleSegments, arc.Segments)
+	assert.Equal(t, PrimitiveTypeArc, arc.GetType())
+}
+
+func TestArcGetVertices_StartAndEndAngles(t *testing.T) {
+	arc := NewArcWithSegments(100, 100, 50, math.Pi/6, math.Pi*3/4, NewColorRGB(1, 1, 1), 8)
+	vertices := arc.GetVertices()
+	
+	assert.Len(t, vertices, (8+2)*3)
+	// 中心点
+	assert.Equal(t, []float32{100, 100, 0}, vertices[0:3])
+	
+	// 最初の外周点は開始角度上
+	assert.InDelta(t, 100+50*math.Cos(math.Pi/6), vertices[3], 1e-3)
+	assert.InDelta(t, 100+50*math.Sin(math.Pi/6), vertices[4], 1e-3)
+	
+	// 最後の外周点は終了角度上
+	last := len(vertices) - 3
+	assert.InDelta(t, 100+50*math.Cos(math.Pi*3/4), vertices[last], 1e-3)
+	assert.InDelta(t, 100+50*math.Sin(math.Pi*3/4), vertices[last+1], 1e-3)
+}
+
+func TestArcGetVertices_WrapAround(t *testing.T) {
+	// 終了角度が開始角度より小さい場合は0°をまたいで反時計回りに進む
+	arc := NewArcWithSegments(0, 0, 10, math.Pi*3/2, math.Pi/2, NewColorRGB(1, 1, 1), 2)
+	vertices := arc.GetVertices()
+	
+	assert.InDelta(t, math.Pi, arc.Sweep(), 1e-9)
+	// 開始: 270° (0, -10)、中間: 0° (10, 0)、終了: 90° (0, 10)
+	assert.InDelta(t, 0.0, vertices[3], 1e-4)
+	assert.InDelta(t, -10.0, vertices[4], 1e-4)
+	assert.InDelta(t, 10.0, vertices[6], 1e-4)
+	assert.InDelta(t, 0.0, vertices[7], 1e-4)
+	assert.InDelta(t, 0.0, vertices[9], 1e-4)
+	assert.InDelta(t, 10.0, vertices[10], 1e-4)
+}
+
+func TestArcGetIndices(t *testing.T) {
+	arc := NewArcWithSegments(0, 0, 10, 0, math.Pi, NewColorRGB(1, 1, 1), 3)
+	
+	expected := []uint32{
+		0, 1, 2,
+		0, 2, 3,
+		0, 3, 4,
+	}
+	assert.Equal(t, expected, arc.GetIndices())
+}
+
+func TestNewPoint(t *testing.T) {
+	color := NewColorRGB(1.0, 0.0, 1.0)
+	point := NewPoint(3, 4, color)
+	
+	assert.Equal(t, float32(3), point.X)
+	assert.Equal(t, float32(4), point.Y)
+	assert.Equal(t, float32(DefaultPointSize), point.Size)
+	assert.Equal(t, color, point.GetColor())
+	assert.Equal(t, PrimitiveTypePoint, point.GetType())
+}
+
+func TestPointGetVertices(t *testing.T) {
+	point := NewPoint(3, 4, NewColorRGB(1.0, 0.0, 1.0))
+	
+	assert.Equal(t, []float32{3, 4, 0}, point.GetVertices())
+	assert.Empty(t, point.GetIndices())
+	
+	call := drawCallFor(point.GetVertices(), point.GetIndices())
+	assert.False(t, call.useElements)
+	assert.Equal(t, int32(1), call.count, "頂点1つを描画する")
+}
+
 func TestNewLine(t *testing.T) {
 	color := NewColorRGB(0.0, 0.0, 1.0)
 	line := NewLine(0, 0, 10, 20, color)
@@ -177,6 +429,51 @@ func TestLineInterface(t *testing.T) {
 	assert.Equal(t, PrimitiveTypeLine, line.GetType())
 }
 
+func TestNewLineWithWidth(t *testing.T) {
+	color := NewColorRGB(0.0, 0.0, 1.0)
+	line := NewLineWithWidth(0, 0, 10, 20, 4, color)
+	
+	assert.Equal(t, float32(4), line.Width)
+	assert.True(t, line.IsThick())
+	assert.False(t, NewLine(0, 0, 10, 20, color).IsThick(), "デフォルト幅はgl.LINESで描画する")
+}
+
+func TestLineGetQuadVertices(t *testing.T) {
+	// 右向きの水平線: 法線は (0, 1)
+	line := NewLineWithWidth(0, 10, 20, 10, 4, NewColorRGB(1, 1, 1))
+	quad := line.GetQuadVertices()
+	
+	expected := []float32{
+		0, 12, 0,  // 始点 + 法線 × width/2
+		20, 12, 0, // 終点 + 法線 × width/2
+		20, 8, 0,  // 終点 - 法線 × width/2
+		0, 8, 0,   // 始点 - 法線 × width/2
+	}
+	assert.Equal(t, expected, quad)
+	assert.Equal(t, []uint32{0, 1, 2, 2, 3, 0}, line.GetQuadIndices())
+}
+
+func TestLineGetQuadVertices_Diagonal(t *testing.T) {
+	line := NewLineWithWidth(0, 0, 30, 40, 10, NewColorRGB(1, 1, 1))
+	quad := line.GetQuadVertices()
+	
+	// 各角は端点から法線方向に width/2 離れている
+	endpoints := [][2]float32{{0, 0}, {30, 40}, {30, 40}, {0, 0}}
+	for i, endpoint := range endpoints {
+		dx := float64(quad[i*3] - endpoint[0])
+		dy := float64(quad[i*3+1] - endpoint[1])
+		assert.InDelta(t, 5.0, math.Hypot(dx, dy), 1e-4)
+		// 線分方向 (0.6, 0.8) と直交している
+		assert.InDelta(t, 0.0, dx*0.6+dy*0.8, 1e-4)
+	}
+}
+
+func TestLineGetQuadVertices_ZeroLength(t *testing.T) {
+	line := NewLineWithWidth(5, 5, 5, 5, 4, NewColorRGB(1, 1, 1))
+	
+	assert.Nil(t, line.GetQuadVertices())
+}
+
 func TestCircleVerticesCorrectness(t *testing.T) {
 	// より厳密な円の頂点計算テスト
 	color := NewColorRGB(1.0, 0.0, 0.0)
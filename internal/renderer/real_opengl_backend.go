@@ -120,11 +120,26 @@ func (b *RealOpenGLBackend) UniformMatrix4fv(location int32, matrix [16]float32)
 	gl.UniformMatrix4fv(location, 1, false, (*float32)(unsafe.Pointer(&matrix[0])))
 }
 
+// UniformMatrix3fv は3x3行列のユニフォーム変数を設定する
+func (b *RealOpenGLBackend) UniformMatrix3fv(location int32, matrix [9]float32) {
+	gl.UniformMatrix3fv(location, 1, false, (*float32)(unsafe.Pointer(&matrix[0])))
+}
+
+// Uniform2fv は2次元ベクトルのユニフォーム変数を設定する
+func (b *RealOpenGLBackend) Uniform2fv(location int32, vector [2]float32) {
+	gl.Uniform2fv(location, 1, (*float32)(unsafe.Pointer(&vector[0])))
+}
+
 // Uniform3fv は3次元ベクトルのユニフォーム変数を設定する
 func (b *RealOpenGLBackend) Uniform3fv(location int32, vector [3]float32) {
 	gl.Uniform3fv(location, 1, (*float32)(unsafe.Pointer(&vector[0])))
 }
 
+// Uniform4fv は4次元ベクトルのユニフォーム変数を設定する
+func (b *RealOpenGLBackend) Uniform4fv(location int32, vector [4]float32) {
+	gl.Uniform4fv(location, 1, (*float32)(unsafe.Pointer(&vector[0])))
+}
+
 // Uniform1f は浮動小数点数のユニフォーム変数を設定する
 func (b *RealOpenGLBackend) Uniform1f(location int32, value float32) {
 	gl.Uniform1f(location, value)
@@ -134,3 +149,139 @@ func (b *RealOpenGLBackend) Uniform1f(location int32, value float32) {
 func (b *RealOpenGLBackend) Uniform1i(location int32, value int32) {
 	gl.Uniform1i(location, value)
 }
+
+// GenTexture は新しいテクスチャオブジェクトを作成する
+func (b *RealOpenGLBackend) GenTexture() uint32 {
+	var texture uint32
+	gl.GenTextures(1, &texture)
+	return texture
+}
+
+// BindTexture はテクスチャをバインドする
+func (b *RealOpenGLBackend) BindTexture(target, texture uint32) {
+	gl.BindTexture(target, texture)
+}
+
+// TexParameteri はテクスチャパラメータを設定する
+func (b *RealOpenGLBackend) TexParameteri(target, pname uint32, param int32) {
+	gl.TexParameteri(target, pname, param)
+}
+
+// TexImage2D はRGBA形式のピクセルデータをテクスチャに転送する
+func (b *RealOpenGLBackend) TexImage2D(target uint32, width, height int32, pixels []uint8) {
+	var ptr unsafe.Pointer
+	if len(pixels) > 0 {
+		ptr = gl.Ptr(pixels)
+	}
+	gl.TexImage2D(target, 0, gl.RGBA, width, height, 0, gl.RGBA, gl.UNSIGNED_BYTE, ptr)
+}
+
+// DeleteTexture はテクスチャオブジェクトを削除する
+func (b *RealOpenGLBackend) DeleteTexture(texture uint32) {
+	gl.DeleteTextures(1, &texture)
+}
+
+// GenVertexArray は新しい頂点配列オブジェクトを作成する
+func (b *RealOpenGLBackend) GenVertexArray() uint32 {
+	var vao uint32
+	gl.GenVertexArrays(1, &vao)
+	return vao
+}
+
+// BindVertexArray は頂点配列オブジェクトをバインドする
+func (b *RealOpenGLBackend) BindVertexArray(vao uint32) {
+	gl.BindVertexArray(vao)
+}
+
+// DeleteVertexArray は頂点配列オブジェクトを削除する
+func (b *RealOpenGLBackend) DeleteVertexArray(vao uint32) {
+	gl.DeleteVertexArrays(1, &vao)
+}
+
+// GenBuffer は新しいバッファオブジェクトを作成する
+func (b *RealOpenGLBackend) GenBuffer() uint32 {
+	var buffer uint32
+	gl.GenBuffers(1, &buffer)
+	return buffer
+}
+
+// BindBuffer はバッファオブジェクトをバインドする
+func (b *RealOpenGLBackend) BindBuffer(target, buffer uint32) {
+	gl.BindBuffer(target, buffer)
+}
+
+// BufferDataFloat32 はfloat32のデータをバッファに転送する
+func (b *RealOpenGLBackend) BufferDataFloat32(target uint32, data []float32, usage uint32) {
+	var ptr unsafe.Pointer
+	if len(data) > 0 {
+		ptr = gl.Ptr(data)
+	}
+	gl.BufferData(target, len(data)*FloatSizeBytes, ptr, usage)
+}
+
+// BufferDataUint32 はuint32のデータをバッファに転送する
+func (b *RealOpenGLBackend) BufferDataUint32(target uint32, data []uint32, usage uint32) {
+	var ptr unsafe.Pointer
+	if len(data) > 0 {
+		ptr = gl.Ptr(data)
+	}
+	gl.BufferData(target, len(data)*4, ptr, usage)
+}
+
+// DeleteBuffer はバッファオブジェクトを削除する
+func (b *RealOpenGLBackend) DeleteBuffer(buffer uint32) {
+	gl.DeleteBuffers(1, &buffer)
+}
+
+// VertexAttribPointer はfloat型の頂点属性のレイアウトを設定する
+func (b *RealOpenGLBackend) VertexAttribPointer(index uint32, size, stride int32, offset int) {
+	gl.VertexAttribPointer(index, size, gl.FLOAT, false, stride, gl.PtrOffset(offset))
+}
+
+// EnableVertexAttribArray は頂点属性を有効にする
+func (b *RealOpenGLBackend) EnableVertexAttribArray(index uint32) {
+	gl.EnableVertexAttribArray(index)
+}
+
+// DrawElements はバインド中のEBOのuint32インデックスで描画する
+func (b *RealOpenGLBackend) DrawElements(mode uint32, count int32) {
+	gl.DrawElements(mode, count, gl.UNSIGNED_INT, gl.PtrOffset(0))
+}
+
+// GenFramebuffer は新しいフレームバッファオブジェクトを作成する
+func (b *RealOpenGLBackend) GenFramebuffer() uint32 {
+	var framebuffer uint32
+	gl.GenFramebuffers(1, &framebuffer)
+	return framebuffer
+}
+
+// BindFramebuffer はフレームバッファをバインドする（0で画面）
+func (b *RealOpenGLBackend) BindFramebuffer(framebuffer uint32) {
+	gl.BindFramebuffer(gl.FRAMEBUFFER, framebuffer)
+}
+
+// FramebufferTexture2D はバインド中のフレームバッファにテクスチャをカラーアタッチメントとして接続する
+func (b *RealOpenGLBackend) FramebufferTexture2D(texture uint32) {
+	gl.FramebufferTexture2D(gl.FRAMEBUFFER, gl.COLOR_ATTACHMENT0, gl.TEXTURE_2D, texture, 0)
+}
+
+// CheckFramebufferStatus はバインド中のフレームバッファの完全性を確認する
+func (b *RealOpenGLBackend) CheckFramebufferStatus() uint32 {
+	return gl.CheckFramebufferStatus(gl.FRAMEBUFFER)
+}
+
+// DeleteFramebuffer はフレームバッファオブジェクトを削除する
+func (b *RealOpenGLBackend) DeleteFramebuffer(framebuffer uint32) {
+	gl.DeleteFramebuffers(1, &framebuffer)
+}
+
+// Viewport はビューポートを設定する
+func (b *RealOpenGLBackend) Viewport(x, y, width, height int32) {
+	gl.Viewport(x, y, width, height)
+}
+
+// ReadPixels はバインド中のフレームバッファの矩形をRGBA形式で読み出す（行は下から上の順）
+func (b *RealOpenGLBackend) ReadPixels(x, y, width, height int32, pixels []uint8) {
+	gl.PixelStorei(gl.PACK_ALIGNMENT, 1)
+	gl.ReadPixels(x, y, width, height, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(pixels))
+}
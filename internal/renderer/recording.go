@@ -0,0 +1,241 @@
+package renderer
+
+import (
+	"fmt"
+	"image"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"io"
+	"os"
+	"time"
+)
+
+// 録画関連の定数
+const (
+	DefaultRecordingFPS = 30
+	gifDelayUnitsPerSec = 100 // GIFの遅延時間の単位（1/100秒）
+)
+
+// frameRecorder は目標フレームレートに合わせてキャプチャしたフレームを蓄積する
+type frameRecorder struct {
+	fps         int
+	active      bool
+	accumulator float64
+	lastTime    time.Time
+	frames      []*image.RGBA
+}
+
+// newFrameRecorder は新しいframeRecorderを作成する
+func newFrameRecorder(fps int) *frameRecorder {
+	if fps <= 0 {
+		fps = DefaultRecordingFPS
+	}
+	return &frameRecorder{
+		fps:    fps,
+		active: true,
+		frames: make([]*image.RGBA, 0),
+	}
+}
+
+// shouldCaptureFrame は前回のキャプチャからの蓄積時間にこのフレームの経過時間を加え、
+// キャプチャすべきかと新しい蓄積時間を返す
+// 描画フレームレートが目標より低い場合でも、1フレームにつき最大1枚のみキャプチャする
+func shouldCaptureFrame(accumulator, deltaTime float64, fps int) (bool, float64) {
+	interval := 1.0 / float64(fps)
+	accumulator += deltaTime
+	if accumulator < interval {
+		return false, accumulator
+	}
+
+	accumulator -= interval
+	// 大きく遅れた場合は蓄積を捨ててドリフトを防ぐ
+	if accumulator >= interval {
+		accumulator = 0
+	}
+	return true, accumulator
+}
+
+// encodeGIF はフレーム列をアニメーションGIFとしてエンコードする
+func encodeGIF(w io.Writer, frames []*image.RGBA, fps int) error {
+	if len(frames) == 0 {
+		return fmt.Errorf("no frames to encode")
+	}
+	if fps <= 0 {
+		fps = DefaultRecordingFPS
+	}
+
+	animation := &gif.GIF{Delay: gifFrameDelays(len(frames), fps)}
+	for _, frame := range frames {
+		bounds := frame.Bounds()
+		paletted := image.NewPaletted(bounds, palette.Plan9)
+		draw.FloydSteinberg.Draw(paletted, bounds, frame, bounds.Min)
+		animation.Image = append(animation.Image, paletted)
+	}
+
+	if err := gif.EncodeAll(w, animation); err != nil {
+		return fmt.Errorf("failed to encode GIF: %v", err)
+	}
+	return nil
+}
+
+// gifFrameDelays は各フレームの遅延時間（1/100秒単位）を返す
+// 1/100秒で割り切れないフレームレートでは端数を次のフレームに持ち越し、
+// 再生時間の合計がフレーム数 / fps 秒からずれないようにする（30FPSなら 3, 4, 3, ...）
+func gifFrameDelays(frameCount, fps int) []int {
+	delays := make([]int, frameCount)
+	previousEnd := 0
+	for i := range delays {
+		end := ((i+1)*gifDelayUnitsPerSec + fps/2) / fps
+		delays[i] = end - previousEnd
+		if delays[i] < 1 {
+			delays[i] = 1
+		}
+		previousEnd += delays[i]
+	}
+	return delays
+}
+
+// flipRowsVertically は下から上の順に並んだOpenGLのピクセル行を上から下の順に並べ替える
+func flipRowsVertically(img *image.RGBA) {
+	height := img.Bounds().Dy()
+	row := make([]uint8, img.Stride)
+	for y := 0; y < height/2; y++ {
+		top := img.Pix[y*img.Stride : (y+1)*img.Stride]
+		bottom := img.Pix[(height-1-y)*img.Stride : (height-y)*img.Stride]
+		copy(row, top)
+		copy(top, bottom)
+		copy(bottom, row)
+	}
+}
+
+// readFramebuffer はバインド中のフレームバッファの内容を上から下の行順の画像として読み出す
+func readFramebuffer(backend OpenGLBackend, width, height int) (*image.RGBA, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid framebuffer size %dx%d", width, height)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	backend.ReadPixels(0, 0, int32(width), int32(height), img.Pix)
+	flipRowsVertically(img)
+
+	return img, nil
+}
+
+// CaptureFrame は現在の描画先（画面またはRenderTarget）の内容を画像として読み出す
+// バッチやシーンに保留中の描画は読み出す前に描画される
+func (r *OpenGLRenderer) CaptureFrame() (*image.RGBA, error) {
+	r.flushState()
+	width, height := r.surfaceSize()
+	return readFramebuffer(NewRealOpenGLBackend(), width, height)
+}
+
+// CaptureScreenshot は現在のフレームを画像として取得する
+func (r *OpenGLRenderer) CaptureScreenshot() (image.Image, error) {
+	return r.CaptureFrame()
+}
+
+// SaveScreenshot は現在のフレームをPNGファイルとして保存する
+func (r *OpenGLRenderer) SaveScreenshot(path string) error {
+	img, err := r.CaptureFrame()
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create screenshot file %s: %v", path, err)
+	}
+
+	if err := encodeScreenshot(file, img); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close screenshot file %s: %v", path, err)
+	}
+	return nil
+}
+
+// encodeScreenshot は画像をPNGとしてエンコードする
+func encodeScreenshot(w io.Writer, img image.Image) error {
+	if err := png.Encode(w, img); err != nil {
+		return fmt.Errorf("failed to encode PNG: %v", err)
+	}
+	return nil
+}
+
+// StartRecording は目標フレームレートでのフレーム録画を開始する
+// 録画中は Present のたびにキャプチャの要否が判定される
+func (r *OpenGLRenderer) StartRecording(fps int) {
+	r.recorder = newFrameRecorder(fps)
+}
+
+// IsRecording は録画中かを確認する
+func (r *OpenGLRenderer) IsRecording() bool {
+	return r.recorder != nil && r.recorder.active
+}
+
+// StopRecording は録画を停止する（キャプチャ済みのフレームは SaveRecordingGIF まで保持される）
+func (r *OpenGLRenderer) StopRecording() error {
+	if !r.IsRecording() {
+		return fmt.Errorf("recording is not in progress")
+	}
+	r.recorder.active = false
+	return nil
+}
+
+// SaveRecordingGIF は録画したフレームをGIFファイルとして保存する
+func (r *OpenGLRenderer) SaveRecordingGIF(path string) error {
+	if r.recorder == nil {
+		return fmt.Errorf("no recording to save")
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create GIF file %s: %v", path, err)
+	}
+
+	if err := encodeGIF(file, r.recorder.frames, r.recorder.fps); err != nil {
+		file.Close()
+		return err
+	}
+	// 書き込みの失敗は Close で報告される場合があるため、エラーを呼び出し元に返す
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close GIF file %s: %v", path, err)
+	}
+	return nil
+}
+
+// recordFrame は録画中であれば目標フレームレートに応じて現在のフレームをキャプチャする
+func (r *OpenGLRenderer) recordFrame() {
+	if !r.IsRecording() {
+		return
+	}
+
+	now := time.Now()
+	if r.recorder.lastTime.IsZero() {
+		// 最初のフレームは必ずキャプチャする
+		r.recorder.lastTime = now
+		r.captureRecordingFrame()
+		return
+	}
+
+	deltaTime := now.Sub(r.recorder.lastTime).Seconds()
+	r.recorder.lastTime = now
+
+	capture, accumulator := shouldCaptureFrame(r.recorder.accumulator, deltaTime, r.recorder.fps)
+	r.recorder.accumulator = accumulator
+	if capture {
+		r.captureRecordingFrame()
+	}
+}
+
+func (r *OpenGLRenderer) captureRecordingFrame() {
+	frame, err := r.CaptureFrame()
+	if err != nil {
+		return
+	}
+	r.recorder.frames = append(r.recorder.frames, frame)
+}
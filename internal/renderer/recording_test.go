@@ -0,0 +1,189 @@
+package renderer
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldCaptureFrame_Cadence(t *testing.T) {
+	// 60FPSで描画し、20FPSで録画する場合は3フレームに1回キャプチャする
+	accumulator := 0.0
+	captured := 0
+	for i := 0; i < 60; i++ {
+		var capture bool
+		capture, accumulator = shouldCaptureFrame(accumulator, 1.0/60.0, 20)
+		if capture {
+			captured++
+		}
+	}
+
+	assert.InDelta(t, 20, captured, 1)
+}
+
+func TestShouldCaptureFrame_SlowRenderCapturesOncePerFrame(t *testing.T) {
+	// 描画が目標フレームレートより遅い場合でも1フレームにつき1回まで
+	capture, accumulator := shouldCaptureFrame(0, 0.5, 30)
+
+	assert.True(t, capture)
+	assert.Equal(t, 0.0, accumulator, "大きな遅れは蓄積しない")
+}
+
+func TestShouldCaptureFrame_NotYetDue(t *testing.T) {
+	capture, accumulator := shouldCaptureFrame(0, 0.01, 30)
+
+	assert.False(t, capture)
+	assert.Equal(t, 0.01, accumulator)
+}
+
+func newSolidFrame(width, height int, c color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestEncodeGIF(t *testing.T) {
+	// Arrange
+	frames := []*image.RGBA{
+		newSolidFrame(4, 3, color.RGBA{R: 255, A: 255}),
+		newSolidFrame(4, 3, color.RGBA{B: 255, A: 255}),
+	}
+	var buffer bytes.Buffer
+
+	// Act
+	err := encodeGIF(&buffer, frames, 25)
+
+	// Assert
+	require.NoError(t, err)
+	decoded, err := gif.DecodeAll(&buffer)
+	require.NoError(t, err)
+	assert.Len(t, decoded.Image, 2)
+	assert.Equal(t, []int{4, 4}, decoded.Delay)
+	assert.Equal(t, 4, decoded.Config.Width)
+	assert.Equal(t, 3, decoded.Config.Height)
+}
+
+func TestGIFFrameDelays_CarriesRemainder(t *testing.T) {
+	tests := []struct {
+		name     string
+		fps      int
+		expected []int
+	}{
+		{"割り切れる", 25, []int{4, 4, 4, 4}},
+		{"30FPSは端数を持ち越す", 30, []int{3, 4, 3, 3, 4, 3}},
+		{"60FPS", 60, []int{2, 1, 2, 2, 1, 2}},
+		{"100FPSを超える場合も最低1", 200, []int{1, 1, 1, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, gifFrameDelays(len(tt.expected), tt.fps))
+		})
+	}
+}
+
+func TestGIFFrameDelays_TotalMatchesDuration(t *testing.T) {
+	// 30FPSで1秒分のフレームは合計で1秒（100単位）になる
+	total := 0
+	for _, delay := range gifFrameDelays(30, 30) {
+		total += delay
+	}
+
+	assert.Equal(t, 100, total)
+}
+
+func TestEncodeGIF_NoFrames(t *testing.T) {
+	var buffer bytes.Buffer
+
+	assert.Error(t, encodeGIF(&buffer, nil, 30))
+}
+
+func TestFlipRowsVertically(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 1, 3))
+	img.SetRGBA(0, 0, color.RGBA{R: 1})
+	img.SetRGBA(0, 1, color.RGBA{R: 2})
+	img.SetRGBA(0, 2, color.RGBA{R: 3})
+
+	flipRowsVertically(img)
+
+	assert.Equal(t, uint8(3), img.RGBAAt(0, 0).R)
+	assert.Equal(t, uint8(2), img.RGBAAt(0, 1).R)
+	assert.Equal(t, uint8(1), img.RGBAAt(0, 2).R)
+}
+
+func TestOpenGLRenderer_RecordingState(t *testing.T) {
+	r := &OpenGLRenderer{}
+
+	assert.False(t, r.IsRecording())
+	assert.Error(t, r.StopRecording())
+
+	r.StartRecording(15)
+	assert.True(t, r.IsRecording())
+	assert.NoError(t, r.StopRecording())
+	assert.False(t, r.IsRecording())
+}
+
+func TestReadFramebuffer_FlipsRows(t *testing.T) {
+	// Arrange
+	backend := NewMockOpenGLBackend()
+	// OpenGLは下の行から順に返す: 1行目(最下段)=赤、2行目(最上段)=青
+	backend.On("ReadPixels", int32(0), int32(0), int32(2), int32(2), mock.Anything).Run(func(args mock.Arguments) {
+		pixels := args.Get(4).([]uint8)
+		copy(pixels, []uint8{
+			255, 0, 0, 255, 255, 0, 0, 255,
+			0, 0, 255, 255, 0, 0, 255, 255,
+		})
+	}).Return()
+
+	// Act
+	img, err := readFramebuffer(backend, 2, 2)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, color.RGBA{B: 255, A: 255}, img.RGBAAt(0, 0), "画像の上端は画面の上端")
+	assert.Equal(t, color.RGBA{B: 255, A: 255}, img.RGBAAt(1, 0))
+	assert.Equal(t, color.RGBA{R: 255, A: 255}, img.RGBAAt(0, 1))
+	backend.AssertExpectations(t)
+}
+
+func TestReadFramebuffer_InvalidSize(t *testing.T) {
+	// Arrange
+	backend := NewMockOpenGLBackend()
+
+	// Act
+	_, err := readFramebuffer(backend, 0, 10)
+
+	// Assert
+	assert.Error(t, err)
+	backend.AssertNotCalled(t, "ReadPixels", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestEncodeScreenshot_RoundTrip(t *testing.T) {
+	// Arrange
+	img := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	img.SetRGBA(0, 0, color.RGBA{R: 10, G: 20, B: 30, A: 255})
+	img.SetRGBA(1, 0, color.RGBA{R: 40, G: 50, B: 60, A: 255})
+	var buffer bytes.Buffer
+
+	// Act
+	err := encodeScreenshot(&buffer, img)
+	decoded, decodeErr := png.Decode(&buffer)
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, decodeErr)
+	assert.Equal(t, img.Bounds(), decoded.Bounds())
+	r, g, b, _ := decoded.At(1, 0).RGBA()
+	assert.Equal(t, []uint32{40, 50, 60}, []uint32{r >> 8, g >> 8, b >> 8})
+}
@@ -0,0 +1,136 @@
+package renderer
+
+import (
+	"fmt"
+
+	"github.com/go-gl/gl/v4.1-core/gl"
+)
+
+// RenderTarget は描画先として使用できるオフスクリーンのフレームバッファ
+// カラーバッファはテクスチャとして保持され、後段の描画で読み取ることができる
+type RenderTarget struct {
+	backend     OpenGLBackend
+	framebuffer uint32
+	texture     *Texture
+	width       int
+	height      int
+	bound       bool
+}
+
+// NewRenderTarget は指定サイズのRenderTargetを作成する
+func NewRenderTarget(backend OpenGLBackend, width, height int) (*RenderTarget, error) {
+	texture, err := NewTexture(backend, width, height, nil, DefaultTextureParams())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create render target texture: %w", err)
+	}
+
+	framebuffer := backend.GenFramebuffer()
+	if framebuffer == 0 {
+		texture.Delete()
+		return nil, fmt.Errorf("failed to create framebuffer")
+	}
+
+	backend.BindFramebuffer(framebuffer)
+	backend.FramebufferTexture2D(texture.GetID())
+	status := backend.CheckFramebufferStatus()
+	backend.BindFramebuffer(0)
+
+	if status != gl.FRAMEBUFFER_COMPLETE {
+		backend.DeleteFramebuffer(framebuffer)
+		texture.Delete()
+		return nil, fmt.Errorf("framebuffer is incomplete: status 0x%X", status)
+	}
+
+	return &RenderTarget{
+		backend:     backend,
+		framebuffer: framebuffer,
+		texture:     texture,
+		width:       width,
+		height:      height,
+	}, nil
+}
+
+// Bind は以降の描画先をこのRenderTargetにし、ビューポートをサイズに合わせる
+func (rt *RenderTarget) Bind() {
+	if rt.framebuffer == 0 {
+		return
+	}
+	rt.backend.BindFramebuffer(rt.framebuffer)
+	rt.backend.Viewport(0, 0, int32(rt.width), int32(rt.height))
+	rt.bound = true
+}
+
+// Unbind は描画先を画面に戻す
+func (rt *RenderTarget) Unbind() {
+	rt.backend.BindFramebuffer(0)
+	rt.bound = false
+}
+
+// IsBound はこのRenderTargetが描画先としてバインドされているかを判定する
+func (rt *RenderTarget) IsBound() bool {
+	return rt.bound
+}
+
+// GetTexture は描画結果を保持するテクスチャを取得する
+func (rt *RenderTarget) GetTexture() *Texture {
+	return rt.texture
+}
+
+// Width は幅を返す
+func (rt *RenderTarget) Width() int {
+	return rt.width
+}
+
+// Height は高さを返す
+func (rt *RenderTarget) Height() int {
+	return rt.height
+}
+
+// Delete はフレームバッファとテクスチャを削除する
+func (rt *RenderTarget) Delete() {
+	if rt.bound {
+		rt.Unbind()
+	}
+	if rt.framebuffer != 0 {
+		rt.backend.DeleteFramebuffer(rt.framebuffer)
+		rt.framebuffer = 0
+	}
+	if rt.texture != nil {
+		rt.texture.Delete()
+		rt.texture = nil
+	}
+}
+
+// SetRenderTarget は以降の Clear や DrawPrimitive の描画先を設定する
+// nil を指定すると画面（デフォルトのフレームバッファ）に戻す
+func (r *OpenGLRenderer) SetRenderTarget(target *RenderTarget) {
+	if r.renderTarget == target {
+		return
+	}
+	r.flushState()
+
+	if r.renderTarget != nil {
+		r.renderTarget.Unbind()
+	}
+	r.renderTarget = target
+	if target != nil {
+		target.Bind()
+	}
+}
+
+// GetRenderTarget は現在の描画先を取得する（画面に描画している場合はnil）
+func (r *OpenGLRenderer) GetRenderTarget() *RenderTarget {
+	return r.renderTarget
+}
+
+// surfaceSize は現在の描画先のピクセルサイズを取得する
+// RenderTarget が設定されていればそのサイズ、そうでなければウィンドウのフレームバッファサイズになる
+func (r *OpenGLRenderer) surfaceSize() (int, int) {
+	if r.renderTarget != nil {
+		return r.renderTarget.Width(), r.renderTarget.Height()
+	}
+	if r.window != nil {
+		return r.window.GetFramebufferSize()
+	}
+	return r.width, r.height
+}
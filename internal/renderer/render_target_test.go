@@ -0,0 +1,103 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/v4.1-core/gl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRenderTarget はモックバックエンド上にRenderTargetを作成する
+func newTestRenderTarget(t *testing.T, backend *MockOpenGLBackend, framebuffer, texture uint32, width, height int) *RenderTarget {
+	expectTextureCreation(backend, texture)
+	backend.On("GenFramebuffer").Return(framebuffer).Once()
+	backend.On("BindFramebuffer", mock.Anything).Return()
+	backend.On("FramebufferTexture2D", texture).Return()
+	backend.On("CheckFramebufferStatus").Return(uint32(gl.FRAMEBUFFER_COMPLETE))
+	backend.On("Viewport", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	target, err := NewRenderTarget(backend, width, height)
+	require.NoError(t, err)
+	return target
+}
+
+func TestRenderTarget_BindUnbindState(t *testing.T) {
+	// Arrange
+	backend := NewMockOpenGLBackend()
+	target := newTestRenderTarget(t, backend, 3, 7, 320, 240)
+
+	// Act & Assert
+	assert.False(t, target.IsBound(), "作成直後は画面に描画する")
+
+	target.Bind()
+	assert.True(t, target.IsBound())
+	backend.AssertCalled(t, "BindFramebuffer", uint32(3))
+
+	target.Unbind()
+	assert.False(t, target.IsBound())
+	backend.AssertCalled(t, "BindFramebuffer", uint32(0))
+}
+
+func TestRenderTarget_DeleteWhileBoundUnbinds(t *testing.T) {
+	// Arrange
+	backend := NewMockOpenGLBackend()
+	target := newTestRenderTarget(t, backend, 3, 7, 320, 240)
+	backend.On("DeleteFramebuffer", uint32(3)).Return()
+	backend.On("DeleteTexture", uint32(7)).Return()
+	target.Bind()
+
+	// Act
+	target.Delete()
+	target.Bind()
+
+	// Assert
+	assert.False(t, target.IsBound(), "削除後はバインドできない")
+	assert.Nil(t, target.GetTexture())
+	backend.AssertNumberOfCalls(t, "DeleteFramebuffer", 1)
+}
+
+func TestOpenGLRenderer_SetRenderTarget(t *testing.T) {
+	// Arrange
+	backend := NewMockOpenGLBackend()
+	minimap := newTestRenderTarget(t, backend, 3, 7, 128, 128)
+	scene := newTestRenderTarget(t, backend, 4, 8, 320, 240)
+	renderer := &OpenGLRenderer{width: 800, height: 600}
+
+	// Act & Assert
+	assert.Nil(t, renderer.GetRenderTarget())
+
+	renderer.SetRenderTarget(minimap)
+	assert.Equal(t, minimap, renderer.GetRenderTarget())
+	assert.True(t, minimap.IsBound())
+
+	// 別のターゲットに切り替えると前のターゲットは解除される
+	renderer.SetRenderTarget(scene)
+	assert.False(t, minimap.IsBound())
+	assert.True(t, scene.IsBound())
+
+	// nil で画面に戻る
+	renderer.SetRenderTarget(nil)
+	assert.Nil(t, renderer.GetRenderTarget())
+	assert.False(t, scene.IsBound())
+}
+
+func TestOpenGLRenderer_SurfaceSizeFollowsRenderTarget(t *testing.T) {
+	// Arrange
+	backend := NewMockOpenGLBackend()
+	target := newTestRenderTarget(t, backend, 3, 7, 128, 64)
+	renderer := &OpenGLRenderer{width: 800, height: 600}
+
+	// Act
+	renderer.SetRenderTarget(target)
+	targetWidth, targetHeight := renderer.surfaceSize()
+	renderer.SetRenderTarget(nil)
+	screenWidth, screenHeight := renderer.surfaceSize()
+
+	// Assert
+	assert.Equal(t, 128, targetWidth)
+	assert.Equal(t, 64, targetHeight)
+	assert.Equal(t, 800, screenWidth)
+	assert.Equal(t, 600, screenHeight)
+}
@@ -23,6 +23,11 @@ func (r *BaseRenderer) Clear() {
 	// 基本実装: 何もしない（OpenGLRendererでオーバーライド）
 }
 
+// SetClearColor は画面クリア時の背景色を設定する
+func (r *BaseRenderer) SetClearColor(red, green, blue, alpha float32) {
+	// 基本実装: 何もしない（OpenGLRendererでオーバーライド）
+}
+
 // Present は描画内容を画面に表示する
 func (r *BaseRenderer) Present() {
 	// 基本実装: 何もしない（OpenGLRendererでオーバーライド）
@@ -53,6 +58,16 @@ func (r *BaseRenderer) DrawLine(x1, y1, x2, y2 float32, red, green, blue, alpha
 	// 基本実装: 何もしない（OpenGLRendererでオーバーライド）
 }
 
+// DrawTriangle は三角形を描画する
+func (r *BaseRenderer) DrawTriangle(x1, y1, x2, y2, x3, y3 float32, red, green, blue, alpha float32) {
+	// 基本実装: 何もしない（OpenGLRendererでオーバーライド）
+}
+
+// DrawPoint は点を描画する
+func (r *BaseRenderer) DrawPoint(x, y float32, red, green, blue, alpha float32) {
+	// 基本実装: 何もしない（OpenGLRendererでオーバーライド）
+}
+
 // GetSize は描画領域のサイズを取得する
 func (r *BaseRenderer) GetSize() (int, int) {
 	return r.width, r.height
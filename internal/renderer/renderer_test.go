@@ -3,6 +3,7 @@ package renderer
 import (
 	"testing"
 
+	"github.com/ganyariya/tinyengine/pkg/tinyengine"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -40,6 +41,20 @@ func (m *MockRenderer) DrawLine(x1, y1, x2, y2 float32, r, g, b, a float32) {
 	m.Called(x1, y1, x2, y2, r, g, b, a)
 }
 
+func (m *MockRenderer) DrawTriangle(x1, y1, x2, y2, x3, y3 float32, r, g, b, a float32) {
+	m.Called(x1, y1, x2, y2, x3, y3, r, g, b, a)
+}
+
+func (m *MockRenderer) DrawPoint(x, y float32, r, g, b, a float32) {
+	m.Called(x, y, r, g, b, a)
+}
+
+func (m *MockRenderer) SetClearColor(r, g, b, a float32) {
+	m.Called(r, g, b, a)
+}
+
+var _ tinyengine.Renderer = (*MockRenderer)(nil)
+
 func TestMockRenderer_Clear(t *testing.T) {
 	// Arrange
 	mockRenderer := new(MockRenderer)
@@ -77,6 +92,19 @@ func TestMockRenderer_DrawRectangle(t *testing.T) {
 	mockRenderer.AssertExpectations(t)
 }
 
+func TestMockRenderer_DrawPoint(t *testing.T) {
+	// Arrange
+	mockRenderer := new(MockRenderer)
+	mockRenderer.On("DrawPoint", float32(5), float32(6), float32(1), float32(0), float32(0), float32(1)).Return()
+
+	// Act
+	var renderer tinyengine.Renderer = mockRenderer
+	renderer.DrawPoint(5, 6, 1, 0, 0, 1)
+
+	// Assert
+	mockRenderer.AssertExpectations(t)
+}
+
 func TestNewBaseRenderer(t *testing.T) {
 	// Arrange
 	width, height := 800, 600
@@ -112,6 +140,16 @@ func TestBaseRenderer_Clear(t *testing.T) {
 	})
 }
 
+func TestBaseRenderer_SetClearColor(t *testing.T) {
+	// Arrange
+	renderer := NewBaseRenderer(800, 600)
+
+	// Act & Assert
+	assert.NotPanics(t, func() {
+		renderer.SetClearColor(0.5, 0.8, 1.0, 1.0)
+	})
+}
+
 func TestBaseRenderer_Present(t *testing.T) {
 	// Arrange
 	renderer := NewBaseRenderer(800, 600)
@@ -176,3 +214,13 @@ func TestBaseRenderer_DrawLine(t *testing.T) {
 		renderer.DrawLine(0, 0, 100, 100, 0.0, 0.0, 1.0, 1.0)
 	})
 }
+
+func TestBaseRenderer_DrawPoint(t *testing.T) {
+	// Arrange
+	renderer := NewBaseRenderer(800, 600)
+
+	// Act & Assert
+	assert.NotPanics(t, func() {
+		renderer.DrawPoint(10, 10, 1.0, 1.0, 1.0, 1.0)
+	})
+}
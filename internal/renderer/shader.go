@@ -12,6 +12,7 @@ type Shader struct {
 	programID        uint32
 	vertexShaderID   uint32
 	fragmentShaderID uint32
+	geometryShaderID uint32 // 任意。0の場合はジオメトリシェーダーを使用しない
 }
 
 // NewShader は新しいShaderを作成する
@@ -21,6 +22,7 @@ func NewShader(backend OpenGLBackend) *Shader {
 		programID:        0,
 		vertexShaderID:   0,
 		fragmentShaderID: 0,
+		geometryShaderID: 0,
 	}
 }
 
@@ -34,6 +36,12 @@ func (s *Shader) LoadFragmentShader(source string) error {
 	return s.loadShader(source, gl.FRAGMENT_SHADER, &s.fragmentShaderID)
 }
 
+// LoadGeometryShader はジオメトリシェーダーを読み込む
+// ジオメトリシェーダーは任意で、読み込んだ場合のみ LinkProgram でプログラムにアタッチされる
+func (s *Shader) LoadGeometryShader(source string) error {
+	return s.loadShader(source, gl.GEOMETRY_SHADER, &s.geometryShaderID)
+}
+
 // loadShader は指定された種類のシェーダーを読み込む
 func (s *Shader) loadShader(source string, shaderType uint32, shaderID *uint32) error {
 	// シェーダー作成
@@ -79,6 +87,9 @@ func (s *Shader) LinkProgram() error {
 	// シェーダーをアタッチ
 	s.backend.AttachShader(s.programID, s.vertexShaderID)
 	s.backend.AttachShader(s.programID, s.fragmentShaderID)
+	if s.geometryShaderID != 0 {
+		s.backend.AttachShader(s.programID, s.geometryShaderID)
+	}
 
 	// リンク
 	s.backend.LinkProgram(s.programID)
@@ -95,9 +106,14 @@ func (s *Shader) LinkProgram() error {
 	s.backend.DetachShader(s.programID, s.fragmentShaderID)
 	s.backend.DeleteShader(s.vertexShaderID)
 	s.backend.DeleteShader(s.fragmentShaderID)
+	if s.geometryShaderID != 0 {
+		s.backend.DetachShader(s.programID, s.geometryShaderID)
+		s.backend.DeleteShader(s.geometryShaderID)
+	}
 
 	s.vertexShaderID = 0
 	s.fragmentShaderID = 0
+	s.geometryShaderID = 0
 
 	return nil
 }
@@ -125,6 +141,10 @@ func (s *Shader) Delete() {
 		s.backend.DeleteShader(s.fragmentShaderID)
 		s.fragmentShaderID = 0
 	}
+	if s.geometryShaderID != 0 {
+		s.backend.DeleteShader(s.geometryShaderID)
+		s.geometryShaderID = 0
+	}
 }
 
 // GetProgramID はシェーダープログラムIDを取得する
@@ -148,6 +168,20 @@ func (s *Shader) SetUniformMat4(location int32, matrix [16]float32) {
 	}
 }
 
+// SetUniformMat3 は3x3行列のユニフォーム変数を設定する（列優先）
+func (s *Shader) SetUniformMat3(location int32, matrix [9]float32) {
+	if location >= 0 {
+		s.backend.UniformMatrix3fv(location, matrix)
+	}
+}
+
+// SetUniformVec2 は2次元ベクトルのユニフォーム変数を設定する
+func (s *Shader) SetUniformVec2(location int32, vector [2]float32) {
+	if location >= 0 {
+		s.backend.Uniform2fv(location, vector)
+	}
+}
+
 // SetUniformVec3 は3次元ベクトルのユニフォーム変数を設定する
 func (s *Shader) SetUniformVec3(location int32, vector [3]float32) {
 	if location >= 0 {
@@ -155,6 +189,13 @@ func (s *Shader) SetUniformVec3(location int32, vector [3]float32) {
 	}
 }
 
+// SetUniformVec4 は4次元ベクトルのユニフォーム変数を設定する
+func (s *Shader) SetUniformVec4(location int32, vector [4]float32) {
+	if location >= 0 {
+		s.backend.Uniform4fv(location, vector)
+	}
+}
+
 // SetUniformFloat は浮動小数点数のユニフォーム変数を設定する
 func (s *Shader) SetUniformFloat(location int32, value float32) {
 	if location >= 0 {
@@ -154,6 +154,23 @@ func (sm *ShaderManager) GetShaderNames() []string {
 	return names
 }
 
+// ForEach は登録されているすべてのシェーダーを名前順に走査する
+func (sm *ShaderManager) ForEach(fn func(name string, shader *Shader)) {
+	for _, name := range sm.GetShaderNames() {
+		fn(name, sm.shaders[name])
+	}
+}
+
+// CurrentShaderProgramID は現在使用中のシェーダーのプログラムIDを取得する
+// 使用中のシェーダーが存在しない場合は0を返す
+func (sm *ShaderManager) CurrentShaderProgramID() uint32 {
+	shader := sm.GetShader(sm.currentShader)
+	if shader == nil {
+		return 0
+	}
+	return shader.GetProgramID()
+}
+
 // SetUniformMat4 は現在のシェーダーに4x4行列ユニフォームを設定する
 func (sm *ShaderManager) SetUniformMat4(name string, matrix [16]float32) bool {
 	if sm.currentShader == "" {
@@ -109,3 +109,68 @@ func TestShaderManager_GetShaderNames(t *testing.T) {
 	assert.NotNil(t, names)
 	assert.Equal(t, 0, len(names))
 }
+
+// newTestShaderWithProgram はプログラムID設定済みのテスト用シェーダーを作成する
+func newTestShaderWithProgram(backend *MockOpenGLBackend, programID uint32) *Shader {
+	shader := NewShader(backend)
+	shader.programID = programID
+	return shader
+}
+
+func TestShaderManager_ForEach(t *testing.T) {
+	// Arrange
+	manager := NewShaderManager()
+	mockBackend := NewMockOpenGLBackend()
+	manager.shaders["sprite"] = newTestShaderWithProgram(mockBackend, 3)
+	manager.shaders["basic"] = newTestShaderWithProgram(mockBackend, 1)
+	manager.shaders["line"] = newTestShaderWithProgram(mockBackend, 2)
+
+	// Act
+	names := make([]string, 0)
+	programs := make([]uint32, 0)
+	manager.ForEach(func(name string, shader *Shader) {
+		names = append(names, name)
+		programs = append(programs, shader.GetProgramID())
+	})
+
+	// Assert
+	// 名前順に走査される
+	assert.Equal(t, []string{"basic", "line", "sprite"}, names)
+	assert.Equal(t, []uint32{1, 2, 3}, programs)
+}
+
+func TestShaderManager_ForEach_Empty(t *testing.T) {
+	// Arrange
+	manager := NewShaderManager()
+	called := false
+
+	// Act
+	manager.ForEach(func(name string, shader *Shader) {
+		called = true
+	})
+
+	// Assert
+	assert.False(t, called)
+}
+
+func TestShaderManager_CurrentShaderProgramID(t *testing.T) {
+	// Arrange
+	manager := NewShaderManager()
+	mockBackend := NewMockOpenGLBackend()
+	mockBackend.On("UseProgram", uint32(5)).Return()
+	mockBackend.On("UseProgram", uint32(7)).Return()
+	manager.shaders["basic"] = newTestShaderWithProgram(mockBackend, 5)
+	manager.shaders["color"] = newTestShaderWithProgram(mockBackend, 7)
+
+	// Act & Assert
+	// 使用中のシェーダーがない場合は0
+	assert.Equal(t, uint32(0), manager.CurrentShaderProgramID())
+
+	manager.UseShader("basic")
+	assert.Equal(t, uint32(5), manager.CurrentShaderProgramID())
+
+	manager.UseShader("color")
+	assert.Equal(t, uint32(7), manager.CurrentShaderProgramID())
+
+	mockBackend.AssertExpectations(t)
+}
@@ -33,6 +33,18 @@ uniform float alpha;
 void main() {
     FragColor = vec4(vertexColor, alpha);
 }
+`
+
+	validGeometryShaderSource = `
+#version 410 core
+layout (points) in;
+layout (triangle_strip, max_vertices = 4) out;
+
+void main() {
+    gl_Position = gl_in[0].gl_Position;
+    EmitVertex();
+    EndPrimitive();
+}
 `
 
 	invalidShaderSource = `
@@ -151,6 +163,87 @@ func TestShader_LinkProgram_Success(t *testing.T) {
 	mockBackend.AssertExpectations(t)
 }
 
+func TestShader_LoadGeometryShader_Success(t *testing.T) {
+	// Arrange
+	mockBackend := NewMockOpenGLBackend()
+	shader := NewShader(mockBackend)
+
+	mockBackend.On("CreateShader", uint32(gl.GEOMETRY_SHADER)).Return(uint32(4))
+	mockBackend.On("ShaderSource", uint32(4), validGeometryShaderSource).Return()
+	mockBackend.On("CompileShader", uint32(4)).Return()
+	mockBackend.On("GetShaderiv", uint32(4), uint32(gl.COMPILE_STATUS)).Return(int32(1))
+
+	// Act
+	err := shader.LoadGeometryShader(validGeometryShaderSource)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(4), shader.geometryShaderID)
+	mockBackend.AssertExpectations(t)
+}
+
+func TestShader_LinkProgram_WithGeometryShader(t *testing.T) {
+	// Arrange
+	mockBackend := NewMockOpenGLBackend()
+	shader := NewShader(mockBackend)
+
+	shader.vertexShaderID = 1
+	shader.fragmentShaderID = 2
+	shader.geometryShaderID = 4
+
+	// モックの設定：3つのシェーダーをアタッチする
+	mockBackend.On("CreateProgram").Return(uint32(3))
+	mockBackend.On("AttachShader", uint32(3), uint32(1)).Return()
+	mockBackend.On("AttachShader", uint32(3), uint32(2)).Return()
+	mockBackend.On("AttachShader", uint32(3), uint32(4)).Return()
+	mockBackend.On("LinkProgram", uint32(3)).Return()
+	mockBackend.On("GetProgramiv", uint32(3), uint32(gl.LINK_STATUS)).Return(int32(1))
+	mockBackend.On("DetachShader", uint32(3), uint32(1)).Return()
+	mockBackend.On("DetachShader", uint32(3), uint32(2)).Return()
+	mockBackend.On("DetachShader", uint32(3), uint32(4)).Return()
+	mockBackend.On("DeleteShader", uint32(1)).Return()
+	mockBackend.On("DeleteShader", uint32(2)).Return()
+	mockBackend.On("DeleteShader", uint32(4)).Return()
+
+	// Act
+	err := shader.LinkProgram()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3), shader.GetProgramID())
+	assert.Equal(t, uint32(0), shader.geometryShaderID) // クリーンアップされている
+	mockBackend.AssertExpectations(t)
+	mockBackend.AssertNumberOfCalls(t, "AttachShader", 3)
+}
+
+func TestShader_LinkProgram_WithoutGeometryShader(t *testing.T) {
+	// Arrange
+	mockBackend := NewMockOpenGLBackend()
+	shader := NewShader(mockBackend)
+
+	// ジオメトリシェーダーは任意なので読み込まなくてもリンクできる
+	shader.vertexShaderID = 1
+	shader.fragmentShaderID = 2
+
+	mockBackend.On("CreateProgram").Return(uint32(3))
+	mockBackend.On("AttachShader", uint32(3), uint32(1)).Return()
+	mockBackend.On("AttachShader", uint32(3), uint32(2)).Return()
+	mockBackend.On("LinkProgram", uint32(3)).Return()
+	mockBackend.On("GetProgramiv", uint32(3), uint32(gl.LINK_STATUS)).Return(int32(1))
+	mockBackend.On("DetachShader", uint32(3), uint32(1)).Return()
+	mockBackend.On("DetachShader", uint32(3), uint32(2)).Return()
+	mockBackend.On("DeleteShader", uint32(1)).Return()
+	mockBackend.On("DeleteShader", uint32(2)).Return()
+
+	// Act
+	err := shader.LinkProgram()
+
+	// Assert
+	assert.NoError(t, err)
+	mockBackend.AssertExpectations(t)
+	mockBackend.AssertNumberOfCalls(t, "AttachShader", 2)
+}
+
 func TestShader_LinkProgram_MissingVertexShader(t *testing.T) {
 	// Arrange
 	mockBackend := NewMockOpenGLBackend()
@@ -287,6 +380,66 @@ func TestShader_SetUniformFloat_InvalidLocation(t *testing.T) {
 	mockBackend.AssertNotCalled(t, "Uniform1f", mock.Anything, mock.Anything)
 }
 
+func TestShader_SetUniformVec2(t *testing.T) {
+	// Arrange
+	mockBackend := NewMockOpenGLBackend()
+	shader := NewShader(mockBackend)
+
+	mockBackend.On("Uniform2fv", int32(2), [2]float32{800, 600}).Return()
+
+	// Act
+	shader.SetUniformVec2(2, [2]float32{800, 600})
+
+	// Assert
+	mockBackend.AssertExpectations(t)
+}
+
+func TestShader_SetUniformVec4(t *testing.T) {
+	// Arrange
+	mockBackend := NewMockOpenGLBackend()
+	shader := NewShader(mockBackend)
+
+	mockBackend.On("Uniform4fv", int32(3), [4]float32{1, 0.5, 0.25, 1}).Return()
+
+	// Act
+	shader.SetUniformVec4(3, [4]float32{1, 0.5, 0.25, 1})
+
+	// Assert
+	mockBackend.AssertExpectations(t)
+}
+
+func TestShader_SetUniformMat3(t *testing.T) {
+	// Arrange
+	mockBackend := NewMockOpenGLBackend()
+	shader := NewShader(mockBackend)
+	matrix := [9]float32{1, 0, 0, 0, 1, 0, 10, 20, 1}
+
+	mockBackend.On("UniformMatrix3fv", int32(4), matrix).Return()
+
+	// Act
+	shader.SetUniformMat3(4, matrix)
+
+	// Assert
+	mockBackend.AssertExpectations(t)
+}
+
+func TestShader_SetUniformVector_InvalidLocation(t *testing.T) {
+	// Arrange
+	mockBackend := NewMockOpenGLBackend()
+	shader := NewShader(mockBackend)
+
+	// Act
+	shader.SetUniformVec2(-1, [2]float32{})
+	shader.SetUniformVec4(-1, [4]float32{})
+	shader.SetUniformMat3(-1, [9]float32{})
+
+	// Assert
+	// バックエンドは呼び出されない（invalid location）
+	mockBackend.AssertNotCalled(t, "Uniform2fv", mock.Anything, mock.Anything)
+	mockBackend.AssertNotCalled(t, "Uniform4fv", mock.Anything, mock.Anything)
+	mockBackend.AssertNotCalled(t, "UniformMatrix3fv", mock.Anything, mock.Anything)
+}
+
 func TestShader_FullWorkflow_Integration(t *testing.T) {
 	// Arrange
 	mockBackend := NewMockOpenGLBackend()
@@ -0,0 +1,197 @@
+package renderer
+
+import (
+	"github.com/go-gl/gl/v4.1-core/gl"
+)
+
+// スプライト描画の定数
+const (
+	TextureShaderName    = "textured"
+	SpriteVertexStride   = 5 // 頂点あたりのfloat数: x, y, z, u, v
+	VertexTexCoordSize   = 2
+	VertexTexCoordAttrib = 1
+)
+
+// テクスチャ描画シェーダーソースコード
+// テクスチャの色に u_color を乗算する（ティント用）
+const (
+	TextureVertexShaderSource = `#version 410 core
+layout (location = 0) in vec3 aPos;
+layout (location = 1) in vec2 aTexCoord;
+
+uniform mat4 u_transform;
+
+out vec2 vTexCoord;
+
+void main()
+{
+    vTexCoord = aTexCoord;
+    gl_Position = u_transform * vec4(aPos, 1.0);
+}`
+
+	TextureFragmentShaderSource = `#version 410 core
+in vec2 vTexCoord;
+
+uniform sampler2D u_texture;
+uniform vec4 u_color;
+
+out vec4 FragColor;
+
+void main()
+{
+    FragColor = texture(u_texture, vTexCoord) * u_color;
+}`
+)
+
+// Sprite はテクスチャを貼り付けた矩形プリミティブ
+// テクスチャ座標は画像の左上が (0, 0)、右下が (1, 1) になる
+type Sprite struct {
+	X, Y          float32  // 左上角の座標
+	Width, Height float32  // 幅と高さ
+	Texture       *Texture // 貼り付けるテクスチャ
+	U0, V0        float32  // 左上のテクスチャ座標
+	U1, V1        float32  // 右下のテクスチャ座標
+	Color         Color    // テクスチャの色に乗算する色（白で元の色のまま）
+}
+
+// NewSprite はテクスチャ全体を貼り付けた新しいスプライトを作成する
+func NewSprite(texture *Texture, x, y, width, height float32) *Sprite {
+	return &Sprite{
+		X:       x,
+		Y:       y,
+		Width:   width,
+		Height:  height,
+		Texture: texture,
+		U0:      0,
+		V0:      0,
+		U1:      1,
+		V1:      1,
+		Color:   NewColor(1.0, 1.0, 1.0, 1.0),
+	}
+}
+
+// GetVertices はスプライトの頂点データを取得する
+// 頂点順は Rectangle と同じ（左下、右下、右上、左上）
+func (s *Sprite) GetVertices() []float32 {
+	return NewRectangle(s.X, s.Y, s.Width, s.Height, s.Color).GetVertices()
+}
+
+// GetTexCoords は GetVertices の頂点順に対応するテクスチャ座標（u, v）を取得する
+func (s *Sprite) GetTexCoords() []float32 {
+	return []float32{
+		s.U0, s.V1, // 左下
+		s.U1, s.V1, // 右下
+		s.U1, s.V0, // 右上
+		s.U0, s.V0, // 左上
+	}
+}
+
+// GetTexturedVertices は位置（x, y, z）とテクスチャ座標（u, v）を頂点ごとに交互に並べたデータを取得する
+func (s *Sprite) GetTexturedVertices() []float32 {
+	positions := s.GetVertices()
+	texCoords := s.GetTexCoords()
+
+	vertexCount := len(positions) / VertexPositionSize
+	data := make([]float32, 0, vertexCount*SpriteVertexStride)
+	for i := 0; i < vertexCount; i++ {
+		data = append(data, positions[i*VertexPositionSize:(i+1)*VertexPositionSize]...)
+		data = append(data, texCoords[i*VertexTexCoordSize:(i+1)*VertexTexCoordSize]...)
+	}
+	return data
+}
+
+// GetIndices はスプライトのインデックスデータを取得する
+func (s *Sprite) GetIndices() []uint32 {
+	return []uint32{
+		0, 1, 2, // 第1三角形
+		2, 3, 0, // 第2三角形
+	}
+}
+
+// GetColor はスプライトに乗算する色を取得する
+func (s *Sprite) GetColor() Color {
+	return s.Color
+}
+
+// GetType はスプライトのプリミティブタイプを取得する
+func (s *Sprite) GetType() PrimitiveType {
+	return PrimitiveTypeSprite
+}
+
+// spriteOf はスプライトの描画経路で描画するスプライトを取得する
+// ティントを付与したスプライトは、ティントを乗算した色を持つコピーを返す
+func spriteOf(primitive interface{}) (*Sprite, bool) {
+	switch p := primitive.(type) {
+	case *Sprite:
+		return p, true
+	case *tintedPrimitive:
+		if sprite, ok := p.Primitive.(*Sprite); ok {
+			tinted := *sprite
+			tinted.Color = p.GetColor()
+			return &tinted, true
+		}
+	}
+	return nil, false
+}
+
+// DrawSprite はスプライトをテクスチャ描画シェーダーで描画する
+// テクスチャが未設定・削除済みの場合やシェーダーが読み込まれていない場合は何もしない
+func (r *OpenGLRenderer) DrawSprite(sprite *Sprite) {
+	if sprite == nil || sprite.Texture == nil || sprite.Texture.GetID() == 0 {
+		return
+	}
+	if r.shaderManager == nil || r.bufferPool == nil {
+		return
+	}
+
+	shader := r.shaderManager.GetShader(TextureShaderName)
+	if shader == nil {
+		return
+	}
+	r.flushState()
+
+	previousShader := r.shaderManager.GetCurrentShader()
+	defer func() {
+		if previousShader != "" {
+			r.shaderManager.UseShader(previousShader)
+		}
+	}()
+
+	data := sprite.GetTexturedVertices()
+	indices := sprite.GetIndices()
+
+	vao := r.bufferPool.GetVAO()
+	vbo := r.bufferPool.GetVBO()
+	ebo := r.bufferPool.GetEBO()
+	defer func() {
+		gl.BindVertexArray(0)
+		r.bufferPool.ReturnVAO(vao)
+		r.bufferPool.ReturnVBO(vbo)
+		r.bufferPool.ReturnEBO(ebo)
+	}()
+
+	gl.BindVertexArray(vao)
+	gl.BindBuffer(gl.ARRAY_BUFFER, vbo)
+	r.bufferPool.UploadFloat32(gl.ARRAY_BUFFER, vbo, data)
+	gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, ebo)
+	r.bufferPool.UploadUint32(gl.ELEMENT_ARRAY_BUFFER, ebo, indices)
+
+	stride := int32(SpriteVertexStride * FloatSizeBytes)
+	gl.VertexAttribPointer(VertexPositionAttrib, VertexPositionSize, gl.FLOAT, false, stride, gl.PtrOffset(0))
+	gl.EnableVertexAttribArray(VertexPositionAttrib)
+	gl.VertexAttribPointer(VertexTexCoordAttrib, VertexTexCoordSize, gl.FLOAT, false, stride, gl.PtrOffset(VertexPositionSize*FloatSizeBytes))
+	gl.EnableVertexAttribArray(VertexTexCoordAttrib)
+
+	r.shaderManager.UseShader(TextureShaderName)
+	r.applyDrawUniforms(shader, sprite.Color)
+	r.applyPolygonMode()
+	if loc := shader.GetUniformLocation("u_texture"); loc != -1 {
+		gl.Uniform1i(loc, 0)
+	}
+
+	gl.ActiveTexture(gl.TEXTURE0)
+	sprite.Texture.Bind()
+	defer gl.BindTexture(gl.TEXTURE_2D, 0)
+
+	gl.DrawElements(gl.TRIANGLES, int32(len(indices)), gl.UNSIGNED_INT, gl.PtrOffset(0))
+}
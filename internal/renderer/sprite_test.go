@@ -0,0 +1,55 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ Primitive = (*Sprite)(nil)
+
+func TestNewSprite(t *testing.T) {
+	// Arrange
+	texture := &Texture{id: 1, Width: 64, Height: 32}
+
+	// Act
+	sprite := NewSprite(texture, 10, 20, 64, 32)
+
+	// Assert
+	assert.Equal(t, texture, sprite.Texture)
+	assert.Equal(t, float32(0), sprite.U0)
+	assert.Equal(t, float32(0), sprite.V0)
+	assert.Equal(t, float32(1), sprite.U1)
+	assert.Equal(t, float32(1), sprite.V1)
+	assert.Equal(t, NewColor(1, 1, 1, 1), sprite.GetColor())
+	assert.Equal(t, PrimitiveTypeSprite, sprite.GetType())
+}
+
+func TestSprite_GetVerticesMatchesRectangle(t *testing.T) {
+	// Arrange
+	sprite := NewSprite(&Texture{id: 1, Width: 64, Height: 32}, 10, 20, 64, 32)
+	rect := NewRectangle(10, 20, 64, 32, sprite.Color)
+
+	// Act & Assert
+	assert.Equal(t, rect.GetVertices(), sprite.GetVertices())
+	assert.Equal(t, rect.GetIndices(), sprite.GetIndices())
+}
+
+func TestSprite_GetTexturedVertices(t *testing.T) {
+	// Arrange
+	sprite := NewSprite(&Texture{id: 1, Width: 10, Height: 20}, 0, 0, 10, 20)
+
+	// Act
+	data := sprite.GetTexturedVertices()
+
+	// Assert
+	// 画像の上端が v=0 になる
+	expected := []float32{
+		0, 20, 0, 0, 1, // 左下
+		10, 20, 0, 1, 1, // 右下
+		10, 0, 0, 1, 0, // 右上
+		0, 0, 0, 0, 0, // 左上
+	}
+	assert.Equal(t, expected, data)
+	assert.Len(t, data, 4*SpriteVertexStride)
+}
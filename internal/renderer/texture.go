@@ -0,0 +1,215 @@
+package renderer
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"io"
+	"os"
+
+	"github.com/go-gl/gl/v4.1-core/gl"
+)
+
+// テクスチャ関連の定数
+const (
+	TextureBytesPerPixel = 4 // RGBA
+)
+
+// TextureFilter はテクスチャの拡大・縮小時のフィルタリング方法を表す
+type TextureFilter int
+
+const (
+	// TextureFilterLinear は線形補間（滑らかな拡大縮小向け）
+	TextureFilterLinear TextureFilter = iota
+	// TextureFilterNearest は最近傍補間（ピクセルアート向け）
+	TextureFilterNearest
+)
+
+// TextureWrap はテクスチャ座標が範囲外の場合の扱いを表す
+type TextureWrap int
+
+const (
+	// TextureWrapClamp は端のピクセルを引き伸ばす
+	TextureWrapClamp TextureWrap = iota
+	// TextureWrapRepeat はテクスチャを繰り返す
+	TextureWrapRepeat
+)
+
+// TextureParams はテクスチャのフィルタリングとラップモードの設定
+type TextureParams struct {
+	MinFilter TextureFilter
+	MagFilter TextureFilter
+	WrapS     TextureWrap
+	WrapT     TextureWrap
+}
+
+// DefaultTextureParams はデフォルトのテクスチャ設定（線形補間 + クランプ）を返す
+func DefaultTextureParams() TextureParams {
+	return TextureParams{
+		MinFilter: TextureFilterLinear,
+		MagFilter: TextureFilterLinear,
+		WrapS:     TextureWrapClamp,
+		WrapT:     TextureWrapClamp,
+	}
+}
+
+// PixelArtTextureParams はピクセルアート向けのテクスチャ設定（最近傍補間 + クランプ）を返す
+func PixelArtTextureParams() TextureParams {
+	return TextureParams{
+		MinFilter: TextureFilterNearest,
+		MagFilter: TextureFilterNearest,
+		WrapS:     TextureWrapClamp,
+		WrapT:     TextureWrapClamp,
+	}
+}
+
+// glFilter はフィルタリング方法をOpenGLの定数に変換する
+func (f TextureFilter) glFilter() int32 {
+	switch f {
+	case TextureFilterNearest:
+		return gl.NEAREST
+	default:
+		return gl.LINEAR
+	}
+}
+
+// glWrap はラップモードをOpenGLの定数に変換する
+func (w TextureWrap) glWrap() int32 {
+	switch w {
+	case TextureWrapRepeat:
+		return gl.REPEAT
+	default:
+		return gl.CLAMP_TO_EDGE
+	}
+}
+
+// Texture はOpenGLテクスチャを管理する
+type Texture struct {
+	backend OpenGLBackend
+	id      uint32
+	Width   int
+	Height  int
+	params  TextureParams
+}
+
+// NewTexture はRGBAピクセルデータから新しいTextureを作成する
+// pixels が nil の場合は内容が未定義のテクスチャを確保する
+func NewTexture(backend OpenGLBackend, width, height int, pixels []uint8, params TextureParams) (*Texture, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid texture size: %dx%d", width, height)
+	}
+	if pixels != nil && len(pixels) != width*height*TextureBytesPerPixel {
+		return nil, fmt.Errorf("pixel data size mismatch: expected %d bytes, got %d",
+			width*height*TextureBytesPerPixel, len(pixels))
+	}
+
+	id := backend.GenTexture()
+	if id == 0 {
+		return nil, fmt.Errorf("failed to create texture")
+	}
+
+	texture := &Texture{
+		backend: backend,
+		id:      id,
+		Width:   width,
+		Height:  height,
+	}
+
+	backend.BindTexture(gl.TEXTURE_2D, id)
+	texture.applyParams(params)
+	backend.TexImage2D(gl.TEXTURE_2D, int32(width), int32(height), pixels)
+	backend.BindTexture(gl.TEXTURE_2D, 0)
+
+	return texture, nil
+}
+
+// NewTextureFromImage は画像から新しいTextureを作成する
+// 画像の1行目（上端）がテクスチャ座標 v=0 になる
+func NewTextureFromImage(backend OpenGLBackend, img image.Image, params TextureParams) (*Texture, error) {
+	nrgba := toNRGBA(img)
+	bounds := nrgba.Bounds()
+	return NewTexture(backend, bounds.Dx(), bounds.Dy(), nrgba.Pix, params)
+}
+
+// LoadTexture はPNGファイルを読み込んでテクスチャを作成する
+func LoadTexture(backend OpenGLBackend, path string, params TextureParams) (*Texture, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open texture %s: %w", path, err)
+	}
+	defer file.Close()
+
+	img, err := DecodePNG(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load texture %s: %w", path, err)
+	}
+
+	return NewTextureFromImage(backend, img, params)
+}
+
+// DecodePNG はPNGデータをデコードしてRGBA画像に変換する
+// アルファブレンド（SRC_ALPHA, ONE_MINUS_SRC_ALPHA）で正しく合成できるよう、乗算済みでないアルファのまま保持する
+func DecodePNG(r io.Reader) (*image.NRGBA, error) {
+	img, err := png.Decode(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode PNG: %w", err)
+	}
+	return toNRGBA(img), nil
+}
+
+// toNRGBA は画像を原点が(0, 0)で行間に余白のないNRGBA画像に変換する
+func toNRGBA(img image.Image) *image.NRGBA {
+	bounds := img.Bounds()
+	if nrgba, ok := img.(*image.NRGBA); ok && bounds.Min == (image.Point{}) && nrgba.Stride == bounds.Dx()*TextureBytesPerPixel {
+		return nrgba
+	}
+
+	nrgba := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(nrgba, nrgba.Bounds(), img, bounds.Min, draw.Src)
+	return nrgba
+}
+
+// applyParams はバインド中のテクスチャにパラメータを設定する
+func (t *Texture) applyParams(params TextureParams) {
+	t.backend.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, params.MinFilter.glFilter())
+	t.backend.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, params.MagFilter.glFilter())
+	t.backend.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, params.WrapS.glWrap())
+	t.backend.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, params.WrapT.glWrap())
+	t.params = params
+}
+
+// SetParams はテクスチャのフィルタリングとラップモードを変更する
+func (t *Texture) SetParams(params TextureParams) {
+	if t.id == 0 {
+		return
+	}
+	t.backend.BindTexture(gl.TEXTURE_2D, t.id)
+	t.applyParams(params)
+	t.backend.BindTexture(gl.TEXTURE_2D, 0)
+}
+
+// GetParams は現在のテクスチャ設定を取得する
+func (t *Texture) GetParams() TextureParams {
+	return t.params
+}
+
+// GetID はテクスチャIDを取得する
+func (t *Texture) GetID() uint32 {
+	return t.id
+}
+
+// Bind はテクスチャをバインドする
+func (t *Texture) Bind() {
+	if t.id != 0 {
+		t.backend.BindTexture(gl.TEXTURE_2D, t.id)
+	}
+}
+
+// Delete はテクスチャを削除する
+func (t *Texture) Delete() {
+	if t.id != 0 {
+		t.backend.DeleteTexture(t.id)
+		t.id = 0
+	}
+}
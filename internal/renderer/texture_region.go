@@ -0,0 +1,47 @@
+package renderer
+
+import (
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+)
+
+// TextureRegion はテクスチャアトラス（スプライトシート）内の矩形領域を表す
+// 座標はテクスチャ全体を (0, 0)-(1, 1) とした正規化テクスチャ座標で、左上が (U0, V0) になる
+type TextureRegion struct {
+	Texture *Texture
+	U0, V0  float32 // 左上のテクスチャ座標
+	U1, V1  float32 // 右下のテクスチャ座標
+}
+
+// NewTextureRegion はピクセル単位の矩形（左上原点）からテクスチャ領域を作成する
+// テクスチャの大きさが0の場合はテクスチャ座標を計算できないため、全て0の領域になる
+func NewTextureRegion(texture *Texture, pixelRect mathlib.Rect) *TextureRegion {
+	region := &TextureRegion{Texture: texture}
+	if texture == nil || texture.Width <= 0 || texture.Height <= 0 {
+		return region
+	}
+
+	width := float64(texture.Width)
+	height := float64(texture.Height)
+	region.U0 = float32(pixelRect.Min.X / width)
+	region.V0 = float32(pixelRect.Min.Y / height)
+	region.U1 = float32(pixelRect.Max.X / width)
+	region.V1 = float32(pixelRect.Max.Y / height)
+	return region
+}
+
+// NewSpriteFromRegion はテクスチャ領域を貼り付けた新しいスプライトを作成する
+func NewSpriteFromRegion(region *TextureRegion, x, y, width, height float32) *Sprite {
+	sprite := NewSprite(region.Texture, x, y, width, height)
+	sprite.SetRegion(region)
+	return sprite
+}
+
+// SetRegion はスプライトに貼り付けるテクスチャとテクスチャ座標を領域に合わせて変更する
+func (s *Sprite) SetRegion(region *TextureRegion) {
+	if region == nil {
+		return
+	}
+	s.Texture = region.Texture
+	s.U0, s.V0 = region.U0, region.V0
+	s.U1, s.V1 = region.U1, region.V1
+}
@@ -0,0 +1,77 @@
+package renderer
+
+import (
+	"testing"
+
+	mathlib "github.com/ganyariya/tinyengine/internal/math"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTextureRegion_WholeTexture(t *testing.T) {
+	// Arrange
+	texture := &Texture{id: 1, Width: 128, Height: 64}
+
+	// Act
+	region := NewTextureRegion(texture, mathlib.NewRect(0, 0, 128, 64))
+
+	// Assert
+	assert.Equal(t, texture, region.Texture)
+	assert.Equal(t, float32(0), region.U0)
+	assert.Equal(t, float32(0), region.V0)
+	assert.Equal(t, float32(1), region.U1)
+	assert.Equal(t, float32(1), region.V1)
+}
+
+func TestNewTextureRegion_AtlasCell(t *testing.T) {
+	// Arrange
+	texture := &Texture{id: 1, Width: 128, Height: 128}
+
+	// Act
+	// 32x32のセルが4x4並んだアトラスの (1, 2) のセル
+	region := NewTextureRegion(texture, mathlib.NewRect(32, 64, 32, 32))
+
+	// Assert
+	assert.Equal(t, float32(0.25), region.U0)
+	assert.Equal(t, float32(0.5), region.V0)
+	assert.Equal(t, float32(0.5), region.U1)
+	assert.Equal(t, float32(0.75), region.V1)
+}
+
+func TestNewTextureRegion_EmptyTexture(t *testing.T) {
+	// Act
+	region := NewTextureRegion(&Texture{}, mathlib.NewRect(0, 0, 32, 32))
+
+	// Assert
+	// 0除算せずに全て0になる
+	assert.Equal(t, &TextureRegion{Texture: &Texture{}}, region)
+}
+
+func TestNewSpriteFromRegion(t *testing.T) {
+	// Arrange
+	texture := &Texture{id: 1, Width: 128, Height: 128}
+	region := NewTextureRegion(texture, mathlib.NewRect(0, 32, 32, 32))
+
+	// Act
+	sprite := NewSpriteFromRegion(region, 0, 0, 64, 64)
+
+	// Assert
+	assert.Equal(t, texture, sprite.Texture)
+	expected := []float32{
+		0, 0.5, // 左下
+		0.25, 0.5, // 右下
+		0.25, 0.25, // 右上
+		0, 0.25, // 左上
+	}
+	assert.Equal(t, expected, sprite.GetTexCoords())
+}
+
+func TestSprite_SetRegionNilKeepsUVs(t *testing.T) {
+	// Arrange
+	sprite := NewSprite(&Texture{id: 1, Width: 16, Height: 16}, 0, 0, 16, 16)
+
+	// Act
+	sprite.SetRegion(nil)
+
+	// Assert
+	assert.Equal(t, []float32{0, 1, 1, 1, 1, 0, 0, 0}, sprite.GetTexCoords())
+}
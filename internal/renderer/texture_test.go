@@ -0,0 +1,204 @@
+package renderer
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-gl/gl/v4.1-core/gl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultTextureParams(t *testing.T) {
+	// Act
+	params := DefaultTextureParams()
+
+	// Assert
+	// デフォルトは線形補間 + クランプ
+	assert.Equal(t, TextureFilterLinear, params.MinFilter)
+	assert.Equal(t, TextureFilterLinear, params.MagFilter)
+	assert.Equal(t, TextureWrapClamp, params.WrapS)
+	assert.Equal(t, TextureWrapClamp, params.WrapT)
+
+	// ゼロ値もデフォルトと同じ設定になる
+	assert.Equal(t, params, TextureParams{})
+}
+
+func TestTextureFilter_GLMapping(t *testing.T) {
+	assert.Equal(t, int32(gl.LINEAR), TextureFilterLinear.glFilter())
+	assert.Equal(t, int32(gl.NEAREST), TextureFilterNearest.glFilter())
+}
+
+func TestTextureWrap_GLMapping(t *testing.T) {
+	assert.Equal(t, int32(gl.CLAMP_TO_EDGE), TextureWrapClamp.glWrap())
+	assert.Equal(t, int32(gl.REPEAT), TextureWrapRepeat.glWrap())
+}
+
+func TestNewTexture_AppliesParams(t *testing.T) {
+	// Arrange
+	mockBackend := NewMockOpenGLBackend()
+	pixels := make([]uint8, 2*2*TextureBytesPerPixel)
+	params := PixelArtTextureParams()
+	params.WrapS = TextureWrapRepeat
+
+	mockBackend.On("GenTexture").Return(uint32(1))
+	mockBackend.On("BindTexture", uint32(gl.TEXTURE_2D), uint32(1)).Return()
+	mockBackend.On("BindTexture", uint32(gl.TEXTURE_2D), uint32(0)).Return()
+	mockBackend.On("TexParameteri", uint32(gl.TEXTURE_2D), uint32(gl.TEXTURE_MIN_FILTER), int32(gl.NEAREST)).Return()
+	mockBackend.On("TexParameteri", uint32(gl.TEXTURE_2D), uint32(gl.TEXTURE_MAG_FILTER), int32(gl.NEAREST)).Return()
+	mockBackend.On("TexParameteri", uint32(gl.TEXTURE_2D), uint32(gl.TEXTURE_WRAP_S), int32(gl.REPEAT)).Return()
+	mockBackend.On("TexParameteri", uint32(gl.TEXTURE_2D), uint32(gl.TEXTURE_WRAP_T), int32(gl.CLAMP_TO_EDGE)).Return()
+	mockBackend.On("TexImage2D", uint32(gl.TEXTURE_2D), int32(2), int32(2), pixels).Return()
+
+	// Act
+	texture, err := NewTexture(mockBackend, 2, 2, pixels, params)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), texture.GetID())
+	assert.Equal(t, 2, texture.Width)
+	assert.Equal(t, 2, texture.Height)
+	assert.Equal(t, params, texture.GetParams())
+	mockBackend.AssertExpectations(t)
+}
+
+func TestNewTexture_InvalidInput(t *testing.T) {
+	// Arrange
+	mockBackend := NewMockOpenGLBackend()
+
+	// Act & Assert
+	_, err := NewTexture(mockBackend, 0, 2, nil, DefaultTextureParams())
+	assert.Error(t, err)
+
+	_, err = NewTexture(mockBackend, 2, 2, make([]uint8, 3), DefaultTextureParams())
+	assert.Error(t, err)
+
+	// GL呼び出しは行われない
+	mockBackend.AssertNotCalled(t, "GenTexture")
+}
+
+func TestTexture_SetParamsAndDelete(t *testing.T) {
+	// Arrange
+	mockBackend := NewMockOpenGLBackend()
+	mockBackend.On("GenTexture").Return(uint32(3))
+	mockBackend.On("BindTexture", mock.Anything, mock.Anything).Return()
+	mockBackend.On("TexParameteri", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockBackend.On("TexImage2D", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockBackend.On("DeleteTexture", uint32(3)).Return()
+
+	texture, err := NewTexture(mockBackend, 4, 4, nil, DefaultTextureParams())
+	assert.NoError(t, err)
+
+	// Act
+	texture.SetParams(PixelArtTextureParams())
+	texture.Delete()
+
+	// Assert
+	assert.Equal(t, PixelArtTextureParams(), texture.GetParams())
+	assert.Equal(t, uint32(0), texture.GetID())
+	mockBackend.AssertCalled(t, "TexParameteri", uint32(gl.TEXTURE_2D), uint32(gl.TEXTURE_MAG_FILTER), int32(gl.NEAREST))
+	mockBackend.AssertNumberOfCalls(t, "DeleteTexture", 1)
+}
+
+// encodeTestPNG は左上が赤、右上が半透明の緑、下段が青の2x2のPNGを作成する
+func encodeTestPNG(t *testing.T) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	img.Set(0, 0, color.NRGBA{R: 255, A: 255})
+	img.Set(1, 0, color.NRGBA{G: 255, A: 128})
+	img.Set(0, 1, color.NRGBA{B: 255, A: 255})
+	img.Set(1, 1, color.NRGBA{B: 255, A: 255})
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// testPNGPixels は encodeTestPNG の画像の上端から順に並んだRGBAピクセルデータ
+var testPNGPixels = []uint8{
+	255, 0, 0, 255, 0, 255, 0, 128,
+	0, 0, 255, 255, 0, 0, 255, 255,
+}
+
+func TestDecodePNG(t *testing.T) {
+	// Arrange
+	data := encodeTestPNG(t)
+
+	// Act
+	img, err := DecodePNG(bytes.NewReader(data))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, img.Bounds().Dx())
+	assert.Equal(t, 2, img.Bounds().Dy())
+	// アルファは乗算済みにならない
+	assert.Equal(t, testPNGPixels, img.Pix)
+}
+
+func TestDecodePNG_InvalidData(t *testing.T) {
+	// Act
+	_, err := DecodePNG(bytes.NewReader([]byte("not a png")))
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestNewTextureFromImage_ConvertsToTightRGBA(t *testing.T) {
+	// Arrange
+	mockBackend := NewMockOpenGLBackend()
+	mockBackend.On("GenTexture").Return(uint32(5))
+	mockBackend.On("BindTexture", mock.Anything, mock.Anything).Return()
+	mockBackend.On("TexParameteri", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockBackend.On("TexImage2D", uint32(gl.TEXTURE_2D), int32(1), int32(1), []uint8{0, 0, 255, 255}).Return()
+
+	// 原点が(0, 0)でない部分画像も詰めて転送される
+	img := image.NewRGBA(image.Rect(0, 0, 3, 3)).SubImage(image.Rect(1, 1, 2, 2)).(*image.RGBA)
+	img.Set(1, 1, color.RGBA{B: 255, A: 255})
+
+	// Act
+	texture, err := NewTextureFromImage(mockBackend, img, DefaultTextureParams())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, texture.Width)
+	assert.Equal(t, 1, texture.Height)
+	mockBackend.AssertExpectations(t)
+}
+
+func TestLoadTexture(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "sprite.png")
+	require.NoError(t, os.WriteFile(path, encodeTestPNG(t), 0o644))
+
+	mockBackend := NewMockOpenGLBackend()
+	mockBackend.On("GenTexture").Return(uint32(7))
+	mockBackend.On("BindTexture", mock.Anything, mock.Anything).Return()
+	mockBackend.On("TexParameteri", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockBackend.On("TexImage2D", uint32(gl.TEXTURE_2D), int32(2), int32(2), testPNGPixels).Return()
+
+	// Act
+	texture, err := LoadTexture(mockBackend, path, PixelArtTextureParams())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, uint32(7), texture.GetID())
+	assert.Equal(t, PixelArtTextureParams(), texture.GetParams())
+	mockBackend.AssertExpectations(t)
+}
+
+func TestLoadTexture_MissingFile(t *testing.T) {
+	// Arrange
+	mockBackend := NewMockOpenGLBackend()
+
+	// Act
+	_, err := LoadTexture(mockBackend, filepath.Join(t.TempDir(), "missing.png"), DefaultTextureParams())
+
+	// Assert
+	assert.Error(t, err)
+	mockBackend.AssertNotCalled(t, "GenTexture")
+}
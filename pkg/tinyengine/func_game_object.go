@@ -0,0 +1,38 @@
+package tinyengine
+
+// funcGameObject は関数から構築される簡易GameObject実装
+type funcGameObject struct {
+	update func(deltaTime float64)
+	render func(renderer Renderer)
+}
+
+// FuncGameObject は更新・描画関数からGameObjectを作成する
+// Initialize と Destroy は何もしない。nil の関数は呼び出されない
+func FuncGameObject(update func(deltaTime float64), render func(renderer Renderer)) GameObject {
+	return &funcGameObject{
+		update: update,
+		render: render,
+	}
+}
+
+// Initialize は何もしない
+func (f *funcGameObject) Initialize() error {
+	return nil
+}
+
+// Update は更新関数を呼び出す
+func (f *funcGameObject) Update(deltaTime float64) {
+	if f.update != nil {
+		f.update(deltaTime)
+	}
+}
+
+// Render は描画関数を呼び出す
+func (f *funcGameObject) Render(renderer Renderer) {
+	if f.render != nil {
+		f.render(renderer)
+	}
+}
+
+// Destroy は何もしない
+func (f *funcGameObject) Destroy() {}
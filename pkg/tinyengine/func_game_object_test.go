@@ -0,0 +1,40 @@
+package tinyengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuncGameObject_InvokesClosures(t *testing.T) {
+	var updatedWith float64
+	var renderedWith Renderer
+	renderer := &testRenderer{}
+
+	obj := FuncGameObject(
+		func(deltaTime float64) { updatedWith = deltaTime },
+		func(r Renderer) { renderedWith = r },
+	)
+
+	assert.NoError(t, obj.Initialize())
+
+	obj.Update(0.016)
+	assert.Equal(t, 0.016, updatedWith)
+
+	obj.Render(renderer)
+	assert.Equal(t, renderer, renderedWith)
+
+	obj.Destroy()
+}
+
+func TestFuncGameObject_NilClosures(t *testing.T) {
+	obj := FuncGameObject(nil, nil)
+
+	// nilの関数を渡してもパニックしないことを確認
+	assert.NotPanics(t, func() {
+		assert.NoError(t, obj.Initialize())
+		obj.Update(0.016)
+		obj.Render(nil)
+		obj.Destroy()
+	})
+}
@@ -24,6 +24,9 @@ type Renderer interface {
 	// Present は描画内容を画面に表示する
 	Present()
 	
+	// SetClearColor は画面クリア時の背景色を設定する
+	SetClearColor(red, green, blue, alpha float32)
+	
 	// DrawRectangle は矩形を描画する
 	DrawRectangle(x, y, width, height float32)
 	
@@ -38,6 +41,12 @@ type Renderer interface {
 	
 	// DrawLine は線を描画する
 	DrawLine(x1, y1, x2, y2 float32, red, green, blue, alpha float32)
+	
+	// DrawTriangle は三角形を描画する
+	DrawTriangle(x1, y1, x2, y2, x3, y3 float32, red, green, blue, alpha float32)
+	
+	// DrawPoint は点を描画する
+	DrawPoint(x, y float32, red, green, blue, alpha float32)
 }
 
 // InputManager は入力管理機能を提供するインターフェース
@@ -48,11 +57,23 @@ type InputManager interface {
 	// IsKeyPressed は指定されたキーが押されているかを確認する
 	IsKeyPressed(key int) bool
 	
+	// IsKeyJustPressed は指定されたキーがこのフレームで押されたかを確認する
+	IsKeyJustPressed(key int) bool
+	
+	// IsKeyJustReleased は指定されたキーがこのフレームで離されたかを確認する
+	IsKeyJustReleased(key int) bool
+	
 	// GetMousePosition はマウス座標を取得する
 	GetMousePosition() (float64, float64)
 	
 	// IsMouseButtonPressed はマウスボタンが押されているかを確認する
 	IsMouseButtonPressed(button int) bool
+	
+	// GetScrollDelta はこのフレームのスクロール量（横, 縦）を取得する
+	GetScrollDelta() (float64, float64)
+	
+	// GetTypedRunes はこのフレームで入力された文字を取得する（キーボードレイアウト・Shift は OS が解決済み）
+	GetTypedRunes() []rune
 }
 
 // AudioManager はオーディオ機能を提供するインターフェース
@@ -85,6 +85,18 @@ func (r *testRenderer) DrawLine(x1, y1, x2, y2 float32, red, g, b, a float32) {
 	// テスト用の空実装
 }
 
+func (r *testRenderer) DrawTriangle(x1, y1, x2, y2, x3, y3 float32, red, g, b, a float32) {
+	// テスト用の空実装
+}
+
+func (r *testRenderer) DrawPoint(x, y float32, red, g, b, a float32) {
+	// テスト用の空実装
+}
+
+func (r *testRenderer) SetClearColor(red, g, b, a float32) {
+	// テスト用の空実装
+}
+
 func TestRendererInterface(t *testing.T) {
 	renderer := &testRenderer{}
 	
@@ -104,5 +116,8 @@ func TestRendererInterface(t *testing.T) {
 	r.DrawRectangleColor(10, 20, 100, 50, 1.0, 0.0, 0.0, 1.0)
 	r.DrawCircle(50, 50, 25, 0.0, 1.0, 0.0, 1.0)
 	r.DrawLine(0, 0, 100, 100, 0.0, 0.0, 1.0, 1.0)
+	r.DrawTriangle(0, 0, 10, 0, 5, 10, 1.0, 1.0, 0.0, 1.0)
+	r.DrawPoint(5, 5, 1.0, 1.0, 1.0, 1.0)
+	r.SetClearColor(0.5, 0.8, 1.0, 1.0)
 	r.DrawPrimitive(nil) // nilでもパニックしないことを確認
 }
\ No newline at end of file